@@ -86,6 +86,7 @@ const Admin: React.FC<{ venueID: string }> = ({ venueID }) => {
               <Tables
                 tables={data?.getVenue?.tables || []}
                 venueId={data?.getVenue?.id}
+                version={data?.getVenue?.version || 0}
                 refetch={refetch}
               />
             </Route>
@@ -114,6 +115,7 @@ const Admin: React.FC<{ venueID: string }> = ({ venueID }) => {
                 specialOpeningHours={data?.getVenue?.specialOpeningHours || []}
                 refetch={refetch}
                 venueId={data?.getVenue?.id}
+                version={data?.getVenue?.version || 0}
               />
             </Route>
           </Switch>
@@ -117,6 +117,8 @@ export type Venue = {
   slug: Scalars['ID'];
   /** paginated list of bookings for a venue */
   bookings?: Maybe<BookingsPage>;
+  /** version of the venue's configuration. must be given when changing opening hours or tables */
+  version: Scalars['Int'];
 };
 
 
@@ -140,6 +142,8 @@ export type TableInput = {
   name: Scalars['String'];
   /** maximum amount of people that can sit at table */
   capacity: Scalars['Int'];
+  /** version of the venue the change is based on */
+  version: Scalars['Int'];
 };
 
 /** Input to remove a venue table */
@@ -148,6 +152,8 @@ export type RemoveTableInput = {
   venueId: Scalars['ID'];
   /** unique identifier of the table to be removed */
   tableId: Scalars['ID'];
+  /** version of the venue the change is based on */
+  version: Scalars['Int'];
 };
 
 /** An individual table at a venue. */
@@ -311,6 +317,8 @@ export type UpdateOpeningHoursInput = {
   venueId: Scalars['ID'];
   /** operating hours of the venue */
   openingHours: Array<OpeningHoursSpecificationInput>;
+  /** version of the venue the change is based on */
+  version: Scalars['Int'];
 };
 
 /** Input to update a venue's special operating hours. */
@@ -319,6 +327,8 @@ export type UpdateSpecialOpeningHoursInput = {
   venueId: Scalars['ID'];
   /** special operating hours of the venue */
   specialOpeningHours: Array<SpecialOpeningHoursSpecificationInput>;
+  /** version of the venue the change is based on */
+  version: Scalars['Int'];
 };
 
 /** Booking mutations. */
@@ -471,7 +481,7 @@ export type GetVenueQuery = (
   { __typename?: 'Query' }
   & { getVenue: (
     { __typename?: 'Venue' }
-    & Pick<Venue, 'id' | 'name' | 'admins' | 'slug'>
+    & Pick<Venue, 'id' | 'name' | 'admins' | 'slug' | 'version'>
     & { openingHours: Array<(
       { __typename?: 'OpeningHoursSpecification' }
       & Pick<OpeningHoursSpecification, 'dayOfWeek' | 'opens' | 'closes'>
@@ -769,6 +779,7 @@ export const GetVenueDocument = gql`
     }
     admins
     slug
+    version
     bookings(filter: $filter, pageInfo: $pageInfo) {
       bookings {
         id
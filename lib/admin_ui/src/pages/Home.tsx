@@ -54,7 +54,16 @@ const Home: React.FC<{
     variables?: GetVenueQueryVariables
   ) => Promise<ApolloQueryResult<GetVenueQuery>>;
   venueId: string | undefined;
-}> = ({ name, slug, openingHours, specialOpeningHours, refetch, venueId }) => {
+  version: number;
+}> = ({
+  name,
+  slug,
+  openingHours,
+  specialOpeningHours,
+  refetch,
+  venueId,
+  version,
+}) => {
   const overrides = {
     TableBodyRow: {
       style: ({ $theme, $rowIndex }: any) => ({
@@ -120,6 +129,7 @@ const Home: React.FC<{
               {(row) => (
                 <EditOpeningHours
                   venueId={venueId}
+                  version={version}
                   openingHours={openingHours.filter(
                     (o) => o.dayOfWeek !== row.dayOfWeek
                   )}
@@ -159,6 +169,7 @@ const Home: React.FC<{
               {(row) => (
                 <EditSpecialOpeningHours
                   venueId={venueId}
+                  version={version}
                   openingHours={specialOpeningHours.filter((o) => o !== row)}
                   text="Remove"
                   refetch={refetch}
@@ -170,6 +181,7 @@ const Home: React.FC<{
       </FlexGrid>
       <AddOpeningHours
         venueId={venueId}
+        version={version}
         openingHours={openingHours}
         setAddOpeningHoursIsOpen={setAddOpeningHoursIsOpen}
         addOpeningHoursIsOpen={addOpeningHoursIsOpen}
@@ -177,6 +189,7 @@ const Home: React.FC<{
       />
       <AddSpecialOpeningHours
         venueId={venueId}
+        version={version}
         openingHours={specialOpeningHours}
         setAddSpecialOpeningHoursIsOpen={setAddSpecialOpeningHoursIsOpen}
         addSpecialOpeningHoursIsOpen={addSpecialOpeningHoursIsOpen}
@@ -190,6 +203,7 @@ export default Home;
 
 const AddOpeningHours: React.FC<{
   venueId: string;
+  version: number;
   openingHours: OpeningHoursSpecification[];
   setAddOpeningHoursIsOpen: React.Dispatch<React.SetStateAction<boolean>>;
   addOpeningHoursIsOpen: boolean;
@@ -199,6 +213,7 @@ const AddOpeningHours: React.FC<{
 }> = ({
   openingHours,
   venueId,
+  version,
   setAddOpeningHoursIsOpen,
   addOpeningHoursIsOpen,
   refetch,
@@ -275,6 +290,7 @@ const AddOpeningHours: React.FC<{
           {day && opens && closes && (
             <EditOpeningHours
               venueId={venueId}
+              version={version}
               openingHours={append_hours()}
               text="Add"
               close={close}
@@ -289,13 +305,14 @@ const AddOpeningHours: React.FC<{
 
 const EditOpeningHours: React.FC<{
   venueId: string;
+  version: number;
   openingHours: OpeningHoursSpecification[];
   text: string;
   close?: () => void;
   refetch: (
     variables?: GetVenueQueryVariables
   ) => Promise<ApolloQueryResult<GetVenueQuery>>;
-}> = ({ venueId, openingHours, text, refetch, close }) => {
+}> = ({ venueId, version, openingHours, text, refetch, close }) => {
   const openHours = openingHours.map((o) => {
     return {
       dayOfWeek: o.dayOfWeek,
@@ -309,6 +326,7 @@ const EditOpeningHours: React.FC<{
       input: {
         venueId: venueId || "",
         openingHours: openHours,
+        version: version,
       },
     },
   });
@@ -334,6 +352,7 @@ const EditOpeningHours: React.FC<{
 
 const AddSpecialOpeningHours: React.FC<{
   venueId: string;
+  version: number;
   openingHours: OpeningHoursSpecification[];
   setAddSpecialOpeningHoursIsOpen: React.Dispatch<
     React.SetStateAction<boolean>
@@ -345,6 +364,7 @@ const AddSpecialOpeningHours: React.FC<{
 }> = ({
   openingHours,
   venueId,
+  version,
   setAddSpecialOpeningHoursIsOpen,
   addSpecialOpeningHoursIsOpen,
   refetch,
@@ -458,6 +478,7 @@ const AddSpecialOpeningHours: React.FC<{
           {day && validFrom && validThrough && (
             <EditSpecialOpeningHours
               venueId={venueId}
+              version={version}
               openingHours={append_hours()}
               text="Add"
               close={close}
@@ -472,13 +493,14 @@ const AddSpecialOpeningHours: React.FC<{
 
 const EditSpecialOpeningHours: React.FC<{
   venueId: string;
+  version: number;
   openingHours: OpeningHoursSpecification[];
   text: string;
   close?: () => void;
   refetch: (
     variables?: GetVenueQueryVariables
   ) => Promise<ApolloQueryResult<GetVenueQuery>>;
-}> = ({ venueId, openingHours, text, refetch, close }) => {
+}> = ({ venueId, version, openingHours, text, refetch, close }) => {
   const [
     updateSpecialOpeningHoursMutation,
   ] = useUpdateSpecialOpeningHoursMutation({
@@ -494,6 +516,7 @@ const EditSpecialOpeningHours: React.FC<{
             validThrough: o.validThrough || "",
           };
         }),
+        version: version,
       },
     },
   });
@@ -24,10 +24,11 @@ import { TableBuilder, TableBuilderColumn } from "baseui/table-semantic";
 const Tables: React.FC<{
   tables: Array<Table>;
   venueId: string | null | undefined;
+  version: number;
   refetch: (
     variables?: GetVenueQueryVariables
   ) => Promise<ApolloQueryResult<GetVenueQuery>>;
-}> = ({ tables, venueId, refetch }) => {
+}> = ({ tables, venueId, version, refetch }) => {
   const overrides = {
     TableBodyRow: {
       style: ({ $theme, $rowIndex }: any) => ({
@@ -91,11 +92,13 @@ const Tables: React.FC<{
           selectedTable={selectedTable}
           refetch={refetch}
           venueId={venueId}
+          version={version}
         />
         <AddTableModal
           addIsOpen={addIsOpen}
           setAddIsOpen={setAddIsOpen}
           venueId={venueId}
+          version={version}
           refetch={refetch}
         />
       </FlexGrid>
@@ -113,10 +116,22 @@ const DeleteTableModal: React.FC<{
     variables?: GetVenueQueryVariables
   ) => Promise<ApolloQueryResult<GetVenueQuery>>;
   venueId: string;
-}> = ({ deleteIsOpen, setDeleteIsOpen, selectedTable, venueId, refetch }) => {
+  version: number;
+}> = ({
+  deleteIsOpen,
+  setDeleteIsOpen,
+  selectedTable,
+  venueId,
+  version,
+  refetch,
+}) => {
   const [removeTableMutation] = useRemoveTableMutation({
     variables: {
-      table: { venueId: venueId, tableId: selectedTable?.id || "" },
+      table: {
+        venueId: venueId,
+        tableId: selectedTable?.id || "",
+        version: version,
+      },
     },
   });
 
@@ -153,15 +168,21 @@ const AddTableModal: React.FC<{
   addIsOpen: boolean;
   setAddIsOpen: React.Dispatch<React.SetStateAction<boolean>>;
   venueId: string;
+  version: number;
   refetch: (
     variables?: GetVenueQueryVariables
   ) => Promise<ApolloQueryResult<GetVenueQuery>>;
-}> = ({ addIsOpen, setAddIsOpen, venueId, refetch }) => {
+}> = ({ addIsOpen, setAddIsOpen, venueId, version, refetch }) => {
   const [name, setName] = useState<string>("");
   const [capacity, setCapacity] = useState<string>("");
   const [addTableMutation] = useAddTableMutation({
     variables: {
-      table: { venueId: venueId, name: name, capacity: parseInt(capacity) },
+      table: {
+        venueId: venueId,
+        name: name,
+        capacity: parseInt(capacity),
+        version: version,
+      },
     },
   });
   const close = () => {
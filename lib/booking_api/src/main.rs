@@ -52,6 +52,7 @@ async fn main() -> Result<(), Box<dyn std::error::Error>> {
         "audience",
         std::env::var("AUTH0_VENUE_API_IDENTIFIER").expect("venue api identifier not set"),
     );
+    map.insert("scope", "venue:read".to_string());
     map.insert("grant_type", "client_credentials".to_string());
     let client = reqwest::Client::new();
     let mut resp = client
@@ -550,6 +550,7 @@ mod tests {
                     }],
                     special_opening_hours: vec![],
                     slug: "".to_string(),
+                    version: 0,
                 })
             });
         let service = BookingService::new(Box::new(MockRepository::new()), Box::new(mock), None)
@@ -600,6 +601,7 @@ mod tests {
                     }],
                     special_opening_hours: vec![],
                     slug: "test-venue".to_string(),
+                    version: 0,
                 })
             });
 
@@ -708,6 +710,7 @@ mod tests {
                     }],
                     special_opening_hours: vec![],
                     slug: "test-venue".to_string(),
+                    version: 0,
                 })
             });
 
@@ -0,0 +1,676 @@
+package main
+
+import (
+	"fmt"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/balancing"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/booking"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/resilience"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/venue"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"net"
+	"net/mail"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config is the gateway's configuration. It is read from a YAML or JSON file, with environment variables overriding the
+// file so secrets can be kept out of it and existing deployments configured with the environment work unchanged.
+type Config struct {
+	Port          int           `yaml:"port"`
+	MetricsPort   int           `yaml:"metricsPort"`
+	TraceExporter string        `yaml:"traceExporter"`
+	CORS          CORSConfig    `yaml:"cors"`
+	Server        ServerConfig  `yaml:"server"`
+	OIDC          OIDCConfig    `yaml:"oidc"`
+	Venue         ServiceConfig `yaml:"venue"`
+	Booking       ServiceConfig `yaml:"booking"`
+	GuestBookings GuestConfig   `yaml:"guestBookings"`
+	Cache         CacheConfig   `yaml:"cache"`
+	Queries       QueryConfig   `yaml:"queries"`
+}
+
+type CORSConfig struct {
+	// AllowOrigins lists the origins browsers may call the api from. CORS headers are not sent when it is empty.
+	AllowOrigins []string `yaml:"allowOrigins"`
+}
+
+type ServerConfig struct {
+	ReadTimeout  time.Duration `yaml:"readTimeout"`
+	WriteTimeout time.Duration `yaml:"writeTimeout"`
+	IdleTimeout  time.Duration `yaml:"idleTimeout"`
+	// ShutdownTimeout is how long requests in flight are given to finish once the gateway is told to stop.
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout"`
+	// ReadinessTimeout is how long each dependency is given to answer the readiness check.
+	ReadinessTimeout time.Duration `yaml:"readinessTimeout"`
+}
+
+type OIDCConfig struct {
+	Issuer          string      `yaml:"issuer"`
+	Audience        string      `yaml:"audience"`
+	ClientID        string      `yaml:"clientId"`
+	ClientSecret    Secret      `yaml:"clientSecret"`
+	ClaimsNamespace string      `yaml:"claimsNamespace"`
+	Claims          ClaimConfig `yaml:"claims"`
+}
+
+// ClaimConfig overrides the claims a user is read from, each listing claim names tried in order.
+type ClaimConfig struct {
+	Email         []string `yaml:"email,omitempty"`
+	EmailVerified []string `yaml:"emailVerified,omitempty"`
+	GivenName     []string `yaml:"givenName,omitempty"`
+	FamilyName    []string `yaml:"familyName,omitempty"`
+	Roles         []string `yaml:"roles,omitempty"`
+}
+
+// ServiceConfig says how to reach one of the gRPC services the gateway forwards to.
+type ServiceConfig struct {
+	URL      string    `yaml:"url"`
+	Audience string    `yaml:"audience"`
+	TLS      TLSConfig `yaml:"tls"`
+	// Timeout is the deadline of calls to the service, unless the client gives a method its own.
+	Timeout        time.Duration `yaml:"timeout"`
+	MaxRetries     int           `yaml:"maxRetries"`
+	CircuitBreaker BreakerConfig `yaml:"circuitBreaker"`
+	// Endpoints are the addresses of the service's replicas, which calls are balanced across round robin. The url is
+	// dialled when none are given, and may name a resolver, such as dns:///venue:8888, to balance across the addresses
+	// it resolves to.
+	Endpoints []string `yaml:"endpoints"`
+	// HealthCheck stops calls going to replicas that are not serving, as reported with the gRPC health protocol.
+	HealthCheck bool            `yaml:"healthCheck"`
+	Keepalive   KeepaliveConfig `yaml:"keepalive"`
+}
+
+// BreakerConfig says when calls to a failing service are cut off. A failure threshold of zero disables the breaker.
+type BreakerConfig struct {
+	FailureThreshold int           `yaml:"failureThreshold"`
+	OpenFor          time.Duration `yaml:"openFor"`
+}
+
+// Policy applies the configuration to a client's default resilience policy.
+func (s ServiceConfig) Policy(policy resilience.Policy) resilience.Policy {
+	policy.Timeout = s.Timeout
+	policy.MaxRetries = s.MaxRetries
+	policy.FailureThreshold = s.CircuitBreaker.FailureThreshold
+	policy.OpenFor = s.CircuitBreaker.OpenFor
+
+	return policy
+}
+
+// KeepaliveConfig says how often idle connections are pinged, and how long a ping may go unanswered before the
+// connection is closed. A time of zero disables pings.
+type KeepaliveConfig struct {
+	Time    time.Duration `yaml:"time"`
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// Balancing applies the configuration to a client's default balancing options.
+func (s ServiceConfig) Balancing(options balancing.Options) balancing.Options {
+	options.Endpoints = s.Endpoints
+	if len(options.Endpoints) == 0 {
+		options.Endpoints = []string{s.URL}
+	}
+	options.HealthCheck = s.HealthCheck
+	options.KeepaliveTime = s.Keepalive.Time
+	options.KeepaliveTimeout = s.Keepalive.Timeout
+
+	return options
+}
+
+func serviceConfig(audience string, policy resilience.Policy, options balancing.Options) ServiceConfig {
+	return ServiceConfig{
+		Audience:   audience,
+		TLS:        TLSConfig{CertFile: "localhost.crt", ServerName: "localhost"},
+		Timeout:    policy.Timeout,
+		MaxRetries: policy.MaxRetries,
+		CircuitBreaker: BreakerConfig{
+			FailureThreshold: policy.FailureThreshold,
+			OpenFor:          policy.OpenFor,
+		},
+		HealthCheck: options.HealthCheck,
+		Keepalive: KeepaliveConfig{
+			Time:    options.KeepaliveTime,
+			Timeout: options.KeepaliveTimeout,
+		},
+	}
+}
+
+type TLSConfig struct {
+	CertFile   string `yaml:"certFile"`
+	ServerName string `yaml:"serverName"`
+}
+
+type GuestConfig struct {
+	// Secret signs guest booking links. Guest bookings are disabled when it is empty.
+	Secret  Secret        `yaml:"secret"`
+	URL     string        `yaml:"url"`
+	LinkTTL time.Duration `yaml:"linkTTL"`
+	// RequestLimit is how many links may be sent to an email, and requested from an address, each hour.
+	RequestLimit int        `yaml:"requestLimit"`
+	SMTP         SMTPConfig `yaml:"smtp"`
+	// LogLinks logs links instead of emailing them, for local development without a mail server. Anyone reading the logs
+	// can follow the links, so it must not be set in production.
+	LogLinks bool `yaml:"logLinks"`
+}
+
+// SMTPConfig says how guest booking links are emailed.
+type SMTPConfig struct {
+	// Addr is the host:port of the mail server.
+	Addr string `yaml:"addr"`
+	// From is the address links are sent from.
+	From string `yaml:"from"`
+	// Username and Password sign in to the mail server, which is not signed in to when the username is empty.
+	Username string `yaml:"username"`
+	Password Secret `yaml:"password"`
+}
+
+type CacheConfig struct {
+	UserTTL  time.Duration `yaml:"userTTL"`
+	AdminTTL time.Duration `yaml:"adminTTL"`
+	// VenueTTL, TablesTTL and OpeningHoursTTL are how long venue data is cached, or zero to always ask the venue service.
+	// Changes made through this gateway, or replicas sharing its redis, are seen straight away, those made through other
+	// replicas once the ttl has passed.
+	VenueTTL        time.Duration `yaml:"venueTTL"`
+	TablesTTL       time.Duration `yaml:"tablesTTL"`
+	OpeningHoursTTL time.Duration `yaml:"openingHoursTTL"`
+	// APIKeyTTL is how long a verified api key is remembered, or zero to verify every call with the venue service. Keys
+	// revoked through replicas not sharing this gateway's redis are accepted until the ttl has passed.
+	APIKeyTTL time.Duration `yaml:"apiKeyTTL"`
+	// RedisURL is the redis shared by the gateway's replicas, such as redis://:password@redis:6379/0, holding the cache in
+	// place of each replica's memory. It must be given for guest bookings, so each link is used once across replicas.
+	RedisURL Secret `yaml:"redisURL"`
+}
+
+// VenueCacheTTL returns how long the venue client caches each kind of venue data.
+func (c CacheConfig) VenueCacheTTL() venue.CacheTTL {
+	return venue.CacheTTL{
+		Venue:        c.VenueTTL,
+		Tables:       c.TablesTTL,
+		OpeningHours: c.OpeningHoursTTL,
+		APIKey:       c.APIKeyTTL,
+	}
+}
+
+// QueryConfig limits the GraphQL operations the gateway runs.
+type QueryConfig struct {
+	// ComplexityLimit is the most an operation may cost, fields calling out to other services costing more than others.
+	ComplexityLimit int `yaml:"complexityLimit"`
+	// DepthLimit is how deeply an operation's fields may be nested.
+	DepthLimit int `yaml:"depthLimit"`
+	// PersistedQueryCacheSize is how many automatic persisted queries are remembered, or zero to disable them.
+	PersistedQueryCacheSize int `yaml:"persistedQueryCacheSize"`
+	// AllowList lists glob patterns of the .graphql files holding the only operations the gateway runs. Any operation
+	// within the limits is run when it is empty.
+	AllowList []string `yaml:"allowList"`
+}
+
+// Documents reads the files of the allow list, keyed by their paths.
+func (q QueryConfig) Documents() (map[string]string, error) {
+	documents := map[string]string{}
+	for _, pattern := range q.AllowList {
+		paths, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("could not match %s : %w", pattern, err)
+		}
+		for _, path := range paths {
+			b, err := ioutil.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("could not read %s : %w", path, err)
+			}
+			documents[path] = string(b)
+		}
+	}
+
+	return documents, nil
+}
+
+// Secret is a configuration value that is redacted when the configuration is printed.
+type Secret string
+
+const redacted = "REDACTED"
+
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+
+	return redacted
+}
+
+func (s Secret) MarshalYAML() (interface{}, error) {
+	return s.String(), nil
+}
+
+// InvalidConfigError lists everything wrong with a configuration, so it can be fixed in one go.
+type InvalidConfigError []string
+
+func (e InvalidConfigError) Error() string {
+	return fmt.Sprintf("invalid config : [%s]", strings.Join(e, ", "))
+}
+
+func defaultConfig() Config {
+	return Config{
+		Port:        9999,
+		MetricsPort: 9998,
+		Server: ServerConfig{
+			ReadTimeout:  10 * time.Second,
+			WriteTimeout: 30 * time.Second,
+			IdleTimeout:  2 * time.Minute,
+			// longer than the write timeout, so requests that can still finish in time are not cut off
+			ShutdownTimeout:  35 * time.Second,
+			ReadinessTimeout: 2 * time.Second,
+		},
+		OIDC: OIDCConfig{
+			ClaimsNamespace: models.DefaultClaimsNamespace,
+		},
+		Venue:   serviceConfig("http://venue", venue.DefaultPolicy(), venue.DefaultBalancing()),
+		Booking: serviceConfig("http://booking", booking.DefaultPolicy(), booking.DefaultBalancing()),
+		GuestBookings: GuestConfig{
+			// the page of the web app that confirms a guest booking from the token in its query
+			URL:          "http://localhost:3000/bookings/confirm",
+			LinkTTL:      30 * time.Minute,
+			RequestLimit: 5,
+		},
+		Cache: CacheConfig{
+			UserTTL:         5 * time.Minute,
+			AdminTTL:        5 * time.Minute,
+			VenueTTL:        time.Minute,
+			TablesTTL:       time.Minute,
+			OpeningHoursTTL: time.Minute,
+			APIKeyTTL:       30 * time.Second,
+		},
+		Queries: QueryConfig{
+			ComplexityLimit:         1000,
+			DepthLimit:              10,
+			PersistedQueryCacheSize: 100,
+		},
+	}
+}
+
+// LoadConfig reads the configuration file at path, if one is given, over the defaults and then applies the environment.
+// Every problem found is reported together in an InvalidConfigError.
+func LoadConfig(path string, env func(string) (string, bool)) (*Config, error) {
+	c := defaultConfig()
+	if path != "" {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read config file : %w", err)
+		}
+		// JSON is valid YAML, so either can be given
+		if err := yaml.UnmarshalStrict(b, &c); err != nil {
+			return nil, fmt.Errorf("could not parse config file : %w", err)
+		}
+	}
+
+	problems := c.override(env)
+	problems = append(problems, c.validate()...)
+	if len(problems) > 0 {
+		return &c, InvalidConfigError(problems)
+	}
+
+	return &c, nil
+}
+
+// override sets the configuration from the environment. Where several variables are named the first that is set wins,
+// the OIDC ones taking precedence over the Auth0 ones they replaced.
+func (c *Config) override(env func(string) (string, bool)) []string {
+	overrides := []struct {
+		names []string
+		set   func(string) error
+	}{
+		{[]string{"PORT"}, setInt(&c.Port)},
+		{[]string{"METRICS_PORT"}, setInt(&c.MetricsPort)},
+		{[]string{"TRACE_EXPORTER"}, setString(&c.TraceExporter)},
+		{[]string{"ALLOW_CORS"}, setOrigins(&c.CORS.AllowOrigins)},
+		{[]string{"READ_TIMEOUT"}, setDuration(&c.Server.ReadTimeout)},
+		{[]string{"WRITE_TIMEOUT"}, setDuration(&c.Server.WriteTimeout)},
+		{[]string{"IDLE_TIMEOUT"}, setDuration(&c.Server.IdleTimeout)},
+		{[]string{"SHUTDOWN_TIMEOUT"}, setDuration(&c.Server.ShutdownTimeout)},
+		{[]string{"READINESS_TIMEOUT"}, setDuration(&c.Server.ReadinessTimeout)},
+		{[]string{"OIDC_ISSUER", "AUTH0_DOMAIN"}, setString(&c.OIDC.Issuer)},
+		{[]string{"OIDC_AUDIENCE", "AUTH0_API_IDENTIFIER"}, setString(&c.OIDC.Audience)},
+		{[]string{"OIDC_CLIENT_ID", "AUTH0_CLIENT_ID"}, setString(&c.OIDC.ClientID)},
+		{[]string{"OIDC_CLIENT_SECRET", "AUTH0_CLIENT_SECRET"}, setSecret(&c.OIDC.ClientSecret)},
+		{[]string{"OIDC_CLAIMS_NAMESPACE", "AUTH0_CLAIMS_NAMESPACE"}, setString(&c.OIDC.ClaimsNamespace)},
+		{[]string{"OIDC_CLAIM_EMAIL"}, setList(&c.OIDC.Claims.Email)},
+		{[]string{"OIDC_CLAIM_EMAIL_VERIFIED"}, setList(&c.OIDC.Claims.EmailVerified)},
+		{[]string{"OIDC_CLAIM_GIVEN_NAME"}, setList(&c.OIDC.Claims.GivenName)},
+		{[]string{"OIDC_CLAIM_FAMILY_NAME"}, setList(&c.OIDC.Claims.FamilyName)},
+		{[]string{"OIDC_CLAIM_ROLES"}, setList(&c.OIDC.Claims.Roles)},
+		{[]string{"VENUE_API_ROOT"}, setString(&c.Venue.URL)},
+		{[]string{"VENUE_API_AUDIENCE", "AUTH0_VENUE_API_IDENTIFIER"}, setString(&c.Venue.Audience)},
+		{[]string{"VENUE_API_CERT_FILE"}, setString(&c.Venue.TLS.CertFile)},
+		{[]string{"VENUE_API_SERVER_NAME"}, setString(&c.Venue.TLS.ServerName)},
+		{[]string{"VENUE_API_TIMEOUT"}, setDuration(&c.Venue.Timeout)},
+		{[]string{"VENUE_API_MAX_RETRIES"}, setInt(&c.Venue.MaxRetries)},
+		{[]string{"VENUE_API_BREAKER_THRESHOLD"}, setInt(&c.Venue.CircuitBreaker.FailureThreshold)},
+		{[]string{"VENUE_API_BREAKER_OPEN_FOR"}, setDuration(&c.Venue.CircuitBreaker.OpenFor)},
+		{[]string{"VENUE_API_ENDPOINTS"}, setList(&c.Venue.Endpoints)},
+		{[]string{"VENUE_API_HEALTH_CHECK"}, setBool(&c.Venue.HealthCheck)},
+		{[]string{"VENUE_API_KEEPALIVE_TIME"}, setDuration(&c.Venue.Keepalive.Time)},
+		{[]string{"VENUE_API_KEEPALIVE_TIMEOUT"}, setDuration(&c.Venue.Keepalive.Timeout)},
+		{[]string{"BOOKING_API_ROOT"}, setString(&c.Booking.URL)},
+		{[]string{"BOOKING_API_AUDIENCE", "AUTH0_BOOKING_API_IDENTIFIER"}, setString(&c.Booking.Audience)},
+		{[]string{"BOOKING_API_CERT_FILE"}, setString(&c.Booking.TLS.CertFile)},
+		{[]string{"BOOKING_API_SERVER_NAME"}, setString(&c.Booking.TLS.ServerName)},
+		{[]string{"BOOKING_API_TIMEOUT"}, setDuration(&c.Booking.Timeout)},
+		{[]string{"BOOKING_API_MAX_RETRIES"}, setInt(&c.Booking.MaxRetries)},
+		{[]string{"BOOKING_API_BREAKER_THRESHOLD"}, setInt(&c.Booking.CircuitBreaker.FailureThreshold)},
+		{[]string{"BOOKING_API_BREAKER_OPEN_FOR"}, setDuration(&c.Booking.CircuitBreaker.OpenFor)},
+		{[]string{"BOOKING_API_ENDPOINTS"}, setList(&c.Booking.Endpoints)},
+		{[]string{"BOOKING_API_HEALTH_CHECK"}, setBool(&c.Booking.HealthCheck)},
+		{[]string{"BOOKING_API_KEEPALIVE_TIME"}, setDuration(&c.Booking.Keepalive.Time)},
+		{[]string{"BOOKING_API_KEEPALIVE_TIMEOUT"}, setDuration(&c.Booking.Keepalive.Timeout)},
+		{[]string{"GUEST_BOOKING_SECRET"}, setSecret(&c.GuestBookings.Secret)},
+		{[]string{"GUEST_BOOKING_URL"}, setString(&c.GuestBookings.URL)},
+		{[]string{"GUEST_BOOKING_LINK_TTL"}, setDuration(&c.GuestBookings.LinkTTL)},
+		{[]string{"GUEST_BOOKING_REQUEST_LIMIT"}, setInt(&c.GuestBookings.RequestLimit)},
+		{[]string{"GUEST_BOOKING_SMTP_ADDR"}, setString(&c.GuestBookings.SMTP.Addr)},
+		{[]string{"GUEST_BOOKING_SMTP_FROM"}, setString(&c.GuestBookings.SMTP.From)},
+		{[]string{"GUEST_BOOKING_SMTP_USERNAME"}, setString(&c.GuestBookings.SMTP.Username)},
+		{[]string{"GUEST_BOOKING_SMTP_PASSWORD"}, setSecret(&c.GuestBookings.SMTP.Password)},
+		{[]string{"GUEST_BOOKING_LOG_LINKS"}, setBool(&c.GuestBookings.LogLinks)},
+		{[]string{"USER_CACHE_TTL"}, setDuration(&c.Cache.UserTTL)},
+		{[]string{"ADMIN_CACHE_TTL"}, setDuration(&c.Cache.AdminTTL)},
+		{[]string{"VENUE_CACHE_TTL"}, setDuration(&c.Cache.VenueTTL)},
+		{[]string{"TABLES_CACHE_TTL"}, setDuration(&c.Cache.TablesTTL)},
+		{[]string{"OPENING_HOURS_CACHE_TTL"}, setDuration(&c.Cache.OpeningHoursTTL)},
+		{[]string{"API_KEY_CACHE_TTL"}, setDuration(&c.Cache.APIKeyTTL)},
+		{[]string{"REDIS_URL"}, setSecret(&c.Cache.RedisURL)},
+		{[]string{"QUERY_COMPLEXITY_LIMIT"}, setInt(&c.Queries.ComplexityLimit)},
+		{[]string{"QUERY_DEPTH_LIMIT"}, setInt(&c.Queries.DepthLimit)},
+		{[]string{"PERSISTED_QUERY_CACHE_SIZE"}, setInt(&c.Queries.PersistedQueryCacheSize)},
+		{[]string{"QUERY_ALLOW_LIST"}, setList(&c.Queries.AllowList)},
+	}
+
+	problems := []string{}
+	for _, o := range overrides {
+		for _, name := range o.names {
+			v, ok := env(name)
+			if !ok {
+				continue
+			}
+			if err := o.set(v); err != nil {
+				problems = append(problems, fmt.Sprintf("%s %s", name, err))
+			}
+			break
+		}
+	}
+
+	return problems
+}
+
+func (c Config) validate() []string {
+	problems := []string{}
+	invalid := func(format string, a ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, a...))
+	}
+
+	for name, port := range map[string]int{"port": c.Port, "metricsPort": c.MetricsPort} {
+		if port < 1 || port > 65535 {
+			invalid("%s must be between 1 and 65535", name)
+		}
+	}
+	if c.Port == c.MetricsPort {
+		invalid("port and metricsPort must differ")
+	}
+	if c.TraceExporter != "" && c.TraceExporter != "stdout" && c.TraceExporter != "otlp" {
+		invalid("traceExporter must be one of stdout or otlp")
+	}
+	for _, origin := range c.CORS.AllowOrigins {
+		if origin != "*" && !isAbsoluteURL(origin) {
+			invalid("cors.allowOrigins %q must be an absolute url", origin)
+		}
+	}
+	for name, timeout := range map[string]time.Duration{
+		"server.readTimeout":  c.Server.ReadTimeout,
+		"server.writeTimeout": c.Server.WriteTimeout,
+		"server.idleTimeout":  c.Server.IdleTimeout,
+	} {
+		if timeout < 0 {
+			invalid("%s must not be negative", name)
+		}
+	}
+	for name, timeout := range map[string]time.Duration{
+		"server.shutdownTimeout":  c.Server.ShutdownTimeout,
+		"server.readinessTimeout": c.Server.ReadinessTimeout,
+	} {
+		if timeout <= 0 {
+			invalid("%s must be positive", name)
+		}
+	}
+
+	if !isAbsoluteURL(c.OIDC.Issuer) {
+		invalid("oidc.issuer must be an absolute url")
+	}
+	if c.OIDC.Audience == "" {
+		invalid("oidc.audience must be given")
+	}
+
+	for name, service := range map[string]ServiceConfig{"venue": c.Venue, "booking": c.Booking} {
+		if service.URL == "" && len(service.Endpoints) == 0 {
+			invalid("%s.url or %s.endpoints must be given", name, name)
+		}
+		if len(service.Endpoints) > 1 {
+			for _, endpoint := range service.Endpoints {
+				if strings.Contains(endpoint, "://") {
+					invalid("%s.endpoints %q must be an address when more than one is given", name, endpoint)
+				}
+			}
+		}
+		if service.Audience == "" {
+			invalid("%s.audience must be given", name)
+		}
+		if _, err := os.Stat(service.TLS.CertFile); err != nil {
+			invalid("%s.tls.certFile could not be read : %s", name, err)
+		}
+		if service.Timeout <= 0 {
+			invalid("%s.timeout must be positive", name)
+		}
+		if service.MaxRetries < 0 {
+			invalid("%s.maxRetries must not be negative", name)
+		}
+		if service.CircuitBreaker.FailureThreshold < 0 {
+			invalid("%s.circuitBreaker.failureThreshold must not be negative", name)
+		}
+		if service.CircuitBreaker.FailureThreshold > 0 && service.CircuitBreaker.OpenFor <= 0 {
+			invalid("%s.circuitBreaker.openFor must be positive", name)
+		}
+		// gRPC will not ping more often than every ten seconds
+		if service.Keepalive.Time != 0 && service.Keepalive.Time < 10*time.Second {
+			invalid("%s.keepalive.time must be zero or at least 10s", name)
+		}
+		if service.Keepalive.Time > 0 && service.Keepalive.Timeout <= 0 {
+			invalid("%s.keepalive.timeout must be positive", name)
+		}
+	}
+
+	if c.GuestBookings.Secret != "" {
+		if len(c.GuestBookings.Secret) < 32 {
+			invalid("guestBookings.secret must be at least 32 bytes")
+		}
+		if !isAbsoluteURL(c.GuestBookings.URL) {
+			invalid("guestBookings.url must be an absolute url")
+		}
+		if c.GuestBookings.RequestLimit <= 0 {
+			invalid("guestBookings.requestLimit must be positive")
+		}
+		// used links and requests for them held in one replica's memory would be forgotten by the others and on restart
+		if c.Cache.RedisURL == "" {
+			invalid("cache.redisURL must be given for guest bookings")
+		}
+
+		smtp := c.GuestBookings.SMTP
+		switch {
+		case smtp.Addr == "" && !c.GuestBookings.LogLinks:
+			invalid("guestBookings.smtp.addr must be given, or guestBookings.logLinks set for local development")
+		case smtp.Addr != "" && c.GuestBookings.LogLinks:
+			invalid("guestBookings.smtp.addr and guestBookings.logLinks must not both be given")
+		case smtp.Addr != "":
+			if _, _, err := net.SplitHostPort(smtp.Addr); err != nil {
+				invalid("guestBookings.smtp.addr must be a host and port")
+			}
+			if _, err := mail.ParseAddress(smtp.From); err != nil {
+				invalid("guestBookings.smtp.from must be an email address")
+			}
+		}
+	}
+	if c.GuestBookings.LinkTTL <= 0 {
+		invalid("guestBookings.linkTTL must be positive")
+	}
+
+	for name, ttl := range map[string]time.Duration{"cache.userTTL": c.Cache.UserTTL, "cache.adminTTL": c.Cache.AdminTTL} {
+		if ttl <= 0 {
+			invalid("%s must be positive", name)
+		}
+	}
+	for name, ttl := range map[string]time.Duration{
+		"cache.venueTTL":        c.Cache.VenueTTL,
+		"cache.tablesTTL":       c.Cache.TablesTTL,
+		"cache.openingHoursTTL": c.Cache.OpeningHoursTTL,
+		"cache.apiKeyTTL":       c.Cache.APIKeyTTL,
+	} {
+		if ttl < 0 {
+			invalid("%s must not be negative", name)
+		}
+	}
+	if c.Cache.RedisURL != "" {
+		if u, err := url.Parse(string(c.Cache.RedisURL)); err != nil || (u.Scheme != "redis" && u.Scheme != "rediss") || u.Host == "" {
+			invalid("cache.redisURL must be a redis:// or rediss:// url")
+		}
+	}
+
+	for name, limit := range map[string]int{"queries.complexityLimit": c.Queries.ComplexityLimit, "queries.depthLimit": c.Queries.DepthLimit} {
+		if limit <= 0 {
+			invalid("%s must be positive", name)
+		}
+	}
+	if c.Queries.PersistedQueryCacheSize < 0 {
+		invalid("queries.persistedQueryCacheSize must not be negative")
+	}
+	for _, pattern := range c.Queries.AllowList {
+		if paths, err := filepath.Glob(pattern); err != nil || len(paths) == 0 {
+			invalid("queries.allowList %q must match a file", pattern)
+		}
+	}
+
+	// maps are iterated in random order, so problems are sorted to report them the same way each time
+	sort.Strings(problems)
+
+	return problems
+}
+
+// ClaimMapping reads profile claims by their standard names, or the namespaced ones Auth0 access tokens carry, unless a
+// claim has been mapped to other names.
+func (c OIDCConfig) ClaimMapping() models.ClaimMapping {
+	mapping := models.NewClaimMapping(c.ClaimsNamespace)
+
+	for _, claim := range []struct {
+		names   []string
+		mapping *[]string
+	}{
+		{c.Claims.Email, &mapping.Email},
+		{c.Claims.EmailVerified, &mapping.EmailVerified},
+		{c.Claims.GivenName, &mapping.GivenName},
+		{c.Claims.FamilyName, &mapping.FamilyName},
+		{c.Claims.Roles, &mapping.Roles},
+	} {
+		if len(claim.names) > 0 {
+			*claim.mapping = claim.names
+		}
+	}
+
+	return mapping
+}
+
+// Print returns the configuration as YAML with its secrets redacted.
+func (c Config) Print() (string, error) {
+	b, err := yaml.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("could not marshal config : %w", err)
+	}
+
+	return string(b), nil
+}
+
+func setString(p *string) func(string) error {
+	return func(v string) error {
+		*p = v
+		return nil
+	}
+}
+
+func setSecret(p *Secret) func(string) error {
+	return func(v string) error {
+		*p = Secret(v)
+		return nil
+	}
+}
+
+func setInt(p *int) func(string) error {
+	return func(v string) error {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("must be a number")
+		}
+		*p = i
+		return nil
+	}
+}
+
+func setBool(p *bool) func(string) error {
+	return func(v string) error {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("must be true or false")
+		}
+		*p = b
+		return nil
+	}
+}
+
+func setDuration(p *time.Duration) func(string) error {
+	return func(v string) error {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("must be a duration such as 30s or 5m")
+		}
+		*p = d
+		return nil
+	}
+}
+
+func setList(p *[]string) func(string) error {
+	return func(v string) error {
+		*p = nil
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				*p = append(*p, item)
+			}
+		}
+		return nil
+	}
+}
+
+// setOrigins reads a comma separated list of origins. An empty value allows any origin, as setting ALLOW_CORS did
+// before origins could be listed.
+func setOrigins(p *[]string) func(string) error {
+	return func(v string) error {
+		if strings.TrimSpace(v) == "" {
+			*p = []string{"*"}
+			return nil
+		}
+		return setList(p)(v)
+	}
+}
+
+func isAbsoluteURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/booking"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/venue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func Test_LoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	cert := writeFile(t, dir, "service.crt", "certificate")
+	writeFile(t, dir, "get_venue.graphql", "query GetVenue { getVenue { id } }")
+	writeFile(t, dir, "get_slot.graphql", "query GetSlot { getSlot { match { startsAt } } }")
+
+	tests := []struct {
+		name   string
+		file   string
+		env    map[string]string
+		expect func(t *testing.T, c *Config)
+	}{
+		{
+			name: "yaml file",
+			file: writeFile(t, dir, "config.yaml", `
+port: 8080
+cors:
+  allowOrigins: [https://booking.example.com]
+oidc:
+  issuer: https://auth.example.com/
+  audience: http://gateway
+venue:
+  url: venue:8888
+  tls: {certFile: `+cert+`, serverName: venue}
+booking:
+  url: booking:6969
+  audience: http://bookings
+  tls: {certFile: `+cert+`}
+cache:
+  adminTTL: 1m
+  venueTTL: 0s
+`),
+			expect: func(t *testing.T, c *Config) {
+				assert.Equal(t, 8080, c.Port)
+				assert.Equal(t, 9998, c.MetricsPort)
+				assert.Equal(t, []string{"https://booking.example.com"}, c.CORS.AllowOrigins)
+				assert.Equal(t, "http://venue", c.Venue.Audience)
+				assert.Equal(t, TLSConfig{CertFile: cert, ServerName: "venue"}, c.Venue.TLS)
+				assert.Equal(t, "http://bookings", c.Booking.Audience)
+				assert.Equal(t, "localhost", c.Booking.TLS.ServerName)
+				assert.Equal(t, time.Minute, c.Cache.AdminTTL)
+				assert.Equal(t, 5*time.Minute, c.Cache.UserTTL)
+				assert.Equal(t, venue.CacheTTL{Tables: time.Minute, OpeningHours: time.Minute, APIKey: 30 * time.Second}, c.Cache.VenueCacheTTL())
+				assert.Equal(t, 2*time.Second, c.Server.ReadinessTimeout)
+				assert.True(t, c.Venue.HealthCheck)
+				assert.Equal(t, 30*time.Second, c.Venue.Keepalive.Time)
+				assert.False(t, c.Booking.HealthCheck)
+				assert.Equal(t, []string{"booking:6969"}, c.Booking.Balancing(booking.DefaultBalancing()).Endpoints)
+			},
+		},
+		{
+			name: "replicas",
+			file: writeFile(t, dir, "replicas.yaml", `
+oidc: {issuer: "https://auth.example.com/", audience: http://gateway}
+venue:
+  endpoints: [venue-0:8888, venue-1:8888]
+  keepalive: {time: 1m, timeout: 20s}
+  tls: {certFile: `+cert+`}
+booking: {url: "dns:///booking:6969", tls: {certFile: `+cert+`}}
+`),
+			env: map[string]string{
+				"BOOKING_API_HEALTH_CHECK":   "true",
+				"BOOKING_API_KEEPALIVE_TIME": "0s",
+			},
+			expect: func(t *testing.T, c *Config) {
+				options := c.Venue.Balancing(venue.DefaultBalancing())
+				assert.Equal(t, []string{"venue-0:8888", "venue-1:8888"}, options.Endpoints)
+				assert.Equal(t, "venue.api.VenueAPI", options.ServiceName)
+				assert.Equal(t, time.Minute, options.KeepaliveTime)
+				assert.Equal(t, 20*time.Second, options.KeepaliveTimeout)
+				assert.True(t, c.Booking.HealthCheck)
+				assert.Equal(t, time.Duration(0), c.Booking.Keepalive.Time)
+			},
+		},
+		{
+			name: "json file",
+			file: writeFile(t, dir, "config.json", `{
+  "oidc": {"issuer": "https://auth.example.com/", "audience": "http://gateway"},
+  "venue": {"url": "venue:8888", "tls": {"certFile": "`+cert+`"}},
+  "booking": {"url": "booking:6969", "tls": {"certFile": "`+cert+`"}},
+  "guestBookings": {"linkTTL": "10m"}
+}`),
+			expect: func(t *testing.T, c *Config) {
+				assert.Equal(t, "venue:8888", c.Venue.URL)
+				assert.Equal(t, 10*time.Minute, c.GuestBookings.LinkTTL)
+			},
+		},
+		{
+			name: "allowed queries",
+			file: writeFile(t, dir, "queries.yaml", `
+oidc: {issuer: "https://auth.example.com/", audience: http://gateway}
+venue: {url: "venue:8888", tls: {certFile: `+cert+`}}
+booking: {url: "booking:6969", tls: {certFile: `+cert+`}}
+queries:
+  depthLimit: 5
+  allowList: [`+filepath.Join(dir, "*.graphql")+`]
+`),
+			env: map[string]string{
+				"QUERY_COMPLEXITY_LIMIT":     "500",
+				"PERSISTED_QUERY_CACHE_SIZE": "0",
+			},
+			expect: func(t *testing.T, c *Config) {
+				assert.Equal(t, QueryConfig{
+					ComplexityLimit: 500,
+					DepthLimit:      5,
+					AllowList:       []string{filepath.Join(dir, "*.graphql")},
+				}, c.Queries)
+
+				documents, err := c.Queries.Documents()
+				require.NoError(t, err)
+				assert.Equal(t, map[string]string{
+					filepath.Join(dir, "get_venue.graphql"): "query GetVenue { getVenue { id } }",
+					filepath.Join(dir, "get_slot.graphql"):  "query GetSlot { getSlot { match { startsAt } } }",
+				}, documents)
+			},
+		},
+		{
+			name: "environment without file",
+			env: map[string]string{
+				"OIDC_ISSUER":                "https://auth.example.com/",
+				"AUTH0_API_IDENTIFIER":       "http://gateway",
+				"VENUE_API_ROOT":             "venue:8888",
+				"AUTH0_VENUE_API_IDENTIFIER": "http://venues",
+				"VENUE_API_CERT_FILE":        cert,
+				"BOOKING_API_ROOT":           "booking:6969",
+				"BOOKING_API_CERT_FILE":      cert,
+				"ALLOW_CORS":                 "",
+				"OIDC_CLAIM_ROLES":           "roles, groups",
+			},
+			expect: func(t *testing.T, c *Config) {
+				assert.Equal(t, "http://gateway", c.OIDC.Audience)
+				assert.Equal(t, "http://venues", c.Venue.Audience)
+				assert.Equal(t, []string{"*"}, c.CORS.AllowOrigins)
+				assert.Equal(t, []string{"roles", "groups"}, c.OIDC.ClaimMapping().Roles)
+			},
+		},
+		{
+			name: "environment overrides file",
+			file: writeFile(t, dir, "override.yaml", `
+port: 8080
+oidc: {issuer: "https://auth.example.com/", audience: http://gateway}
+venue: {url: "venue:8888", audience: http://venue, tls: {certFile: `+cert+`}}
+booking: {url: "booking:6969", tls: {certFile: `+cert+`}}
+guestBookings: {secret: from-the-file-which-is-long-enough, smtp: {addr: "mail:25", from: bookings@example.com}}
+`),
+			env: map[string]string{
+				"PORT":                        "7070",
+				"VENUE_API_AUDIENCE":          "http://venue-api",
+				"GUEST_BOOKING_SECRET":        "from-the-environment-which-is-long-enough",
+				"GUEST_BOOKING_SMTP_ADDR":     "smtp.example.com:587",
+				"GUEST_BOOKING_SMTP_PASSWORD": "mail password",
+				"GUEST_BOOKING_REQUEST_LIMIT": "10",
+				"REDIS_URL":                   "redis://:redis-password@redis:6379/0",
+			},
+			expect: func(t *testing.T, c *Config) {
+				assert.Equal(t, 7070, c.Port)
+				assert.Equal(t, "http://venue-api", c.Venue.Audience)
+				assert.Equal(t, Secret("from-the-environment-which-is-long-enough"), c.GuestBookings.Secret)
+				assert.Equal(t, "smtp.example.com:587", c.GuestBookings.SMTP.Addr)
+				assert.Equal(t, "bookings@example.com", c.GuestBookings.SMTP.From)
+				assert.Equal(t, Secret("mail password"), c.GuestBookings.SMTP.Password)
+				assert.Equal(t, 10, c.GuestBookings.RequestLimit)
+				assert.Equal(t, Secret("redis://:redis-password@redis:6379/0"), c.Cache.RedisURL)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			c, err := LoadConfig(test.file, lookup(test.env))
+			require.NoError(t, err)
+
+			test.expect(t, c)
+		})
+	}
+}
+
+func Test_LoadConfigReportsEveryProblem(t *testing.T) {
+	file := writeFile(t, t.TempDir(), "config.yaml", `
+port: 0
+metricsPort: 70000
+traceExporter: jaeger
+cors: {allowOrigins: [localhost]}
+oidc: {issuer: auth.example.com}
+venue: {url: "venue:8888", tls: {certFile: missing.crt}}
+booking: {audience: ""}
+guestBookings: {secret: short, requestLimit: 0, smtp: {addr: mail.example.com, from: bookings}}
+cache: {userTTL: 0s, tablesTTL: -1s, apiKeyTTL: -1s, redisURL: "redis:6379"}
+server: {readinessTimeout: 0s}
+queries: {complexityLimit: 0, persistedQueryCacheSize: -1, allowList: [missing/*.graphql]}
+`)
+
+	_, err := LoadConfig(file, lookup(map[string]string{
+		"GUEST_BOOKING_LINK_TTL":   "forever",
+		"VENUE_API_ENDPOINTS":      "venue-0:8888, dns:///venue:8888",
+		"VENUE_API_HEALTH_CHECK":   "sometimes",
+		"VENUE_API_KEEPALIVE_TIME": "1s",
+		"QUERY_DEPTH_LIMIT":        "deep",
+	}))
+	require.Error(t, err)
+
+	problems, ok := err.(InvalidConfigError)
+	require.True(t, ok, "expected an InvalidConfigError, got %T", err)
+	assert.Equal(t, InvalidConfigError{
+		"VENUE_API_HEALTH_CHECK must be true or false",
+		"GUEST_BOOKING_LINK_TTL must be a duration such as 30s or 5m",
+		"QUERY_DEPTH_LIMIT must be a number",
+		"booking.audience must be given",
+		"booking.tls.certFile could not be read : stat localhost.crt: no such file or directory",
+		"booking.url or booking.endpoints must be given",
+		"cache.apiKeyTTL must not be negative",
+		"cache.redisURL must be a redis:// or rediss:// url",
+		"cache.tablesTTL must not be negative",
+		"cache.userTTL must be positive",
+		`cors.allowOrigins "localhost" must be an absolute url`,
+		"guestBookings.requestLimit must be positive",
+		"guestBookings.secret must be at least 32 bytes",
+		"guestBookings.smtp.addr must be a host and port",
+		"guestBookings.smtp.from must be an email address",
+		"metricsPort must be between 1 and 65535",
+		"oidc.audience must be given",
+		"oidc.issuer must be an absolute url",
+		"port must be between 1 and 65535",
+		`queries.allowList "missing/*.graphql" must match a file`,
+		"queries.complexityLimit must be positive",
+		"queries.persistedQueryCacheSize must not be negative",
+		"server.readinessTimeout must be positive",
+		"traceExporter must be one of stdout or otlp",
+		`venue.endpoints "dns:///venue:8888" must be an address when more than one is given`,
+		"venue.keepalive.time must be zero or at least 10s",
+		"venue.tls.certFile could not be read : stat missing.crt: no such file or directory",
+	}, problems)
+}
+
+func Test_LoadConfigUnknownKey(t *testing.T) {
+	file := writeFile(t, t.TempDir(), "config.yaml", "prot: 8080\n")
+
+	_, err := LoadConfig(file, lookup(nil))
+	assert.Error(t, err)
+}
+
+func Test_PrintConfigRedactsSecrets(t *testing.T) {
+	c := defaultConfig()
+	c.OIDC.ClientSecret = "client secret"
+	c.GuestBookings.Secret = "guest booking secret"
+	c.GuestBookings.SMTP.Password = "mail password"
+	c.Cache.RedisURL = "redis://:redis-password@redis:6379/0"
+
+	printed, err := c.Print()
+	require.NoError(t, err)
+
+	assert.NotContains(t, printed, "client secret")
+	assert.NotContains(t, printed, "guest booking secret")
+	assert.NotContains(t, printed, "mail password")
+	assert.NotContains(t, printed, "redis-password")
+	assert.Contains(t, printed, "clientSecret: REDACTED")
+	assert.Contains(t, printed, "secret: REDACTED")
+	assert.Contains(t, printed, "password: REDACTED")
+	assert.Contains(t, printed, "linkTTL: 30m0s")
+}
+
+func writeFile(t *testing.T, dir string, name string, content string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
+
+func lookup(env map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		v, ok := env[name]
+		return v, ok
+	}
+}
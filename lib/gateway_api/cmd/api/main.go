@@ -1,79 +1,223 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	mw "github.com/cobbinma/booking-platform/lib/gateway_api/cmd/api/middleware"
-	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/auth0"
 	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/booking"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/cache"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/guest"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/health"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/oidc"
 	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/venue"
+	"github.com/cobbinma/booking-platform/lib/tracing"
+	"github.com/gorilla/websocket"
 	"github.com/joho/godotenv"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
 	"go.uber.org/zap"
 	"net/http"
 	"os"
-	"strings"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/cobbinma/booking-platform/lib/gateway_api/graph"
 	"github.com/cobbinma/booking-platform/lib/gateway_api/graph/generated"
 )
 
-const defaultPort = "9999"
-
 func main() {
 	_ = godotenv.Load()
 
+	configFile := flag.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML or JSON config file")
+	printConfig := flag.Bool("print-config", false, "print the config with secrets redacted and exit")
+	flag.Parse()
+
+	if *printConfig {
+		if err := runPrintConfig(*configFile); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	logger, err := zap.NewProduction()
 	if err != nil {
 		panic("could not start logger" + err.Error())
 	}
 	log := logger.Sugar()
 
-	c, err := NewConfig()
+	c, err := LoadConfig(*configFile, os.LookupEnv)
+	if err != nil {
+		log.Fatalf("could not load config : %s", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	closeTracer, err := tracing.NewTracerProvider(ctx, "gateway_api", c.TraceExporter)
 	if err != nil {
-		log.Fatalf("could not construct config : %s", err)
+		log.Fatalf("could not construct tracer provider : %s", err)
 	}
+	defer closeTracer(log)
 
-	tokenClient, err := auth0.NewTokenClient(log, c.authDomain)
+	provider, err := oidc.Discover(ctx, c.OIDC.Issuer)
 	if err != nil {
-		log.Fatalf("could not create token client : %s", err)
+		log.Fatalf("could not discover identity provider : %s", err)
 	}
 
-	venueToken, err := tokenClient.GetToken(log, "http://venue")
+	readiness := health.NewReadiness(c.Server.ReadinessTimeout)
+	readiness.Add("identityProvider", provider.Ready)
+
+	userService, err := provider.UserService(c.OIDC.ClaimMapping(), oidc.WithUserCacheTTL(c.Cache.UserTTL))
 	if err != nil {
-		log.Fatalf("could not get venue client : %s", err)
+		log.Fatalf("could not create user service : %s", err)
 	}
 
-	venueClient, closeVenueClient, err := venue.NewVenueClient(c.venueURL, log, venueToken)
+	tokenClient, err := provider.TokenClient(log, c.OIDC.ClientID, string(c.OIDC.ClientSecret))
+	if err != nil {
+		log.Fatalf("could not create token client : %s", err)
+	}
+
+	venueTokens := tokenClient.TokenSource(c.Venue.Audience, "venue:read", "venue:write", "venue:admin", "venue:apikeys")
+	if _, err := venueTokens.Token(); err != nil {
+		log.Fatalf("could not get venue token : %s", err)
+	}
+
+	// the cache is held in memory unless redis is given to share it between the gateway's replicas
+	sharedCache := cache.NewMemory(time.Minute)
+	if c.Cache.RedisURL != "" {
+		redisCache, closeCache, err := cache.NewRedis(string(c.Cache.RedisURL), cache.WithReadiness(readiness))
+		if err != nil {
+			log.Fatalf("could not create cache : %s", err)
+		}
+		defer closeCache(log)
+		sharedCache = redisCache
+	}
+
+	venueClient, closeVenueClient, err := venue.NewVenueClient(c.Venue.URL, log, venueTokens,
+		venue.WithTLS(c.Venue.TLS.CertFile, c.Venue.TLS.ServerName),
+		venue.WithPolicy(c.Venue.Policy(venue.DefaultPolicy())),
+		venue.WithBalancing(c.Venue.Balancing(venue.DefaultBalancing())),
+		venue.WithReadiness(readiness),
+		venue.WithCache(sharedCache, c.Cache.VenueCacheTTL()))
 	if err != nil {
 		log.Fatalf("could not create venue client : %s", err)
 	}
 	defer closeVenueClient(log)
 
-	bookingToken, err := tokenClient.GetToken(log, "http://booking")
-	if err != nil {
-		log.Fatalf("could not get booking client : %s", err)
+	bookingTokens := tokenClient.TokenSource(c.Booking.Audience)
+	if _, err := bookingTokens.Token(); err != nil {
+		log.Fatalf("could not get booking token : %s", err)
 	}
 
-	bookingClient, closeBookingClient, err := booking.NewBookingClient(c.bookingURL, log, bookingToken)
+	bookingClient, closeBookingClient, err := booking.NewBookingClient(c.Booking.URL, log, bookingTokens,
+		booking.WithTLS(c.Booking.TLS.CertFile, c.Booking.TLS.ServerName),
+		booking.WithPolicy(c.Booking.Policy(booking.DefaultPolicy())),
+		booking.WithBalancing(c.Booking.Balancing(booking.DefaultBalancing())),
+		booking.WithReadiness(readiness))
 	if err != nil {
 		log.Fatalf("could not create booking client : %s", err)
 	}
 	defer closeBookingClient(log)
 
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(
-		generated.Config{Resolvers: graph.NewResolver(log, venueClient, bookingClient)}))
+	resolverOptions := []func(*graph.Resolver){graph.WithAdminCacheTTL(c.Cache.AdminTTL)}
+	if c.GuestBookings.Secret != "" {
+		var mailer guest.Mailer
+		if c.GuestBookings.LogLinks {
+			log.Warn("guest booking links are logged instead of emailed, which is only for local development")
+			mailer = guest.NewLogMailer(log)
+		} else {
+			smtp := c.GuestBookings.SMTP
+			mailer, err = guest.NewSMTPMailer(smtp.Addr, smtp.From, smtp.Username, string(smtp.Password))
+			if err != nil {
+				log.Fatalf("could not create mailer : %s", err)
+			}
+		}
+
+		guestBookings, err := guest.NewGuestBookings([]byte(c.GuestBookings.Secret), c.GuestBookings.URL, mailer, sharedCache,
+			guest.WithLinkTTL(c.GuestBookings.LinkTTL), guest.WithRequestLimit(c.GuestBookings.RequestLimit))
+		if err != nil {
+			log.Fatalf("could not create guest bookings : %s", err)
+		}
+		resolverOptions = append(resolverOptions, graph.WithGuestBookings(guestBookings))
+	} else {
+		log.Info("guest bookings are disabled, set GUEST_BOOKING_SECRET to enable them")
+	}
+
+	resolver := graph.NewResolver(log, venueClient, bookingClient, resolverOptions...)
+	upgrader := websocket.Upgrader{}
+	if len(c.CORS.AllowOrigins) > 0 {
+		upgrader.CheckOrigin = mw.CheckOrigin(c.CORS.AllowOrigins)
+	}
+	// the default server's transports, with websockets signed in when they are opened for subscriptions
+	schema := generated.NewExecutableSchema(graph.NewConfig(resolver))
+	srv := handler.New(schema)
+	srv.AddTransport(transport.Websocket{
+		Upgrader:              upgrader,
+		InitFunc:              mw.WebsocketInit(provider.Verifier(c.OIDC.Audience), c.OIDC.ClaimMapping(), userService),
+		KeepAlivePingInterval: 10 * time.Second,
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+	srv.SetQueryCache(lru.New(1000))
+	srv.Use(extension.Introspection{})
+	if c.Queries.PersistedQueryCacheSize > 0 {
+		srv.Use(extension.AutomaticPersistedQuery{Cache: lru.New(c.Queries.PersistedQueryCacheSize)})
+	}
+	srv.Use(extension.FixedComplexityLimit(c.Queries.ComplexityLimit))
+	srv.Use(graph.DepthLimit{Limit: c.Queries.DepthLimit})
+	if len(c.Queries.AllowList) > 0 {
+		documents, err := c.Queries.Documents()
+		if err != nil {
+			log.Fatalf("could not read allowed queries : %s", err)
+		}
+		allowList, err := graph.NewAllowList(schema.Schema(), documents)
+		if err != nil {
+			log.Fatalf("could not create allow list : %s", err)
+		}
+		srv.Use(allowList)
+	}
+	srv.SetErrorPresenter(graph.NewErrorPresenter(log))
+	srv.Use(graph.Metrics{})
+	srv.Use(graph.Tracing{})
+	srv.Use(resolver.Dataloaders())
 	e := echo.New()
+	// callers are found from the X-Forwarded-For header set by proxies on private networks, which cannot be spoofed
+	// by callers connecting directly
+	e.IPExtractor = echo.ExtractIPFromXFFHeader()
+	e.Use(mw.RequestID())
+	e.Use(mw.ClientIP())
+	e.Use(otelecho.Middleware("gateway_api"))
 	e.Use(mw.ZapLogger(logger))
 
-	if c.allowCors {
-		e.Use(middleware.CORSWithConfig(middleware.DefaultCORSConfig))
+	e.Server.ReadTimeout = c.Server.ReadTimeout
+	e.Server.WriteTimeout = c.Server.WriteTimeout
+	e.Server.IdleTimeout = c.Server.IdleTimeout
+
+	if len(c.CORS.AllowOrigins) > 0 {
+		cors := middleware.DefaultCORSConfig
+		cors.AllowOrigins = c.CORS.AllowOrigins
+		e.Use(middleware.CORSWithConfig(cors))
 	}
 
+	e.GET("/healthz", echo.WrapHandler(http.HandlerFunc(health.Live)))
+	e.GET("/readyz", echo.WrapHandler(readiness))
 	e.GET("/", echo.WrapHandler(playground.Handler("GraphQL playground", "/query")))
-	e.POST("/query", echo.WrapHandler(srv), mw.Auth(c.authDomain, c.authApiId), mw.User(auth0.NewUserService(c.authDomain)))
+	auth := []echo.MiddlewareFunc{mw.Auth(provider.Verifier(c.OIDC.Audience), c.OIDC.ClaimMapping(), venueClient), mw.User(userService)}
+	// websockets are opened with a GET, and as browsers cannot send headers with them are signed in when initialised
+	e.GET("/query", echo.WrapHandler(srv), auth...)
+	e.POST("/query", echo.WrapHandler(srv), auth...)
 	e.OPTIONS("/query", func(c echo.Context) error {
 		headers := c.Request().Header
 		for key, value := range headers {
@@ -82,74 +226,53 @@ func main() {
 		return c.NoContent(http.StatusOK)
 	})
 
-	log.Infof("connect to http://localhost:%s/ for GraphQL playground", c.port)
-	e.Logger.Fatal(e.Start(":" + c.port))
-}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	metrics := &http.Server{Addr: fmt.Sprintf(":%d", c.MetricsPort), Handler: mux}
+	go func() {
+		log.Infof("starting metrics listener on port %d", c.MetricsPort)
+		if err := metrics.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Errorf("could not serve metrics : %s", err)
+		}
+	}()
+
+	errs := make(chan error, 1)
+	go func() {
+		log.Infof("connect to http://localhost:%d/ for GraphQL playground", c.Port)
+		errs <- e.Start(fmt.Sprintf(":%d", c.Port))
+	}()
+
+	select {
+	case err := <-errs:
+		log.Fatalf("failed to serve : %s", err)
+	case <-ctx.Done():
+	}
+
+	// the deferred functions close the gRPC connections once requests in flight have finished with them
+	log.Info("shutting down server")
+	readiness.Shutdown()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), c.Server.ShutdownTimeout)
+	defer cancel()
 
-type Config struct {
-	port                string
-	allowCors           bool
-	authDomain          string
-	authApiId           string
-	venueURL            string
-	authVenueAudience   string
-	bookingURL          string
-	authBookingAudience string
+	if err := e.Shutdown(shutdownCtx); err != nil {
+		log.Warnf("could not drain server within %s : %s", c.Server.ShutdownTimeout, err)
+	}
+	if err := metrics.Shutdown(shutdownCtx); err != nil {
+		log.Errorf("could not shut down metrics listener : %s", err)
+	}
 }
 
-func NewConfig() (*Config, error) {
-	missing := []string{}
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = defaultPort
-	}
-	allowCors := false
-	if _, present := os.LookupEnv("ALLOW_CORS"); present {
-		allowCors = true
-	}
-	e := "AUTH0_DOMAIN"
-	domain, present := os.LookupEnv(e)
-	if !present {
-		missing = append(missing, e)
-	}
-	e = "AUTH0_API_IDENTIFIER"
-	apiId, present := os.LookupEnv(e)
-	if !present {
-		missing = append(missing, e)
-	}
-	e = "VENUE_API_ROOT"
-	venueURL, present := os.LookupEnv(e)
-	if !present {
-		missing = append(missing, e)
-	}
-	e = "AUTH0_VENUE_API_IDENTIFIER"
-	authVenueAudience, present := os.LookupEnv(e)
-	if !present {
-		missing = append(missing, e)
-	}
-	e = "AUTH0_BOOKING_API_IDENTIFIER"
-	authBookingAudience, present := os.LookupEnv(e)
-	if !present {
-		missing = append(missing, e)
-	}
-	e = "BOOKING_API_ROOT"
-	bookingURL, present := os.LookupEnv(e)
-	if !present {
-		missing = append(missing, e)
-	}
-
-	if len(missing) > 0 {
-		return nil, fmt.Errorf("missing environment variables [%s]", strings.Join(missing, ", "))
-	}
-
-	return &Config{
-		port:                port,
-		allowCors:           allowCors,
-		authDomain:          domain,
-		authApiId:           apiId,
-		venueURL:            venueURL,
-		authVenueAudience:   authVenueAudience,
-		bookingURL:          bookingURL,
-		authBookingAudience: authBookingAudience,
-	}, nil
+// runPrintConfig prints the config the gateway would run with, before reporting anything wrong with it.
+func runPrintConfig(configFile string) error {
+	c, err := LoadConfig(configFile, os.LookupEnv)
+	if c != nil {
+		printed, err := c.Print()
+		if err != nil {
+			return err
+		}
+		fmt.Print(printed)
+	}
+
+	return err
 }
@@ -0,0 +1,16 @@
+package middleware
+
+import (
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"github.com/labstack/echo/v4"
+)
+
+// ClientIP adds the address the request came from to the context, as the echo instance's ip extractor finds it.
+func ClientIP() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.SetRequest(c.Request().WithContext(models.AddClientIPToContext(c.Request().Context(), c.RealIP())))
+			return next(c)
+		}
+	}
+}
@@ -22,15 +22,16 @@ func ZapLogger(log *zap.Logger) echo.MiddlewareFunc {
 			req := c.Request()
 			res := c.Response()
 
-			id := req.Header.Get(echo.HeaderXRequestID)
+			// the response carries the id the request was given, which is the one it sent if that was safe to keep
+			id := res.Header().Get(echo.HeaderXRequestID)
 			if id == "" {
-				id = res.Header().Get(echo.HeaderXRequestID)
+				id = req.Header.Get(echo.HeaderXRequestID)
 			}
 
 			fields := []zapcore.Field{
 				zap.Int("status", res.Status),
 				zap.String("latency", time.Since(start).String()),
-				zap.String("id", id),
+				zap.String("request_id", id),
 				zap.String("method", req.Method),
 				zap.String("uri", req.RequestURI),
 				zap.String("host", req.Host),
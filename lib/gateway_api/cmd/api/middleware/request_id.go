@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"github.com/labstack/echo/v4"
+)
+
+const maxRequestIDLength = 128
+
+// RequestID adds an id to the context that correlates everything done for the request, keeping the one the caller sent
+// in the X-Request-ID header if it is safe to log. The id is sent back in the same header.
+func RequestID() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			id := c.Request().Header.Get(echo.HeaderXRequestID)
+			if !validRequestID(id) {
+				id = newRequestID()
+			}
+
+			c.Response().Header().Set(echo.HeaderXRequestID, id)
+			c.SetRequest(c.Request().WithContext(models.AddRequestIDToContext(c.Request().Context(), id)))
+			return next(c)
+		}
+	}
+}
+
+// validRequestID allows ids made of printable ascii, so a caller cannot break up log lines with the ones it sends.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r < '!' || r > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		// the id only correlates logs, so a request is not failed for want of one
+		return "unknown"
+	}
+
+	return hex.EncodeToString(b)
+}
@@ -1,16 +1,26 @@
 package middleware
 
 import (
+	"errors"
 	"fmt"
 	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
 	"github.com/labstack/echo/v4"
 )
 
+// User adds the user's profile to the context, looking it up with the service unless the access token already gave it.
+// Guests, who have no access token, are passed on without a user.
 func User(service models.UserService) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			ctx := c.Request().Context()
+			if _, err := models.GetUserFromContext(ctx); err == nil {
+				return next(c)
+			}
+
 			user, err := service.GetUser(ctx)
+			if errors.Is(err, models.ErrNoToken) {
+				return next(c)
+			}
 			if err != nil {
 				return fmt.Errorf("could not get user from service : %w", err)
 			}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"net/http"
+)
+
+// WebsocketInit signs a websocket connection in with the access token given as the Authorization of its init payload, as
+// browsers cannot send headers when opening one. Connections without a valid token are refused, as only signed in users
+// may subscribe.
+func WebsocketInit(verifier models.TokenVerifier, mapping models.ClaimMapping, users models.UserService) transport.WebsocketInitFunc {
+	return func(ctx context.Context, payload transport.InitPayload) (context.Context, error) {
+		token := payload.Authorization()
+		if token == "" {
+			return nil, models.ErrUnauthenticated
+		}
+
+		ctx, err := authenticate(ctx, verifier, mapping, token)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := models.GetUserFromContext(ctx); err == nil {
+			return ctx, nil
+		}
+
+		user, err := users.GetUser(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user from service : %w", err)
+		}
+
+		return models.AddUserToContext(ctx, *user), nil
+	}
+}
+
+// CheckOrigin allows websockets to be opened from pages served from the origins, which may include "*" to allow any.
+func CheckOrigin(allowOrigins []string) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		// only browsers send an origin, and other clients cannot be made to open a websocket by a page
+		if origin == "" {
+			return true
+		}
+		for _, allowed := range allowOrigins {
+			if allowed == "*" || allowed == origin {
+				return true
+			}
+		}
+
+		return false
+	}
+}
@@ -1,27 +1,45 @@
 module github.com/cobbinma/booking-platform/lib/gateway_api
 
-go 1.15
+go 1.16
 
 require (
 	github.com/99designs/gqlgen v0.13.0
-	github.com/auth0-community/go-auth0 v1.0.0
+	github.com/alicebob/miniredis/v2 v2.30.0
 	github.com/bradleyjkemp/cupaloy v2.3.0+incompatible
 	github.com/cobbinma/booking-platform/lib/protobuf v0.0.0
+	github.com/cobbinma/booking-platform/lib/tracing v0.0.0
 	github.com/golang/mock v1.4.4
+	github.com/gomodule/redigo v1.8.9
+	github.com/gorilla/websocket v1.4.2
 	github.com/joho/godotenv v1.3.0
-	github.com/labstack/echo/v4 v4.1.17
+	github.com/labstack/echo/v4 v4.2.2
 	github.com/mattn/go-colorable v0.1.8 // indirect
 	github.com/patrickmn/go-cache v2.1.0+incompatible
-	github.com/stretchr/testify v1.5.1
+	github.com/prometheus/client_golang v1.10.0
+	github.com/prometheus/client_model v0.2.0
+	github.com/stretchr/testify v1.7.0
 	github.com/vektah/gqlparser/v2 v2.1.0
+	go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho v0.20.0
+	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.20.0
+	go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp v0.20.0
+	go.opentelemetry.io/otel v0.20.0
+	go.opentelemetry.io/otel/oteltest v0.20.0
+	go.opentelemetry.io/otel/trace v0.20.0
 	go.uber.org/zap v1.16.0
 	golang.org/x/crypto v0.0.0-20201221181555-eec23a3978ad // indirect
 	golang.org/x/net v0.0.0-20210119194325-5f4716e94777 // indirect
-	golang.org/x/oauth2 v0.0.0-20180821212333-d2e6202438be
-	golang.org/x/sys v0.0.0-20210119212857-b64e53b001e4 // indirect
+	golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d
+	golang.org/x/sync v0.0.0-20201207232520-09787c993a3a
 	golang.org/x/text v0.3.5 // indirect
-	google.golang.org/grpc v1.35.0
-	gopkg.in/square/go-jose.v2 v2.1.7
+	google.golang.org/grpc v1.37.0
+	gopkg.in/square/go-jose.v2 v2.5.1
+	gopkg.in/yaml.v2 v2.3.0
 )
 
 replace github.com/cobbinma/booking-platform/lib/protobuf v0.0.0 => ./.protobuf
+
+replace github.com/cobbinma/booking-platform/lib/tracing v0.0.0 => ./.tracing
+
+// kept at the version gqlgen was released with, which its extensions and test client decode with. later versions
+// decode empty lists as nil
+replace github.com/mitchellh/mapstructure => github.com/mitchellh/mapstructure v0.0.0-20180203102830-a4e142e9c047
@@ -0,0 +1,270 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/dataloader"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBatchWait = 2 * time.Millisecond
+	// maxBatch is the most venues the venue service takes in one batch call
+	maxBatch = 100
+)
+
+type loadersKey struct{}
+
+// Dataloaders is a gqlgen extension giving each response its own loaders, so the venue fields it resolves are fetched
+// in batches, and each venue only once.
+type Dataloaders struct {
+	resolver *Resolver
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = Dataloaders{}
+
+// Dataloaders returns the extension batching the resolver's calls to services.
+func (r *Resolver) Dataloaders() Dataloaders {
+	return Dataloaders{resolver: r}
+}
+
+func (Dataloaders) ExtensionName() string {
+	return "Dataloaders"
+}
+
+func (Dataloaders) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (d Dataloaders) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	return next(context.WithValue(ctx, loadersKey{}, newLoaders(d.resolver)))
+}
+
+// loaders returns the loaders of the response being resolved. Without the Dataloaders extension each call gets loaders
+// of its own, so fields are still resolved, one venue at a time.
+func (r *Resolver) loaders(ctx context.Context) *loaders {
+	if l, ok := ctx.Value(loadersKey{}).(*loaders); ok {
+		return l
+	}
+
+	return newLoaders(r)
+}
+
+// loaders fetch the fields of venues in batches. Fields that take arguments have a loader for each set of arguments.
+type loaders struct {
+	venueService   VenueService
+	bookingService BookingService
+	wait           time.Duration
+
+	venues *dataloader.Loader
+	tables *dataloader.Loader
+	admins *dataloader.Loader
+
+	mu           sync.Mutex
+	isAdmin      map[string]*dataloader.Loader
+	openingHours map[string]*dataloader.Loader
+	bookings     map[string]*dataloader.Loader
+}
+
+func newLoaders(r *Resolver) *loaders {
+	l := &loaders{
+		venueService:   r.venueService,
+		bookingService: r.bookingService,
+		wait:           r.batchWait,
+		isAdmin:        map[string]*dataloader.Loader{},
+		openingHours:   map[string]*dataloader.Loader{},
+		bookings:       map[string]*dataloader.Loader{},
+	}
+
+	l.venues = dataloader.New(func(ctx context.Context, ids []string) (map[string]interface{}, error) {
+		venues, err := l.venueService.GetVenues(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]interface{}, len(venues))
+		for id, venue := range venues {
+			values[id] = venue
+		}
+
+		return values, nil
+	}, l.wait, maxBatch)
+
+	l.tables = dataloader.New(func(ctx context.Context, ids []string) (map[string]interface{}, error) {
+		tables, err := l.venueService.GetTablesBatch(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]interface{}, len(tables))
+		for id, venueTables := range tables {
+			values[id] = venueTables
+		}
+
+		return values, nil
+	}, l.wait, maxBatch)
+
+	l.admins = dataloader.New(func(ctx context.Context, ids []string) (map[string]interface{}, error) {
+		admins, err := l.venueService.GetAdminsBatch(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]interface{}, len(admins))
+		for id, emails := range admins {
+			values[id] = emails
+		}
+
+		return values, nil
+	}, l.wait, maxBatch)
+
+	return l
+}
+
+func (l *loaders) venue(ctx context.Context, id string) (*models.Venue, error) {
+	venue, found, err := l.venues.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, models.ErrVenueNotFound
+	}
+
+	return venue.(*models.Venue), nil
+}
+
+func (l *loaders) venueTables(ctx context.Context, venueID string) ([]*models.Table, error) {
+	tables, found, err := l.tables.Load(ctx, venueID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return []*models.Table{}, nil
+	}
+
+	return tables.([]*models.Table), nil
+}
+
+func (l *loaders) venueAdmins(ctx context.Context, venueID string) ([]string, error) {
+	admins, found, err := l.admins.Load(ctx, venueID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return []string{}, nil
+	}
+
+	return admins.([]string), nil
+}
+
+func (l *loaders) isVenueAdmin(ctx context.Context, venueID string, email string) (bool, error) {
+	loader := l.keyed(l.isAdmin, email, func(ctx context.Context, ids []string) (map[string]interface{}, error) {
+		administered, err := l.venueService.IsAdminBatch(ctx, ids, email)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]interface{}, len(administered))
+		for id, isAdmin := range administered {
+			values[id] = isAdmin
+		}
+
+		return values, nil
+	})
+
+	isAdmin, found, err := loader.Load(ctx, venueID)
+	if err != nil {
+		return false, err
+	}
+
+	return found && isAdmin.(bool), nil
+}
+
+// venueOpeningHours returns the hours the venue is open on the date, or nil if it is not open for business.
+func (l *loaders) venueOpeningHours(ctx context.Context, venueID string, date time.Time) (*models.OpeningHoursSpecification, error) {
+	loader := l.keyed(l.openingHours, date.Format(time.RFC3339), func(ctx context.Context, ids []string) (map[string]interface{}, error) {
+		specifications, err := l.venueService.OpeningHoursSpecificationBatch(ctx, ids, date)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]interface{}, len(specifications))
+		for id, specification := range specifications {
+			values[id] = specification
+		}
+
+		return values, nil
+	})
+
+	specification, found, err := loader.Load(ctx, venueID)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return specification.(*models.OpeningHoursSpecification), nil
+}
+
+type bookingsResult struct {
+	page *models.BookingsPage
+	err  error
+}
+
+// venueBookings returns a page of the venue's bookings. The booking service cannot look up the bookings of several
+// venues at once, so each venue is still a call of its own, but the calls of a batch are made together and a page
+// asked for more than once is only fetched once.
+func (l *loaders) venueBookings(ctx context.Context, venueID string, date time.Time, pageInfo models.PageInfo) (*models.BookingsPage, error) {
+	limit := "-"
+	if pageInfo.Limit != nil {
+		limit = strconv.Itoa(*pageInfo.Limit)
+	}
+	key := fmt.Sprintf("%s/%d/%s", date.Format(time.RFC3339), pageInfo.Page, limit)
+
+	loader := l.keyed(l.bookings, key, func(ctx context.Context, ids []string) (map[string]interface{}, error) {
+		results := make([]bookingsResult, len(ids))
+		var wg sync.WaitGroup
+		for i := range ids {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				page, err := l.bookingService.Bookings(ctx, models.BookingsFilter{VenueID: &ids[i], Date: date}, pageInfo)
+				results[i] = bookingsResult{page: page, err: err}
+			}(i)
+		}
+		wg.Wait()
+
+		values := make(map[string]interface{}, len(ids))
+		for i := range ids {
+			values[ids[i]] = results[i]
+		}
+
+		return values, nil
+	})
+
+	result, _, err := loader.Load(ctx, venueID)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(bookingsResult).page, result.(bookingsResult).err
+}
+
+// keyed returns the loader for the key from loaders, making one that fetches with fetch if there is none yet.
+func (l *loaders) keyed(loaders map[string]*dataloader.Loader, key string, fetch dataloader.Fetch) *dataloader.Loader {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	loader, ok := loaders[key]
+	if !ok {
+		loader = dataloader.New(fetch, l.wait, maxBatch)
+		loaders[key] = loader
+	}
+
+	return loader
+}
@@ -0,0 +1,117 @@
+package graph_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/cmd/api/middleware"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/graph"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/graph/generated"
+	mock_resolver "github.com/cobbinma/booking-platform/lib/gateway_api/graph/mock"
+	booking2 "github.com/cobbinma/booking-platform/lib/gateway_api/internal/booking"
+	venue2 "github.com/cobbinma/booking-platform/lib/gateway_api/internal/venue"
+	api2 "github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/booking/api"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	venue "github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/models"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"sync"
+	"testing"
+	"time"
+)
+
+func Test_DataloadersBatchVenueFields(t *testing.T) {
+	hopAndVine := "2b7b9d4e-5f0c-4b6a-8b0e-1c0d9f3e8a11"
+	theCrown := "9f4c2a1e-7d3b-4e5f-a6b7-c8d9e0f1a2b3"
+	date := time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctrl := gomock.NewController(t)
+	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
+	bookingClient := mock_resolver.NewMockBookingAPIClient(ctrl)
+
+	venueClient.EXPECT().BatchGetVenues(gomock.Any(), &api.BatchGetVenuesRequest{Ids: []string{hopAndVine, theCrown}}).
+		Return(&api.BatchGetVenuesResponse{Venues: []*venue.Venue{
+			{Id: hopAndVine, Name: "hop and vine", Slug: "hop-and-vine"},
+			{Id: theCrown, Name: "the crown", Slug: "the-crown"},
+		}}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{hopAndVine, theCrown}, Email: "test@test.com"}).
+		Return(&api.IsAdminBatchResponse{VenueIds: []string{hopAndVine, theCrown}}, nil)
+	venueClient.EXPECT().GetTablesBatch(gomock.Any(), &api.GetTablesBatchRequest{VenueIds: []string{hopAndVine, theCrown}}).
+		Return(&api.GetTablesBatchResponse{Venues: []*api.VenueTables{
+			{VenueId: hopAndVine, Tables: []*venue.Table{{Id: "table one", Name: "one", Capacity: 4}}},
+			{VenueId: theCrown, Tables: []*venue.Table{{Id: "table two", Name: "two", Capacity: 2}}},
+		}}, nil)
+	venueClient.EXPECT().GetAdminsBatch(gomock.Any(), &api.GetAdminsBatchRequest{VenueIds: []string{hopAndVine, theCrown}}).
+		Return(&api.GetAdminsBatchResponse{Venues: []*api.VenueAdmins{
+			{VenueId: hopAndVine, Admins: []string{"test@test.com"}},
+			{VenueId: theCrown, Admins: []string{"test@test.com", "landlord@test.com"}},
+		}}, nil)
+	venueClient.EXPECT().GetOpeningHoursSpecificationBatch(gomock.Any(), &api.GetOpeningHoursSpecificationBatchRequest{
+		VenueIds: []string{hopAndVine, theCrown},
+		Date:     date.Format(time.RFC3339),
+	}).Return(&api.GetOpeningHoursSpecificationBatchResponse{Venues: []*api.VenueOpeningHoursSpecification{
+		{VenueId: hopAndVine, Specification: &venue.OpeningHoursSpecification{DayOfWeek: 3, Opens: "10:00", Closes: "22:00"}},
+	}}, nil)
+	// the booking service has no batch call, so bookings are fetched once for each venue, at the same time
+	var mu sync.Mutex
+	var bookingsOf []string
+	bookingClient.EXPECT().GetBookings(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *api2.GetBookingsRequest, opts ...grpc.CallOption) (*api2.GetBookingsResponse, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			bookingsOf = append(bookingsOf, req.VenueId)
+			return &api2.GetBookingsResponse{Pages: 1}, nil
+		}).Times(2)
+
+	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
+	require.NoError(t, err)
+	bookingSrv, _, err := booking2.NewBookingClient("", nil, nil, booking2.WithClient(bookingClient))
+	require.NoError(t, err)
+
+	resolver := graph.NewResolver(zap.NewNop().Sugar(), venueSrv, bookingSrv, graph.WithBatchWait(50*time.Millisecond))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(resolver)))
+	h.Use(resolver.Dataloaders())
+	e := echo.New()
+	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
+	c := client.New(e)
+
+	type venueFields struct {
+		Name   string `json:"name"`
+		Tables []struct {
+			ID string `json:"id"`
+		} `json:"tables"`
+		Admins                    []string `json:"admins"`
+		OpeningHoursSpecification *struct {
+			Opens string `json:"opens"`
+		} `json:"openingHoursSpecification"`
+		Bookings struct {
+			Pages int `json:"pages"`
+		} `json:"bookings"`
+	}
+	var resp struct {
+		HopAndVine      venueFields `json:"hopAndVine"`
+		TheCrown        venueFields `json:"theCrown"`
+		HopAndVineAgain venueFields `json:"hopAndVineAgain"`
+	}
+	fields := `{name,tables{id},admins,openingHoursSpecification(date:"3000-01-01T00:00:00Z"){opens},bookings(filter:{date:"3000-01-01T00:00:00Z"},pageInfo:{page:0,limit:5}){pages}}`
+	c.MustPost(fmt.Sprintf(`{hopAndVine:getVenue(filter:{id:"%s"})%s theCrown:getVenue(filter:{id:"%s"})%s hopAndVineAgain:getVenue(filter:{id:"%s"})%s}`,
+		hopAndVine, fields, theCrown, fields, hopAndVine, fields), &resp)
+
+	assert.Equal(t, "hop and vine", resp.HopAndVine.Name)
+	assert.Equal(t, resp.HopAndVine, resp.HopAndVineAgain, "venue asked for twice should be resolved the same")
+	assert.Equal(t, "table one", resp.HopAndVine.Tables[0].ID)
+	assert.Equal(t, "10:00", resp.HopAndVine.OpeningHoursSpecification.Opens)
+	assert.Equal(t, 1, resp.HopAndVine.Bookings.Pages)
+
+	assert.Equal(t, "the crown", resp.TheCrown.Name)
+	assert.Equal(t, "table two", resp.TheCrown.Tables[0].ID)
+	assert.Equal(t, []string{"test@test.com", "landlord@test.com"}, resp.TheCrown.Admins)
+	assert.Nil(t, resp.TheCrown.OpeningHoursSpecification, "venue closed on the date should have no opening hours")
+	assert.ElementsMatch(t, []string{hopAndVine, theCrown}, bookingsOf)
+
+	ctrl.Finish()
+}
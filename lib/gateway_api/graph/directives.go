@@ -0,0 +1,29 @@
+package graph
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+)
+
+// Auth enforces the requirement a field declares with the auth directive. Guests, who have no user in the context, can
+// only resolve public fields. Requests made with a venue api key can also resolve fields that allow the key's role.
+func Auth(ctx context.Context, obj interface{}, next graphql.Resolver, requires models.Auth, apiKey *models.APIKeyRole) (interface{}, error) {
+	if requires == models.AuthPublic {
+		return next(ctx)
+	}
+
+	if key, err := models.GetAPIKeyFromContext(ctx); err == nil {
+		if apiKey == nil || !key.Role.Allows(*apiKey) {
+			return nil, models.ErrForbidden
+		}
+
+		return next(ctx)
+	}
+
+	if _, err := models.GetUserFromContext(ctx); err != nil {
+		return nil, models.ErrUnauthenticated
+	}
+
+	return next(ctx)
+}
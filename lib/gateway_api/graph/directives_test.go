@@ -0,0 +1,247 @@
+package graph_test
+
+import (
+	"fmt"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/cmd/api/middleware"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/graph"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/graph/generated"
+	mock_resolver "github.com/cobbinma/booking-platform/lib/gateway_api/graph/mock"
+	booking2 "github.com/cobbinma/booking-platform/lib/gateway_api/internal/booking"
+	venue2 "github.com/cobbinma/booking-platform/lib/gateway_api/internal/venue"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	api2 "github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/booking/api"
+	booking "github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/booking/models"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	venue "github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/models"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_AuthDirectiveOnEveryOperation(t *testing.T) {
+	schema := generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), nil, nil))).Schema()
+
+	for _, operation := range []string{"Query", "Mutation"} {
+		for _, field := range schema.Types[operation].Fields {
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+			directive := field.Directives.ForName("auth")
+			if !assert.NotNil(t, directive, "%s.%s does not declare who may call it", operation, field.Name) {
+				continue
+			}
+			assert.Contains(t, models.AllAuth, models.Auth(directive.Arguments.ForName("requires").Value.Raw))
+		}
+	}
+}
+
+func Test_GetVenueAsGuest(t *testing.T) {
+	venueID := "a3291740-e89f-4cc0-845c-75c4c39842c9"
+	ctrl := gomock.NewController(t)
+	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
+
+	venueClient.EXPECT().BatchGetVenues(gomock.Any(), &api.BatchGetVenuesRequest{Ids: []string{venueID}}).Return(&api.BatchGetVenuesResponse{Venues: []*venue.Venue{{
+		Id:           venueID,
+		Name:         "hop and vine",
+		OpeningHours: defaultOpeningHours(),
+		Slug:         "hop-and-vine",
+	}}}, nil).Times(2)
+
+	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
+	require.NoError(t, err)
+
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
+	e := echo.New()
+	e.POST("/", echo.WrapHandler(h), middleware.User(guestUserService{}))
+	c := client.New(e)
+
+	var resp struct {
+		GetVenue struct {
+			Name   string `json:"name"`
+			Tables []struct {
+				ID string `json:"id"`
+			} `json:"tables"`
+		} `json:"getVenue"`
+	}
+	c.MustPost(fmt.Sprintf(`{getVenue(filter:{id:"%s"}){name}}`, venueID), &resp)
+	assert.Equal(t, "hop and vine", resp.GetVenue.Name)
+
+	err = c.Post(fmt.Sprintf(`{getVenue(filter:{id:"%s"}){name,tables{id}}}`, venueID), &resp)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), models.ErrUnauthenticated.Error())
+
+	ctrl.Finish()
+}
+
+func Test_AddTableAsGuest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
+
+	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
+	require.NoError(t, err)
+
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
+	e := echo.New()
+	e.POST("/", echo.WrapHandler(h), middleware.User(guestUserService{}))
+	c := client.New(e)
+
+	var resp struct {
+		AddTable struct {
+			ID string `json:"id"`
+		} `json:"addTable"`
+	}
+	err = c.Post(`mutation{addTable(input:{venueId:"8a18e89b-339b-4e51-ab53-825aae59a070",name:"table one",capacity:4,version:1}){id}}`, &resp)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), models.ErrUnauthenticated.Error())
+
+	ctrl.Finish()
+}
+
+func Test_APIKey(t *testing.T) {
+	const (
+		venueID = "8a18e89b-339b-4e51-ab53-825aae59a070"
+		otherID = "a3291740-e89f-4cc0-845c-75c4c39842c9"
+		readKey = "bpk_read"
+		fullKey = "bpk_manage"
+	)
+	createdAt := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC).Format(time.RFC3339)
+
+	tests := []struct {
+		name   string
+		key    string
+		query  string
+		expect func(venueClient *mock_resolver.MockVenueAPIClient, bookingClient *mock_resolver.MockBookingAPIClient)
+		error  string
+	}{
+		{
+			name:  "read key can read its venue's tables",
+			key:   readKey,
+			query: fmt.Sprintf(`{getVenue(filter:{id:"%s"}){tables{id}}}`, venueID),
+			expect: func(venueClient *mock_resolver.MockVenueAPIClient, _ *mock_resolver.MockBookingAPIClient) {
+				venueClient.EXPECT().BatchGetVenues(gomock.Any(), &api.BatchGetVenuesRequest{Ids: []string{venueID}}).
+					Return(&api.BatchGetVenuesResponse{Venues: []*venue.Venue{{Id: venueID, Name: "hop and vine", Slug: "hop-and-vine"}}}, nil)
+				venueClient.EXPECT().GetTablesBatch(gomock.Any(), &api.GetTablesBatchRequest{VenueIds: []string{venueID}}).
+					Return(&api.GetTablesBatchResponse{Venues: []*api.VenueTables{{VenueId: venueID, Tables: []*venue.Table{{Id: "table", Name: "one", Capacity: 4}}}}}, nil)
+			},
+		},
+		{
+			name:  "read key cannot read another venue's tables",
+			key:   readKey,
+			query: fmt.Sprintf(`{getVenue(filter:{id:"%s"}){tables{id}}}`, otherID),
+			expect: func(venueClient *mock_resolver.MockVenueAPIClient, _ *mock_resolver.MockBookingAPIClient) {
+				venueClient.EXPECT().BatchGetVenues(gomock.Any(), &api.BatchGetVenuesRequest{Ids: []string{otherID}}).
+					Return(&api.BatchGetVenuesResponse{Venues: []*venue.Venue{{Id: otherID, Name: "other", Slug: "other"}}}, nil)
+			},
+			error: models.ErrForbidden.Error(),
+		},
+		{
+			name:  "read key cannot cancel bookings",
+			key:   readKey,
+			query: fmt.Sprintf(`mutation{cancelBooking(input:{venueId:"%s",id:"booking"}){id}}`, venueID),
+			error: models.ErrForbidden.Error(),
+		},
+		{
+			name:  "manage key can cancel its venue's bookings",
+			key:   fullKey,
+			query: fmt.Sprintf(`mutation{cancelBooking(input:{venueId:"%s",id:"booking"}){id}}`, venueID),
+			expect: func(_ *mock_resolver.MockVenueAPIClient, bookingClient *mock_resolver.MockBookingAPIClient) {
+				bookingClient.EXPECT().CancelBooking(gomock.Any(), &api2.CancelBookingRequest{Id: "booking"}).
+					Return(&booking.Booking{
+						Id:       "booking",
+						VenueId:  venueID,
+						Email:    "test@test.com",
+						People:   2,
+						StartsAt: createdAt,
+						EndsAt:   createdAt,
+						Duration: 60,
+						TableId:  "table",
+					}, nil)
+			},
+		},
+		{
+			name:  "manage key cannot change tables",
+			key:   fullKey,
+			query: fmt.Sprintf(`mutation{addTable(input:{venueId:"%s",name:"table one",capacity:4,version:1}){id}}`, venueID),
+			error: models.ErrForbidden.Error(),
+		},
+		{
+			name:  "manage key cannot issue keys",
+			key:   fullKey,
+			query: fmt.Sprintf(`mutation{createApiKey(input:{venueId:"%s",name:"till",role:MANAGE_BOOKINGS}){key}}`, venueID),
+			error: models.ErrForbidden.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
+			bookingClient := mock_resolver.NewMockBookingAPIClient(ctrl)
+
+			role := map[string]venue.ApiKeyRole{readKey: venue.ApiKeyRole_READ_BOOKINGS, fullKey: venue.ApiKeyRole_MANAGE_BOOKINGS}[test.key]
+			venueClient.EXPECT().VerifyApiKey(gomock.Any(), &api.VerifyApiKeyRequest{Key: test.key}).
+				Return(&venue.ApiKey{Id: "key", VenueId: venueID, Name: "till", Role: role, Prefix: "bpk_", CreatedAt: createdAt}, nil)
+			if test.expect != nil {
+				test.expect(venueClient, bookingClient)
+			}
+
+			c := apiKeyClient(t, venueClient, bookingClient)
+
+			var resp interface{}
+			err := c.Post(test.query, &resp, func(r *client.Request) {
+				r.HTTP.Header.Set(middleware.APIKeyHeader, test.key)
+			})
+			if test.error == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.error)
+			}
+
+			ctrl.Finish()
+		})
+	}
+}
+
+func Test_APIKeyRevoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
+
+	venueClient.EXPECT().VerifyApiKey(gomock.Any(), &api.VerifyApiKeyRequest{Key: "bpk_revoked"}).
+		Return(nil, status.Error(codes.NotFound, "could not find api key"))
+
+	c := apiKeyClient(t, venueClient, mock_resolver.NewMockBookingAPIClient(ctrl))
+
+	var resp interface{}
+	err := c.Post(`{getVenue(filter:{slug:"hop-and-vine"}){name}}`, &resp, func(r *client.Request) {
+		r.HTTP.Header.Set(middleware.APIKeyHeader, "bpk_revoked")
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprint(http.StatusUnauthorized))
+
+	ctrl.Finish()
+}
+
+func apiKeyClient(t *testing.T, venueClient api.VenueAPIClient, bookingClient api2.BookingAPIClient) *client.Client {
+	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
+	require.NoError(t, err)
+	bookingSrv, _, err := booking2.NewBookingClient("", nil, nil, booking2.WithClient(bookingClient))
+	require.NoError(t, err)
+
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, bookingSrv))))
+	e := echo.New()
+	e.POST("/", echo.WrapHandler(h), middleware.Auth(nil, models.NewClaimMapping(""), venueSrv), middleware.User(guestUserService{}))
+
+	return client.New(e)
+}
@@ -0,0 +1,141 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The extensions codes clients can handle errors by. They are part of the api, so must not be changed.
+const (
+	codeNotFound        = "NOT_FOUND"
+	codeForbidden       = "FORBIDDEN"
+	codeUnauthenticated = "UNAUTHENTICATED"
+	codeInvalidArgument = "INVALID_ARGUMENT"
+	codeConflict        = "CONFLICT"
+	codeRateLimited     = "RATE_LIMITED"
+	codeVersionConflict = "VERSION_CONFLICT"
+	codeUnavailable     = "UNAVAILABLE"
+	codeInternal        = "INTERNAL"
+)
+
+const msgInternal = "internal error"
+
+// domainErrors gives the code of each error the gateway returns to clients with its own message.
+var domainErrors = []struct {
+	err  error
+	code string
+}{
+	{models.ErrVersionConflict, codeVersionConflict},
+	{models.ErrServiceUnavailable, codeUnavailable},
+	{models.ErrUnauthenticated, codeUnauthenticated},
+	{models.ErrInvalidAPIKey, codeUnauthenticated},
+	{models.ErrVenueNotFound, codeNotFound},
+	{models.ErrForbidden, codeForbidden},
+	{models.ErrNotAdmin, codeForbidden},
+	{models.ErrGuestBookingsDisabled, codeForbidden},
+	{models.ErrInvalidBookingLink, codeInvalidArgument},
+	{models.ErrBookingLinkUsed, codeConflict},
+	{models.ErrTooManyRequests, codeRateLimited},
+	{models.ErrSlotUnavailable, codeConflict},
+}
+
+// NewErrorPresenter returns a presenter giving every error a stable extensions code that clients can handle it by, and
+// the id of the request so support can find the request a customer saw fail. Messages are replaced by ones safe to show
+// clients, with the full error logged instead.
+func NewErrorPresenter(log *zap.SugaredLogger) graphql.ErrorPresenterFunc {
+	return func(ctx context.Context, err error) *gqlerror.Error {
+		gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+
+		// errors gqlgen raises about the query itself say what is wrong with it, and are passed on as they are
+		if gqlErr.Unwrap() == nil {
+			return gqlErr
+		}
+
+		code, message := present(err)
+		id := models.GetRequestIDFromContext(ctx)
+
+		fields := []interface{}{"code", code, "path", gqlErr.Path.String(), "error", gqlErr.Unwrap().Error()}
+		if id != "" {
+			fields = append(fields, "request_id", id)
+		}
+		switch code {
+		case codeInternal, codeUnavailable:
+			log.Errorw("could not resolve field", fields...)
+		default:
+			log.Infow("could not resolve field", fields...)
+		}
+
+		gqlErr.Message = message
+		if gqlErr.Extensions == nil {
+			gqlErr.Extensions = map[string]interface{}{}
+		}
+		gqlErr.Extensions["code"] = code
+		if id != "" {
+			gqlErr.Extensions["requestId"] = id
+		}
+
+		return gqlErr
+	}
+}
+
+// present returns the code of an error and the message to show clients. Messages of gRPC errors are only shown for
+// invalid arguments, as services may reveal their internals in others.
+func present(err error) (string, string) {
+	for _, domain := range domainErrors {
+		if errors.Is(err, domain.err) {
+			return domain.code, domain.err.Error()
+		}
+	}
+
+	var invalid models.InvalidInputError
+	if errors.As(err, &invalid) {
+		return codeInvalidArgument, invalid.Error()
+	}
+
+	if isUnavailable(err) {
+		return codeUnavailable, models.ErrServiceUnavailable.Error()
+	}
+
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		s := grpcErr.GRPCStatus()
+		switch s.Code() {
+		case codes.NotFound:
+			return codeNotFound, "not found"
+		case codes.PermissionDenied:
+			return codeForbidden, "not permitted"
+		case codes.Unauthenticated:
+			return codeUnauthenticated, models.ErrUnauthenticated.Error()
+		case codes.InvalidArgument, codes.OutOfRange:
+			return codeInvalidArgument, s.Message()
+		case codes.AlreadyExists, codes.Aborted, codes.FailedPrecondition:
+			return codeConflict, "conflicts with the current state, refresh and try again"
+		}
+	}
+
+	return codeInternal, msgInternal
+}
+
+// isUnavailable reports whether an error was caused by a service that could not be reached or did not answer in time,
+// as opposed to one that rejected the request. Clients can retry such errors later.
+func isUnavailable(err error) bool {
+	if errors.Is(err, models.ErrServiceUnavailable) {
+		return true
+	}
+
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		switch grpcErr.GRPCStatus().Code() {
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,151 @@
+package graph_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/graph"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func Test_ErrorPresenter(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		code    string
+		message string
+		level   zapcore.Level
+	}{
+		{
+			name:    "version conflict",
+			err:     fmt.Errorf("could not add table using venue service : %w", models.ErrVersionConflict),
+			code:    "VERSION_CONFLICT",
+			message: models.ErrVersionConflict.Error(),
+			level:   zapcore.InfoLevel,
+		},
+		{
+			name:    "too many requests",
+			err:     fmt.Errorf("could not request guest booking : %w", models.ErrTooManyRequests),
+			code:    "RATE_LIMITED",
+			message: models.ErrTooManyRequests.Error(),
+			level:   zapcore.InfoLevel,
+		},
+		{
+			name:    "not an admin",
+			err:     models.ErrNotAdmin,
+			code:    "FORBIDDEN",
+			message: models.ErrNotAdmin.Error(),
+			level:   zapcore.InfoLevel,
+		},
+		{
+			name:    "signed out",
+			err:     models.ErrUnauthenticated,
+			code:    "UNAUTHENTICATED",
+			message: models.ErrUnauthenticated.Error(),
+			level:   zapcore.InfoLevel,
+		},
+		{
+			name:    "invalid input",
+			err:     models.InvalidInputError("venue ID must be given"),
+			code:    "INVALID_ARGUMENT",
+			message: "venue ID must be given",
+			level:   zapcore.InfoLevel,
+		},
+		{
+			name:    "slot taken",
+			err:     models.ErrSlotUnavailable,
+			code:    "CONFLICT",
+			message: models.ErrSlotUnavailable.Error(),
+			level:   zapcore.InfoLevel,
+		},
+		{
+			name:    "service could not find",
+			err:     fmt.Errorf("could not get venue from venue service : %w", status.Error(codes.NotFound, "could not find venue : sql: no rows in result set")),
+			code:    "NOT_FOUND",
+			message: "not found",
+			level:   zapcore.InfoLevel,
+		},
+		{
+			name:    "service denied",
+			err:     status.Error(codes.PermissionDenied, "token lacks scope 'venue:admin'"),
+			code:    "FORBIDDEN",
+			message: "not permitted",
+			level:   zapcore.InfoLevel,
+		},
+		{
+			name:    "service rejected argument",
+			err:     fmt.Errorf("could not add table : %w", status.Error(codes.InvalidArgument, "table name must be given")),
+			code:    "INVALID_ARGUMENT",
+			message: "table name must be given",
+			level:   zapcore.InfoLevel,
+		},
+		{
+			name:    "service already has it",
+			err:     status.Error(codes.AlreadyExists, "could not insert venue : duplicate key value violates unique constraint \"venues_slug_key\""),
+			code:    "CONFLICT",
+			message: "conflicts with the current state, refresh and try again",
+			level:   zapcore.InfoLevel,
+		},
+		{
+			name:    "service unavailable",
+			err:     fmt.Errorf("could not get slot : %w", status.Error(codes.Unavailable, "connection refused")),
+			code:    "UNAVAILABLE",
+			message: models.ErrServiceUnavailable.Error(),
+			level:   zapcore.ErrorLevel,
+		},
+		{
+			name:    "service failed",
+			err:     fmt.Errorf("could not add table : %w", status.Error(codes.Internal, "could not insert row : pq: connection reset")),
+			code:    "INTERNAL",
+			message: "internal error",
+			level:   zapcore.ErrorLevel,
+		},
+		{
+			name:    "unexpected error",
+			err:     errors.New("internal system error"),
+			code:    "INTERNAL",
+			message: "internal error",
+			level:   zapcore.ErrorLevel,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			core, logs := observer.New(zapcore.DebugLevel)
+			presenter := graph.NewErrorPresenter(zap.New(core).Sugar())
+
+			ctx := models.AddRequestIDToContext(context.Background(), "4f1c2a")
+			gqlErr := presenter(ctx, gqlerror.WrapPath(nil, test.err))
+
+			assert.Equal(t, test.message, gqlErr.Message)
+			assert.Equal(t, test.code, gqlErr.Extensions["code"])
+			assert.Equal(t, "4f1c2a", gqlErr.Extensions["requestId"])
+
+			entries := logs.All()
+			if assert.Len(t, entries, 1) {
+				assert.Equal(t, test.level, entries[0].Level)
+				assert.Equal(t, test.code, entries[0].ContextMap()["code"])
+				assert.Equal(t, "4f1c2a", entries[0].ContextMap()["request_id"])
+				assert.Contains(t, entries[0].ContextMap()["error"], test.err.Error(), "full error should be logged")
+			}
+		})
+	}
+}
+
+func Test_ErrorPresenterQueryErrors(t *testing.T) {
+	presenter := graph.NewErrorPresenter(zap.NewNop().Sugar())
+
+	gqlErr := presenter(context.Background(), gqlerror.Errorf("must be defined"))
+
+	assert.Equal(t, "must be defined", gqlErr.Message, "errors about the query should be passed on")
+	assert.Nil(t, gqlErr.Extensions)
+}
@@ -0,0 +1,271 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/graph/generated"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// bookingsCost is the cost of a page of bookings, on top of the bookings in it, as it is a call to the booking service.
+	bookingsCost = 10
+	// maxPageLimit is the most bookings a page may hold, and what a page is costed as when its limit is not given. Pages
+	// asking for more are refused.
+	maxPageLimit = 50
+	// slotCost is the cost of a booking enquiry, which has the booking service check the venue's tables and bookings.
+	slotCost = 10
+	// subscriptionCost is the cost of a subscription, which holds a connection open for as long as it lasts.
+	subscriptionCost = 10
+
+	depthLimitExceeded  = "DEPTH_LIMIT_EXCEEDED"
+	operationNotAllowed = "OPERATION_NOT_ALLOWED"
+)
+
+// complexity costs the fields calling out to other services above the other fields, so the complexity limit stops
+// operations fanning out into many calls.
+func complexity() generated.ComplexityRoot {
+	var c generated.ComplexityRoot
+	c.Venue.Bookings = func(childComplexity int, filter *models.BookingsFilter, pageInfo *models.PageInfo) int {
+		limit := maxPageLimit
+		if pageInfo != nil && pageInfo.Limit != nil && *pageInfo.Limit > 0 && *pageInfo.Limit < maxPageLimit {
+			limit = *pageInfo.Limit
+		}
+
+		return bookingsCost + limit*childComplexity
+	}
+	c.Query.GetSlot = func(childComplexity int, input models.SlotInput) int {
+		return slotCost + childComplexity
+	}
+	c.Subscription.BookingsChanged = func(childComplexity int, venueID string, date time.Time) int {
+		return subscriptionCost + childComplexity
+	}
+
+	return c
+}
+
+// DepthLimit is a gqlgen extension refusing operations nested deeper than the limit. Fragments count towards the depth
+// of the fields they are spread in, and introspection fields are not counted.
+type DepthLimit struct {
+	Limit int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = DepthLimit{}
+
+func (DepthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+func (d DepthLimit) Validate(graphql.ExecutableSchema) error {
+	if d.Limit < 1 {
+		return fmt.Errorf("depth limit must be at least 1")
+	}
+
+	return nil
+}
+
+func (d DepthLimit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	if depth := depth(rc.Operation.SelectionSet); depth > d.Limit {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.Limit)
+		errcode.Set(err, depthLimitExceeded)
+		return err
+	}
+
+	return nil
+}
+
+func depth(selections ast.SelectionSet) int {
+	max := 0
+	for _, selection := range selections {
+		var d int
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			d = 1 + depth(s.SelectionSet)
+		case *ast.InlineFragment:
+			d = depth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				d = depth(s.Definition.SelectionSet)
+			}
+		}
+		if d > max {
+			max = d
+		}
+	}
+
+	return max
+}
+
+// AllowList is a gqlgen extension only running the operations registered with it, such as those sent by the web apps,
+// so the api cannot be used for operations of a client's own making. Operations are matched on their shape, ignoring
+// their names, formatting, how they are split into fragments and the __typename fields clients add.
+type AllowList struct {
+	schema     *ast.Schema
+	operations map[string]struct{}
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = AllowList{}
+
+// NewAllowList registers the operations of the documents, keyed by where they were read from. Documents must be valid
+// against the schema, so a client sending operations the gateway cannot run is found when it starts.
+func NewAllowList(schema *ast.Schema, documents map[string]string) (AllowList, error) {
+	names := make([]string, 0, len(documents))
+	for name := range documents {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	a := AllowList{schema: schema, operations: map[string]struct{}{}}
+	for _, name := range names {
+		doc, errs := gqlparser.LoadQuery(schema, documents[name])
+		if len(errs) > 0 {
+			return AllowList{}, fmt.Errorf("could not load operations from %s : %w", name, errs)
+		}
+		for _, op := range doc.Operations {
+			a.operations[a.canonical(op)] = struct{}{}
+		}
+	}
+
+	return a, nil
+}
+
+func (AllowList) ExtensionName() string {
+	return "AllowList"
+}
+
+func (AllowList) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (a AllowList) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	if _, ok := a.operations[a.canonical(rc.Operation)]; !ok {
+		err := gqlerror.Errorf("operation is not allowed, only those registered with the gateway may be run")
+		errcode.Set(err, operationNotAllowed)
+		return err
+	}
+
+	return nil
+}
+
+// canonical writes out the operation with its fragments spread in place and without __typename fields, so operations
+// differing only in how they are written are the same.
+func (a AllowList) canonical(op *ast.OperationDefinition) string {
+	var root string
+	switch op.Operation {
+	case ast.Query:
+		root = a.schema.Query.Name
+	case ast.Mutation:
+		root = a.schema.Mutation.Name
+	case ast.Subscription:
+		root = a.schema.Subscription.Name
+	}
+
+	var b strings.Builder
+	b.WriteString(string(op.Operation))
+	if len(op.VariableDefinitions) > 0 {
+		b.WriteString("(")
+		for i, v := range op.VariableDefinitions {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			b.WriteString("$" + v.Variable + ":" + v.Type.String())
+			if v.DefaultValue != nil {
+				b.WriteString("=" + v.DefaultValue.String())
+			}
+		}
+		b.WriteString(")")
+	}
+	writeDirectives(&b, op.Directives)
+	writeSelections(&b, root, op.SelectionSet)
+
+	return b.String()
+}
+
+func writeSelections(b *strings.Builder, parent string, selections ast.SelectionSet) {
+	if len(selections) == 0 {
+		return
+	}
+
+	b.WriteString("{")
+	writeFields(b, parent, selections)
+	b.WriteString("}")
+}
+
+// writeFields writes out the selections, with fragments on the type they are selected from merged into it.
+func writeFields(b *strings.Builder, parent string, selections ast.SelectionSet) {
+	for _, selection := range selections {
+		switch s := selection.(type) {
+		case *ast.Field:
+			if s.Name == "__typename" {
+				continue
+			}
+			if s.Alias != "" && s.Alias != s.Name {
+				b.WriteString(s.Alias + ":")
+			}
+			b.WriteString(s.Name)
+			writeArguments(b, s.Arguments)
+			writeDirectives(b, s.Directives)
+			if s.Definition != nil {
+				writeSelections(b, s.Definition.Type.Name(), s.SelectionSet)
+			}
+			b.WriteString(" ")
+		case *ast.InlineFragment:
+			writeFragment(b, parent, s.TypeCondition, s.Directives, s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				writeFragment(b, parent, s.Definition.TypeCondition, s.Directives, s.Definition.SelectionSet)
+			}
+		}
+	}
+}
+
+func writeFragment(b *strings.Builder, parent string, typeCondition string, directives ast.DirectiveList, selections ast.SelectionSet) {
+	if (typeCondition == "" || typeCondition == parent) && len(directives) == 0 {
+		writeFields(b, parent, selections)
+		return
+	}
+
+	b.WriteString("... on " + typeCondition)
+	writeDirectives(b, directives)
+	writeSelections(b, typeCondition, selections)
+	b.WriteString(" ")
+}
+
+func writeDirectives(b *strings.Builder, directives ast.DirectiveList) {
+	for _, d := range directives {
+		b.WriteString("@" + d.Name)
+		writeArguments(b, d.Arguments)
+	}
+}
+
+func writeArguments(b *strings.Builder, arguments ast.ArgumentList) {
+	if len(arguments) == 0 {
+		return
+	}
+
+	b.WriteString("(")
+	for i, arg := range arguments {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString(arg.Name + ":" + arg.Value.String())
+	}
+	b.WriteString(")")
+}
@@ -0,0 +1,297 @@
+package graph_test
+
+import (
+	"encoding/json"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/cmd/api/middleware"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/graph"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/graph/generated"
+	mock_resolver "github.com/cobbinma/booking-platform/lib/gateway_api/graph/mock"
+	venue2 "github.com/cobbinma/booking-platform/lib/gateway_api/internal/venue"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	venue "github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/models"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"go.uber.org/zap"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const limitedVenueID = "a3291740-e89f-4cc0-845c-75c4c39842c9"
+
+// newLimitedClient returns a client for a server using the extensions, with the venue service expected to be asked for
+// the venue the given number of times.
+func newLimitedClient(t *testing.T, calls int, extensions ...graphql.HandlerExtension) *client.Client {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
+	venueClient.EXPECT().BatchGetVenues(gomock.Any(), &api.BatchGetVenuesRequest{Ids: []string{limitedVenueID}}).
+		Return(&api.BatchGetVenuesResponse{Venues: []*venue.Venue{{Id: limitedVenueID, Name: "hop and vine"}}}, nil).
+		Times(calls)
+
+	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
+	require.NoError(t, err)
+
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
+	h.SetErrorPresenter(graph.NewErrorPresenter(zap.NewNop().Sugar()))
+	for _, extension := range extensions {
+		h.Use(extension)
+	}
+	e := echo.New()
+	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
+
+	return client.New(e)
+}
+
+// errorCodes returns the codes of the errors in the response.
+func errorCodes(t *testing.T, resp *client.Response) []string {
+	var errs []struct {
+		Extensions struct {
+			Code string `json:"code"`
+		} `json:"extensions"`
+	}
+	if resp.Errors != nil {
+		require.NoError(t, json.Unmarshal(resp.Errors, &errs))
+	}
+
+	codes := []string{}
+	for _, err := range errs {
+		codes = append(codes, err.Extensions.Code)
+	}
+
+	return codes
+}
+
+func Test_Complexity(t *testing.T) {
+	es := generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), nil, nil)))
+	tests := []struct {
+		name       string
+		query      string
+		complexity int
+	}{
+		{
+			name:       "venue",
+			query:      `{getVenue(filter:{slug:"hop-and-vine"}){id,name}}`,
+			complexity: 3,
+		},
+		{
+			name:       "page of bookings",
+			query:      `{getVenue(filter:{slug:"hop-and-vine"}){bookings(pageInfo:{page:0,limit:20}){bookings{id,email},hasNextPage}}}`,
+			complexity: 1 + 10 + 20*(3+1),
+		},
+		{
+			name:       "page of bookings without a limit",
+			query:      `{getVenue(filter:{slug:"hop-and-vine"}){bookings{bookings{id,email},hasNextPage}}}`,
+			complexity: 1 + 10 + 50*(3+1),
+		},
+		{
+			name:       "page of bookings over the maximum limit",
+			query:      `{getVenue(filter:{slug:"hop-and-vine"}){bookings(pageInfo:{page:0,limit:1000000}){bookings{id,email},hasNextPage}}}`,
+			complexity: 1 + 10 + 50*(3+1),
+		},
+		{
+			name:       "slot",
+			query:      `{getSlot(input:{venueId:"hop-and-vine",email:"test@test.com",people:4,startsAt:"3000-06-20T12:00:00Z",duration:60}){match{startsAt}}}`,
+			complexity: 10 + 2,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			doc, errs := gqlparser.LoadQuery(es.Schema(), test.query)
+			require.Empty(t, errs)
+
+			assert.Equal(t, test.complexity, complexity.Calculate(es, doc.Operations[0], nil))
+		})
+	}
+}
+
+func Test_ComplexityLimit(t *testing.T) {
+	c := newLimitedClient(t, 0, extension.FixedComplexityLimit(1000))
+
+	// each alias is another call to the booking service
+	resp, err := c.RawPost(`{getVenue(filter:{id:"a3291740-e89f-4cc0-845c-75c4c39842c9"}){
+		a:bookings{bookings{id,email,people,startsAt,endsAt}}
+		b:bookings{bookings{id,email,people,startsAt,endsAt}}
+		c:bookings{bookings{id,email,people,startsAt,endsAt}}
+		d:bookings{bookings{id,email,people,startsAt,endsAt}}
+	}}`)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"COMPLEXITY_LIMIT_EXCEEDED"}, errorCodes(t, resp))
+}
+
+func Test_PageLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+		codes []string
+	}{
+		{name: "over the maximum", limit: 51, codes: []string{"INVALID_ARGUMENT"}},
+		{name: "far over the maximum", limit: 1000000, codes: []string{"INVALID_ARGUMENT"}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			c := newLimitedClient(t, 1, extension.FixedComplexityLimit(1000))
+
+			resp, err := c.RawPost(`query($limit:Int){getVenue(filter:{id:"a3291740-e89f-4cc0-845c-75c4c39842c9"}){
+				bookings(filter:{date:"3000-01-01T00:00:00Z"},pageInfo:{page:0,limit:$limit}){bookings{id}}
+			}}`, client.Var("limit", test.limit))
+			require.NoError(t, err)
+
+			assert.Equal(t, test.codes, errorCodes(t, resp))
+		})
+	}
+}
+
+func Test_DepthLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		codes []string
+		calls int
+	}{
+		{
+			name:  "within the limit",
+			query: `{getVenue(filter:{id:"a3291740-e89f-4cc0-845c-75c4c39842c9"}){id,name}}`,
+			codes: []string{},
+			calls: 1,
+		},
+		{
+			name:  "introspection fields are not counted",
+			query: `{__schema{types{fields{type{name}}}}}`,
+			codes: []string{},
+		},
+		{
+			name:  "over the limit",
+			query: `{getVenue(filter:{id:"a3291740-e89f-4cc0-845c-75c4c39842c9"}){bookings{bookings{id}}}}`,
+			codes: []string{"DEPTH_LIMIT_EXCEEDED"},
+		},
+		{
+			name:  "over the limit in a fragment",
+			query: `{getVenue(filter:{id:"a3291740-e89f-4cc0-845c-75c4c39842c9"}){...bookings}} fragment bookings on Venue{bookings{bookings{id}}}`,
+			codes: []string{"DEPTH_LIMIT_EXCEEDED"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			c := newLimitedClient(t, test.calls, graph.DepthLimit{Limit: 2})
+
+			resp, err := c.RawPost(test.query)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.codes, errorCodes(t, resp))
+		})
+	}
+}
+
+func Test_AllowList(t *testing.T) {
+	es := generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), nil, nil)))
+	allowList, err := graph.NewAllowList(es.Schema(), map[string]string{
+		"get_venue.graphql": `query GetVenue($id: ID) {
+			getVenue(filter: {id: $id}) {
+				...venue
+			}
+		}
+
+		fragment venue on Venue {
+			id
+			name
+		}`,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		query string
+		codes []string
+		calls int
+	}{
+		{
+			name:  "registered",
+			query: `query GetVenue($id:ID){getVenue(filter:{id:$id}){id,name}}`,
+			codes: []string{},
+			calls: 1,
+		},
+		{
+			name:  "registered with type names added by the client",
+			query: `query Venue($id:ID){getVenue(filter:{id:$id}){__typename,id,name}}`,
+			codes: []string{},
+			calls: 1,
+		},
+		{
+			name:  "different fields",
+			query: `query GetVenue($id:ID){getVenue(filter:{id:$id}){id,name,slug}}`,
+			codes: []string{"OPERATION_NOT_ALLOWED"},
+		},
+		{
+			name:  "different arguments",
+			query: `query GetVenue($slug:ID){getVenue(filter:{slug:$slug}){id,name}}`,
+			codes: []string{"OPERATION_NOT_ALLOWED"},
+		},
+		{
+			name:  "introspection",
+			query: `{__schema{types{name}}}`,
+			codes: []string{"OPERATION_NOT_ALLOWED"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			c := newLimitedClient(t, test.calls, allowList)
+
+			resp, err := c.RawPost(test.query, client.Var("id", limitedVenueID))
+			require.NoError(t, err)
+
+			assert.Equal(t, test.codes, errorCodes(t, resp))
+		})
+	}
+}
+
+func Test_AllowListInvalidDocument(t *testing.T) {
+	es := generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), nil, nil)))
+
+	_, err := graph.NewAllowList(es.Schema(), map[string]string{"get_venue.graphql": `{getVenue{unknown}}`})
+
+	assert.Error(t, err, "documents the schema cannot run should not be registered")
+}
+
+// Test_AllowListWebApps checks the web apps' operations can be registered, so the gateway starts with them allowed.
+func Test_AllowListWebApps(t *testing.T) {
+	es := generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), nil, nil)))
+
+	documents := map[string]string{}
+	for _, app := range []string{"admin_ui", "booking_ui"} {
+		paths, err := filepath.Glob(filepath.Join("..", "..", app, "src", "graphql", "*.graphql"))
+		require.NoError(t, err)
+		for _, path := range paths {
+			b, err := ioutil.ReadFile(path)
+			require.NoError(t, err)
+			documents[path] = string(b)
+		}
+	}
+	if len(documents) == 0 {
+		if _, err := os.Stat(filepath.Join("..", "..", "admin_ui")); os.IsNotExist(err) {
+			t.Skip("web apps are not alongside the gateway")
+		}
+		t.Fatal("web apps have no operations")
+	}
+
+	_, err := graph.NewAllowList(es.Schema(), documents)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,106 @@
+package graph
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"time"
+)
+
+var (
+	operations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gateway",
+		Subsystem: "graphql",
+		Name:      "operations_total",
+		Help:      "Total number of GraphQL operations by name and outcome.",
+	}, []string{"operation", "status"})
+	operationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "gateway",
+		Subsystem: "graphql",
+		Name:      "operation_duration_seconds",
+		Help:      "Latency of GraphQL operations by name.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+	resolvers = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gateway",
+		Subsystem: "graphql",
+		Name:      "resolvers_total",
+		Help:      "Total number of resolver calls by field and outcome.",
+	}, []string{"field", "status"})
+	resolverDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "gateway",
+		Subsystem: "graphql",
+		Name:      "resolver_duration_seconds",
+		Help:      "Latency of resolver calls by field.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"field"})
+	adminCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gateway",
+		Subsystem: "admin_cache",
+		Name:      "requests_total",
+		Help:      "Total number of admin cache lookups by result.",
+	}, []string{"result"})
+)
+
+// Metrics is a gqlgen extension recording the count and latency of operations and of fields with a resolver.
+type Metrics struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = Metrics{}
+
+func (Metrics) ExtensionName() string {
+	return "Metrics"
+}
+
+func (Metrics) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (Metrics) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+	if !graphql.HasOperationContext(ctx) {
+		return resp
+	}
+
+	oc := graphql.GetOperationContext(ctx)
+	name := oc.OperationName
+	if name == "" && oc.Operation != nil {
+		name = oc.Operation.Name
+	}
+	if name == "" {
+		name = "anonymous"
+	}
+
+	operations.WithLabelValues(name, outcome(resp != nil && len(resp.Errors) > 0)).Inc()
+	operationDuration.WithLabelValues(name).Observe(time.Since(oc.Stats.OperationStart).Seconds())
+
+	return resp
+}
+
+func (Metrics) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	res, err := next(ctx)
+
+	field := fc.Object + "." + fc.Field.Name
+	resolvers.WithLabelValues(field, outcome(err != nil)).Inc()
+	resolverDuration.WithLabelValues(field).Observe(time.Since(start).Seconds())
+
+	return res, err
+}
+
+func outcome(failed bool) string {
+	if failed {
+		return "error"
+	}
+
+	return "ok"
+}
@@ -0,0 +1,82 @@
+package graph_test
+
+import (
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/cmd/api/middleware"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/graph"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/graph/generated"
+	mock_resolver "github.com/cobbinma/booking-platform/lib/gateway_api/graph/mock"
+	venue2 "github.com/cobbinma/booking-platform/lib/gateway_api/internal/venue"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	venue "github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/models"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func Test_Metrics(t *testing.T) {
+	venueID := "a3291740-e89f-4cc0-845c-75c4c39842c9"
+	ctrl := gomock.NewController(t)
+	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
+
+	venueClient.EXPECT().BatchGetVenues(gomock.Any(), &api.BatchGetVenuesRequest{Ids: []string{venueID}}).
+		Return(&api.BatchGetVenuesResponse{Venues: []*venue.Venue{{Id: venueID, Name: "hop and vine", Slug: "hop-and-vine"}}}, nil)
+
+	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
+	require.NoError(t, err)
+
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
+	h.Use(graph.Metrics{})
+	e := echo.New()
+	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
+	c := client.New(e)
+
+	operations := counter(t, "gateway_graphql_operations_total", map[string]string{"operation": "MetricsVenue", "status": "ok"})
+	resolvers := counter(t, "gateway_graphql_resolvers_total", map[string]string{"field": "Query.getVenue", "status": "ok"})
+
+	var resp struct {
+		GetVenue struct {
+			Name string `json:"name"`
+		} `json:"getVenue"`
+	}
+	c.MustPost(`query MetricsVenue { getVenue(filter: {id: "a3291740-e89f-4cc0-845c-75c4c39842c9"}) { name } }`, &resp)
+
+	assert.Equal(t, "hop and vine", resp.GetVenue.Name)
+	assert.Equal(t, operations+1, counter(t, "gateway_graphql_operations_total", map[string]string{"operation": "MetricsVenue", "status": "ok"}))
+	assert.Equal(t, resolvers+1, counter(t, "gateway_graphql_resolvers_total", map[string]string{"field": "Query.getVenue", "status": "ok"}))
+}
+
+func counter(t *testing.T, name string, labels map[string]string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			if hasLabels(metric, labels) {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+
+	return 0
+}
+
+func hasLabels(metric *dto.Metric, labels map[string]string) bool {
+	matched := 0
+	for _, label := range metric.GetLabel() {
+		if v, ok := labels[label.GetName()]; ok && v == label.GetValue() {
+			matched++
+		}
+	}
+
+	return matched == len(labels)
+}
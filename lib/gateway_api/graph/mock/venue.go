@@ -77,6 +77,46 @@ func (mr *MockVenueAPIClientMockRecorder) AddTable(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTable", reflect.TypeOf((*MockVenueAPIClient)(nil).AddTable), varargs...)
 }
 
+// BatchGetVenues mocks base method.
+func (m *MockVenueAPIClient) BatchGetVenues(arg0 context.Context, arg1 *api.BatchGetVenuesRequest, arg2 ...grpc.CallOption) (*api.BatchGetVenuesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchGetVenues", varargs...)
+	ret0, _ := ret[0].(*api.BatchGetVenuesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetVenues indicates an expected call of BatchGetVenues.
+func (mr *MockVenueAPIClientMockRecorder) BatchGetVenues(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetVenues", reflect.TypeOf((*MockVenueAPIClient)(nil).BatchGetVenues), varargs...)
+}
+
+// CreateApiKey mocks base method.
+func (m *MockVenueAPIClient) CreateApiKey(arg0 context.Context, arg1 *api.CreateApiKeyRequest, arg2 ...grpc.CallOption) (*api.CreateApiKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateApiKey", varargs...)
+	ret0, _ := ret[0].(*api.CreateApiKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApiKey indicates an expected call of CreateApiKey.
+func (mr *MockVenueAPIClientMockRecorder) CreateApiKey(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApiKey", reflect.TypeOf((*MockVenueAPIClient)(nil).CreateApiKey), varargs...)
+}
+
 // CreateVenue mocks base method.
 func (m *MockVenueAPIClient) CreateVenue(arg0 context.Context, arg1 *api.CreateVenueRequest, arg2 ...grpc.CallOption) (*models.Venue, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +157,46 @@ func (mr *MockVenueAPIClientMockRecorder) GetAdmins(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdmins", reflect.TypeOf((*MockVenueAPIClient)(nil).GetAdmins), varargs...)
 }
 
+// GetAdminsBatch mocks base method.
+func (m *MockVenueAPIClient) GetAdminsBatch(arg0 context.Context, arg1 *api.GetAdminsBatchRequest, arg2 ...grpc.CallOption) (*api.GetAdminsBatchResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAdminsBatch", varargs...)
+	ret0, _ := ret[0].(*api.GetAdminsBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdminsBatch indicates an expected call of GetAdminsBatch.
+func (mr *MockVenueAPIClientMockRecorder) GetAdminsBatch(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminsBatch", reflect.TypeOf((*MockVenueAPIClient)(nil).GetAdminsBatch), varargs...)
+}
+
+// GetApiKeys mocks base method.
+func (m *MockVenueAPIClient) GetApiKeys(arg0 context.Context, arg1 *api.GetApiKeysRequest, arg2 ...grpc.CallOption) (*api.GetApiKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetApiKeys", varargs...)
+	ret0, _ := ret[0].(*api.GetApiKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApiKeys indicates an expected call of GetApiKeys.
+func (mr *MockVenueAPIClientMockRecorder) GetApiKeys(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiKeys", reflect.TypeOf((*MockVenueAPIClient)(nil).GetApiKeys), varargs...)
+}
+
 // GetOpeningHoursSpecification mocks base method.
 func (m *MockVenueAPIClient) GetOpeningHoursSpecification(arg0 context.Context, arg1 *api.GetOpeningHoursSpecificationRequest, arg2 ...grpc.CallOption) (*api.GetOpeningHoursSpecificationResponse, error) {
 	m.ctrl.T.Helper()
@@ -137,6 +217,26 @@ func (mr *MockVenueAPIClientMockRecorder) GetOpeningHoursSpecification(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpeningHoursSpecification", reflect.TypeOf((*MockVenueAPIClient)(nil).GetOpeningHoursSpecification), varargs...)
 }
 
+// GetOpeningHoursSpecificationBatch mocks base method.
+func (m *MockVenueAPIClient) GetOpeningHoursSpecificationBatch(arg0 context.Context, arg1 *api.GetOpeningHoursSpecificationBatchRequest, arg2 ...grpc.CallOption) (*api.GetOpeningHoursSpecificationBatchResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOpeningHoursSpecificationBatch", varargs...)
+	ret0, _ := ret[0].(*api.GetOpeningHoursSpecificationBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpeningHoursSpecificationBatch indicates an expected call of GetOpeningHoursSpecificationBatch.
+func (mr *MockVenueAPIClientMockRecorder) GetOpeningHoursSpecificationBatch(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpeningHoursSpecificationBatch", reflect.TypeOf((*MockVenueAPIClient)(nil).GetOpeningHoursSpecificationBatch), varargs...)
+}
+
 // GetTables mocks base method.
 func (m *MockVenueAPIClient) GetTables(arg0 context.Context, arg1 *api.GetTablesRequest, arg2 ...grpc.CallOption) (*api.GetTablesResponse, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +257,26 @@ func (mr *MockVenueAPIClientMockRecorder) GetTables(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTables", reflect.TypeOf((*MockVenueAPIClient)(nil).GetTables), varargs...)
 }
 
+// GetTablesBatch mocks base method.
+func (m *MockVenueAPIClient) GetTablesBatch(arg0 context.Context, arg1 *api.GetTablesBatchRequest, arg2 ...grpc.CallOption) (*api.GetTablesBatchResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTablesBatch", varargs...)
+	ret0, _ := ret[0].(*api.GetTablesBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTablesBatch indicates an expected call of GetTablesBatch.
+func (mr *MockVenueAPIClientMockRecorder) GetTablesBatch(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTablesBatch", reflect.TypeOf((*MockVenueAPIClient)(nil).GetTablesBatch), varargs...)
+}
+
 // GetVenue mocks base method.
 func (m *MockVenueAPIClient) GetVenue(arg0 context.Context, arg1 *api.GetVenueRequest, arg2 ...grpc.CallOption) (*models.Venue, error) {
 	m.ctrl.T.Helper()
@@ -197,6 +317,26 @@ func (mr *MockVenueAPIClientMockRecorder) IsAdmin(arg0, arg1 interface{}, arg2 .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAdmin", reflect.TypeOf((*MockVenueAPIClient)(nil).IsAdmin), varargs...)
 }
 
+// IsAdminBatch mocks base method.
+func (m *MockVenueAPIClient) IsAdminBatch(arg0 context.Context, arg1 *api.IsAdminBatchRequest, arg2 ...grpc.CallOption) (*api.IsAdminBatchResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IsAdminBatch", varargs...)
+	ret0, _ := ret[0].(*api.IsAdminBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAdminBatch indicates an expected call of IsAdminBatch.
+func (mr *MockVenueAPIClientMockRecorder) IsAdminBatch(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAdminBatch", reflect.TypeOf((*MockVenueAPIClient)(nil).IsAdminBatch), varargs...)
+}
+
 // RemoveAdmin mocks base method.
 func (m *MockVenueAPIClient) RemoveAdmin(arg0 context.Context, arg1 *api.RemoveAdminRequest, arg2 ...grpc.CallOption) (*api.RemoveAdminResponse, error) {
 	m.ctrl.T.Helper()
@@ -237,6 +377,26 @@ func (mr *MockVenueAPIClientMockRecorder) RemoveTable(arg0, arg1 interface{}, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTable", reflect.TypeOf((*MockVenueAPIClient)(nil).RemoveTable), varargs...)
 }
 
+// RevokeApiKey mocks base method.
+func (m *MockVenueAPIClient) RevokeApiKey(arg0 context.Context, arg1 *api.RevokeApiKeyRequest, arg2 ...grpc.CallOption) (*models.ApiKey, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeApiKey", varargs...)
+	ret0, _ := ret[0].(*models.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeApiKey indicates an expected call of RevokeApiKey.
+func (mr *MockVenueAPIClientMockRecorder) RevokeApiKey(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeApiKey", reflect.TypeOf((*MockVenueAPIClient)(nil).RevokeApiKey), varargs...)
+}
+
 // UpdateOpeningHours mocks base method.
 func (m *MockVenueAPIClient) UpdateOpeningHours(arg0 context.Context, arg1 *api.UpdateOpeningHoursRequest, arg2 ...grpc.CallOption) (*api.UpdateOpeningHoursResponse, error) {
 	m.ctrl.T.Helper()
@@ -276,3 +436,63 @@ func (mr *MockVenueAPIClientMockRecorder) UpdateSpecialOpeningHours(arg0, arg1 i
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSpecialOpeningHours", reflect.TypeOf((*MockVenueAPIClient)(nil).UpdateSpecialOpeningHours), varargs...)
 }
+
+// VerifyApiKey mocks base method.
+func (m *MockVenueAPIClient) VerifyApiKey(arg0 context.Context, arg1 *api.VerifyApiKeyRequest, arg2 ...grpc.CallOption) (*models.ApiKey, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyApiKey", varargs...)
+	ret0, _ := ret[0].(*models.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyApiKey indicates an expected call of VerifyApiKey.
+func (mr *MockVenueAPIClientMockRecorder) VerifyApiKey(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyApiKey", reflect.TypeOf((*MockVenueAPIClient)(nil).VerifyApiKey), varargs...)
+}
+
+// WatchVenue mocks base method.
+func (m *MockVenueAPIClient) WatchVenue(arg0 context.Context, arg1 *api.WatchVenueRequest, arg2 ...grpc.CallOption) (api.VenueAPI_WatchVenueClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchVenue", varargs...)
+	ret0, _ := ret[0].(api.VenueAPI_WatchVenueClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchVenue indicates an expected call of WatchVenue.
+func (mr *MockVenueAPIClientMockRecorder) WatchVenue(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchVenue", reflect.TypeOf((*MockVenueAPIClient)(nil).WatchVenue), varargs...)
+}
+
+// WatchVenues mocks base method.
+func (m *MockVenueAPIClient) WatchVenues(arg0 context.Context, arg1 *api.WatchVenuesRequest, arg2 ...grpc.CallOption) (api.VenueAPI_WatchVenuesClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchVenues", varargs...)
+	ret0, _ := ret[0].(api.VenueAPI_WatchVenuesClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchVenues indicates an expected call of WatchVenues.
+func (mr *MockVenueAPIClientMockRecorder) WatchVenues(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchVenues", reflect.TypeOf((*MockVenueAPIClient)(nil).WatchVenues), varargs...)
+}
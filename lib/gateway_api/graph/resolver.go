@@ -2,11 +2,12 @@ package graph
 
 import (
 	"context"
+	"fmt"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/graph/generated"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/events"
 	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
 	"github.com/patrickmn/go-cache"
 	"go.uber.org/zap"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"time"
 )
 
@@ -18,30 +19,103 @@ type Resolver struct {
 	log            *zap.SugaredLogger
 	venueService   VenueService
 	bookingService BookingService
+	guestBookings  GuestBookingService
+	bookingEvents  BookingEvents
 	admins         *adminCache
+	batchWait      time.Duration
 }
 
-func NewResolver(log *zap.SugaredLogger, venueService VenueService, bookingService BookingService) *Resolver {
-	return &Resolver{
+func NewResolver(log *zap.SugaredLogger, venueService VenueService, bookingService BookingService, options ...func(*Resolver)) *Resolver {
+	r := &Resolver{
 		log:            log,
 		venueService:   venueService,
 		bookingService: bookingService,
-		admins:         newAdminCache(),
+		bookingEvents:  events.NewBookings(),
+		admins:         newAdminCache(5 * time.Minute),
+		batchWait:      defaultBatchWait,
+	}
+	for i := range options {
+		options[i](r)
+	}
+
+	return r
+}
+
+// WithGuestBookings lets customers without an account book, confirming the booking from a link sent to their email.
+func WithGuestBookings(guestBookings GuestBookingService) func(*Resolver) {
+	return func(r *Resolver) {
+		r.guestBookings = guestBookings
+	}
+}
+
+// WithBookingEvents sets where changes to bookings are published and subscribed to, in place of the gateway's own.
+func WithBookingEvents(bookingEvents BookingEvents) func(*Resolver) {
+	return func(r *Resolver) {
+		r.bookingEvents = bookingEvents
+	}
+}
+
+// WithAdminCacheTTL sets how long a user is remembered as a venue administrator, saving a call to the venue service.
+func WithAdminCacheTTL(ttl time.Duration) func(*Resolver) {
+	return func(r *Resolver) {
+		if ttl > 0 {
+			r.admins = newAdminCache(ttl)
+		}
 	}
 }
 
+// WithBatchWait sets how long the Dataloaders extension waits for the fields of other venues before fetching a batch.
+func WithBatchWait(wait time.Duration) func(*Resolver) {
+	return func(r *Resolver) {
+		if wait > 0 {
+			r.batchWait = wait
+		}
+	}
+}
+
+// NewConfig returns the schema configuration for the resolver, with the schema's directives implemented and the
+// fields calling out to other services costed for the complexity limit.
+func NewConfig(r *Resolver) generated.Config {
+	return generated.Config{
+		Resolvers: r,
+		Directives: generated.DirectiveRoot{
+			Auth: Auth,
+		},
+		Complexity: complexity(),
+	}
+}
+
+// VenueService is the venue api. The batch methods return results by venue id, leaving out venues that cannot be found.
 type VenueService interface {
 	GetVenue(ctx context.Context, filter models.VenueFilter) (*models.Venue, error)
-	OpeningHoursSpecification(ctx context.Context, venueID string, date time.Time) (*models.OpeningHoursSpecification, error)
+	GetVenues(ctx context.Context, ids []string) (map[string]*models.Venue, error)
+	OpeningHoursSpecificationBatch(ctx context.Context, venueIDs []string, date time.Time) (map[string]*models.OpeningHoursSpecification, error)
 	UpdateOpeningHours(ctx context.Context, input models.UpdateOpeningHoursInput) ([]*models.OpeningHoursSpecification, error)
 	UpdateSpecialOpeningHours(ctx context.Context, input models.UpdateSpecialOpeningHoursInput) ([]*models.OpeningHoursSpecification, error)
-	GetTables(ctx context.Context, venueID string) ([]*models.Table, error)
+	GetTablesBatch(ctx context.Context, venueIDs []string) (map[string][]*models.Table, error)
 	AddTable(ctx context.Context, input models.TableInput) (*models.Table, error)
 	RemoveTable(ctx context.Context, input models.RemoveTableInput) (*models.Table, error)
 	IsAdmin(ctx context.Context, input models.IsAdminInput, email string) (bool, error)
-	GetAdmins(ctx context.Context, venueID string) ([]string, error)
+	IsAdminBatch(ctx context.Context, venueIDs []string, email string) (map[string]bool, error)
+	GetAdminsBatch(ctx context.Context, venueIDs []string) (map[string][]string, error)
 	AddAdmin(ctx context.Context, input models.AdminInput) (string, error)
 	RemoveAdmin(ctx context.Context, input models.RemoveAdminInput) (string, error)
+	CreateAPIKey(ctx context.Context, input models.APIKeyInput) (*models.CreatedAPIKey, error)
+	GetAPIKeys(ctx context.Context, venueID string) ([]*models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, input models.RevokeAPIKeyInput) (*models.APIKey, error)
+	VerifyAPIKey(ctx context.Context, key string) (*models.APIKey, error)
+}
+
+type GuestBookingService interface {
+	Request(ctx context.Context, input models.BookingInput) (*models.GuestBooking, error)
+	Confirm(ctx context.Context, token string, book func(input models.BookingInput) (*models.Booking, error)) (*models.Booking, error)
+}
+
+// BookingEvents carries changes to bookings to the subscriptions watching them. The gateway publishes the bookings its
+// own mutations create and cancel.
+type BookingEvents interface {
+	Publish(ctx context.Context, change models.BookingChange)
+	Subscribe(ctx context.Context, venueID string, date time.Time) <-chan *models.BookingChange
 }
 
 type BookingService interface {
@@ -51,42 +125,101 @@ type BookingService interface {
 	CancelBooking(ctx context.Context, input models.CancelBookingInput) (*models.Booking, error)
 }
 
+// logger returns the resolver's logger, adding the id of the request being resolved to each line.
+func (r *Resolver) logger(ctx context.Context) *zap.SugaredLogger {
+	if id := models.GetRequestIDFromContext(ctx); id != "" {
+		return r.log.With("request_id", id)
+	}
+
+	return r.log
+}
+
+// authIsAdmin checks the user administers the venue, returning ErrNotAdmin if they do not. Requests made with a venue api
+// key are only allowed for the venue the key was issued for, leaving what the key may do to the auth directive.
 func (r *Resolver) authIsAdmin(ctx context.Context, input models.IsAdminInput) error {
+	if key, err := models.GetAPIKeyFromContext(ctx); err == nil {
+		if input.VenueID == nil || *input.VenueID != key.VenueID {
+			return models.ErrForbidden
+		}
+
+		return nil
+	}
+
 	user, err := models.GetUserFromContext(ctx)
 	if err != nil {
-		return status.Errorf(codes.Internal, "could not get user profile : %s", err)
+		return models.ErrUnauthenticated
 	}
 
-	if isAdmin := r.admins.getAdmin(user.Email); isAdmin {
+	// venues given by slug are not cached, as administrators are removed by venue id
+	if input.VenueID == nil {
+		isAdmin, err := r.venueService.IsAdmin(ctx, input, user.Email)
+		if err != nil {
+			return fmt.Errorf("could not determine if user is admin : %w", err)
+		}
+		if isAdmin {
+			return nil
+		}
+
+		return models.ErrNotAdmin
+	}
+
+	if isAdmin := r.admins.getAdmin(*input.VenueID, user.Email); isAdmin {
 		return nil
 	}
 
-	isAdmin, err := r.venueService.IsAdmin(ctx, input, user.Email)
+	isAdmin, err := r.loaders(ctx).isVenueAdmin(ctx, *input.VenueID, user.Email)
 	if err != nil {
-		return status.Errorf(codes.Internal, "could not determine is user is admin : %s", err)
+		return fmt.Errorf("could not determine if user is admin : %w", err)
 	}
 
 	if isAdmin {
-		r.admins.setAdmin(user.Email)
+		r.admins.setAdmin(*input.VenueID, user.Email)
 		return nil
 	}
 
-	return status.Errorf(codes.Unauthenticated, "user is not admin")
+	return models.ErrNotAdmin
+}
+
+// createBooking creates the booking, publishing it to the subscriptions watching the venue's bookings.
+func (r *Resolver) createBooking(ctx context.Context, input models.BookingInput) (*models.Booking, error) {
+	booking, err := r.bookingService.CreateBooking(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	r.bookingEvents.Publish(ctx, models.BookingChange{Type: models.BookingChangeTypeCreated, Booking: booking})
+
+	return booking, nil
 }
 
 type adminCache struct {
 	admins *cache.Cache
 }
 
-func newAdminCache() *adminCache {
-	return &adminCache{admins: cache.New(5*time.Minute, 10*time.Minute)}
+func newAdminCache(ttl time.Duration) *adminCache {
+	return &adminCache{admins: cache.New(ttl, 2*ttl)}
+}
+
+// adminKey keys administrators by venue, as administering one venue does not make a user the administrator of another.
+func adminKey(venueID string, email string) string {
+	return venueID + "/" + email
 }
 
-func (ac *adminCache) getAdmin(email string) bool {
-	_, found := ac.admins.Get(email)
+func (ac *adminCache) getAdmin(venueID string, email string) bool {
+	_, found := ac.admins.Get(adminKey(venueID, email))
+	if found {
+		adminCacheRequests.WithLabelValues("hit").Inc()
+	} else {
+		adminCacheRequests.WithLabelValues("miss").Inc()
+	}
+
 	return found
 }
 
-func (ac adminCache) setAdmin(email string) {
-	ac.admins.Set(email, true, cache.DefaultExpiration)
+func (ac adminCache) setAdmin(venueID string, email string) {
+	ac.admins.Set(adminKey(venueID, email), true, cache.DefaultExpiration)
+}
+
+// removeAdmin forgets the user administers the venue, so a removed administrator loses access straight away.
+func (ac adminCache) removeAdmin(venueID string, email string) {
+	ac.admins.Delete(adminKey(venueID, email))
 }
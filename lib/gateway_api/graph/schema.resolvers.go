@@ -5,17 +5,25 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"go.uber.org/zap"
 	"time"
 
 	"github.com/cobbinma/booking-platform/lib/gateway_api/graph/generated"
 	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 func (r *mutationResolver) CreateBooking(ctx context.Context, input models.BookingInput) (*models.Booking, error) {
+	if _, err := models.GetAPIKeyFromContext(ctx); err == nil {
+		if err := r.authIsAdmin(ctx, models.IsAdminInput{
+			VenueID: &input.VenueID,
+		}); err != nil {
+			return nil, err
+		}
+
+		return r.createBooking(ctx, input)
+	}
+
 	user, err := models.GetUserFromContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not get user profile : %w", err)
@@ -25,9 +33,8 @@ func (r *mutationResolver) CreateBooking(ctx context.Context, input models.Booki
 	if err := r.authIsAdmin(ctx, models.IsAdminInput{
 		VenueID: &input.VenueID,
 	}); err != nil {
-		if status.Code(err) != codes.Unauthenticated {
-			r.log.Error("could not determine if user is admin", zap.Error(err))
-			return nil, fmt.Errorf("internal error")
+		if !errors.Is(err, models.ErrNotAdmin) {
+			return nil, err
 		}
 	} else {
 		isAdmin = true
@@ -35,15 +42,15 @@ func (r *mutationResolver) CreateBooking(ctx context.Context, input models.Booki
 
 	if user.Email != input.Email {
 		if !isAdmin {
-			r.log.Info("user is not admin therefore cannot change email")
-			return nil, fmt.Errorf("unauthorised")
+			r.logger(ctx).Info("user is not admin therefore cannot change email")
+			return nil, models.ErrNotAdmin
 		}
 	}
 
 	if input.GivenName != nil || input.FamilyName != nil {
 		if !isAdmin {
-			r.log.Info("user is not admin therefore cannot change name")
-			return nil, fmt.Errorf("unauthorised")
+			r.logger(ctx).Info("user is not admin therefore cannot change name")
+			return nil, models.ErrNotAdmin
 		}
 	}
 
@@ -59,7 +66,7 @@ func (r *mutationResolver) CreateBooking(ctx context.Context, input models.Booki
 		}
 	}
 
-	return r.bookingService.CreateBooking(ctx, input)
+	return r.createBooking(ctx, input)
 }
 
 func (r *mutationResolver) AddTable(ctx context.Context, input models.TableInput) (*models.Table, error) {
@@ -98,13 +105,14 @@ func (r *mutationResolver) RemoveAdmin(ctx context.Context, input models.RemoveA
 	}); err != nil {
 		return "", err
 	}
+	defer r.admins.removeAdmin(input.VenueID, input.Email)
 
 	return r.venueService.RemoveAdmin(ctx, input)
 }
 
 func (r *mutationResolver) CancelBooking(ctx context.Context, input models.CancelBookingInput) (*models.Booking, error) {
 	if input.VenueID == nil {
-		return nil, fmt.Errorf("venue ID must be given")
+		return nil, models.InvalidInputError("venue ID must be given")
 	}
 	if err := r.authIsAdmin(ctx, models.IsAdminInput{
 		VenueID: input.VenueID,
@@ -112,21 +120,25 @@ func (r *mutationResolver) CancelBooking(ctx context.Context, input models.Cance
 		return nil, err
 	}
 
-	return r.bookingService.CancelBooking(ctx, input)
+	booking, err := r.bookingService.CancelBooking(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	r.bookingEvents.Publish(ctx, models.BookingChange{Type: models.BookingChangeTypeCancelled, Booking: booking})
+
+	return booking, nil
 }
 
 func (r *mutationResolver) UpdateOpeningHours(ctx context.Context, input models.UpdateOpeningHoursInput) ([]*models.OpeningHoursSpecification, error) {
-	r.log.Infof("updating opening hours : %v", input)
+	r.logger(ctx).Infof("updating opening hours : %v", input)
 	if err := r.authIsAdmin(ctx, models.IsAdminInput{
 		VenueID: &input.VenueID,
 	}); err != nil {
-		r.log.Errorf("user is not admin")
 		return nil, err
 	}
 
 	hours, err := r.venueService.UpdateOpeningHours(ctx, input)
 	if err != nil {
-		r.log.Errorf("could not update opening hours : %s", err)
 		return nil, fmt.Errorf("could not update opening hours : %w", err)
 	}
 
@@ -137,22 +149,75 @@ func (r *mutationResolver) UpdateSpecialOpeningHours(ctx context.Context, input
 	if err := r.authIsAdmin(ctx, models.IsAdminInput{
 		VenueID: &input.VenueID,
 	}); err != nil {
-		r.log.Errorf("user is not admin")
 		return nil, err
 	}
 
 	hours, err := r.venueService.UpdateSpecialOpeningHours(ctx, input)
 	if err != nil {
-		r.log.Errorf("could not update special opening hours : %s", err)
 		return nil, fmt.Errorf("could not update special opening hours : %w", err)
 	}
 
 	return hours, nil
 }
 
+func (r *mutationResolver) CreateGuestBooking(ctx context.Context, input models.BookingInput) (*models.GuestBooking, error) {
+	if r.guestBookings == nil {
+		return nil, models.ErrGuestBookingsDisabled
+	}
+
+	slot, err := r.bookingService.GetSlot(ctx, models.SlotInput{
+		VenueID:  input.VenueID,
+		Email:    input.Email,
+		People:   input.People,
+		StartsAt: input.StartsAt,
+		Duration: input.Duration,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get slot : %w", err)
+	}
+	if slot.Match == nil {
+		return nil, models.ErrSlotUnavailable
+	}
+
+	return r.guestBookings.Request(ctx, input)
+}
+
+func (r *mutationResolver) ConfirmGuestBooking(ctx context.Context, token string) (*models.Booking, error) {
+	if r.guestBookings == nil {
+		return nil, models.ErrGuestBookingsDisabled
+	}
+
+	return r.guestBookings.Confirm(ctx, token, func(input models.BookingInput) (*models.Booking, error) {
+		return r.createBooking(ctx, input)
+	})
+}
+
+func (r *mutationResolver) CreateAPIKey(ctx context.Context, input models.APIKeyInput) (*models.CreatedAPIKey, error) {
+	if err := r.authIsAdmin(ctx, models.IsAdminInput{
+		VenueID: &input.VenueID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return r.venueService.CreateAPIKey(ctx, input)
+}
+
+func (r *mutationResolver) RevokeAPIKey(ctx context.Context, input models.RevokeAPIKeyInput) (*models.APIKey, error) {
+	if err := r.authIsAdmin(ctx, models.IsAdminInput{
+		VenueID: &input.VenueID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return r.venueService.RevokeAPIKey(ctx, input)
+}
+
 func (r *queryResolver) GetVenue(ctx context.Context, filter models.VenueFilter) (*models.Venue, error) {
 	if filter.ID == nil && filter.Slug == nil {
-		return nil, fmt.Errorf("at least one field must not be nil on filter")
+		return nil, models.InvalidInputError("at least one field must not be nil on filter")
+	}
+	if filter.ID != nil && filter.Slug == nil {
+		return r.loaders(ctx).venue(ctx, *filter.ID)
 	}
 	return r.venueService.GetVenue(ctx, filter)
 }
@@ -163,23 +228,36 @@ func (r *queryResolver) GetSlot(ctx context.Context, input models.SlotInput) (*m
 
 func (r *queryResolver) IsAdmin(ctx context.Context, input models.IsAdminInput) (bool, error) {
 	if input.VenueID == nil && input.Slug == nil {
-		return false, fmt.Errorf("either venue id or slug must be given")
+		return false, models.InvalidInputError("either venue id or slug must be given")
 	}
 
 	user, err := models.GetUserFromContext(ctx)
 	if err != nil {
-		return false, status.Errorf(codes.Internal, "could not get user profile : %s", err)
+		return false, models.ErrUnauthenticated
 	}
 
+	if input.VenueID != nil {
+		return r.loaders(ctx).isVenueAdmin(ctx, *input.VenueID, user.Email)
+	}
 	return r.venueService.IsAdmin(ctx, input, user.Email)
 }
 
+func (r *subscriptionResolver) BookingsChanged(ctx context.Context, venueID string, date time.Time) (<-chan *models.BookingChange, error) {
+	if err := r.authIsAdmin(ctx, models.IsAdminInput{
+		VenueID: &venueID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return r.bookingEvents.Subscribe(ctx, venueID, date), nil
+}
+
 func (r *venueResolver) OpeningHoursSpecification(ctx context.Context, obj *models.Venue, date *time.Time) (*models.OpeningHoursSpecification, error) {
 	if obj == nil || date == nil {
 		return nil, nil
 	}
 
-	return r.venueService.OpeningHoursSpecification(ctx, obj.ID, *date)
+	return r.loaders(ctx).venueOpeningHours(ctx, obj.ID, *date)
 }
 
 func (r *venueResolver) Tables(ctx context.Context, obj *models.Venue) ([]*models.Table, error) {
@@ -189,7 +267,7 @@ func (r *venueResolver) Tables(ctx context.Context, obj *models.Venue) ([]*model
 		return nil, err
 	}
 
-	return r.venueService.GetTables(ctx, obj.ID)
+	return r.loaders(ctx).venueTables(ctx, obj.ID)
 }
 
 func (r *venueResolver) Admins(ctx context.Context, obj *models.Venue) ([]string, error) {
@@ -199,10 +277,14 @@ func (r *venueResolver) Admins(ctx context.Context, obj *models.Venue) ([]string
 		return nil, err
 	}
 
-	return r.venueService.GetAdmins(ctx, obj.ID)
+	return r.loaders(ctx).venueAdmins(ctx, obj.ID)
 }
 
 func (r *venueResolver) Bookings(ctx context.Context, obj *models.Venue, filter *models.BookingsFilter, pageInfo *models.PageInfo) (*models.BookingsPage, error) {
+	if pageInfo != nil && pageInfo.Limit != nil && *pageInfo.Limit > maxPageLimit {
+		return nil, models.InvalidInputError(fmt.Sprintf("page limit must be at most %d", maxPageLimit))
+	}
+
 	if err := r.authIsAdmin(ctx, models.IsAdminInput{
 		VenueID: &obj.ID,
 	}); err != nil {
@@ -214,13 +296,20 @@ func (r *venueResolver) Bookings(ctx context.Context, obj *models.Venue, filter
 	}
 
 	if filter.VenueID != nil && *filter.VenueID != obj.ID {
-		return nil, fmt.Errorf("cannot query bookings for a different venue")
+		return nil, models.InvalidInputError("cannot query bookings for a different venue")
 	}
 
-	return r.bookingService.Bookings(ctx, models.BookingsFilter{
+	return r.loaders(ctx).venueBookings(ctx, obj.ID, filter.Date, *pageInfo)
+}
+
+func (r *venueResolver) APIKeys(ctx context.Context, obj *models.Venue) ([]*models.APIKey, error) {
+	if err := r.authIsAdmin(ctx, models.IsAdminInput{
 		VenueID: &obj.ID,
-		Date:    filter.Date,
-	}, *pageInfo)
+	}); err != nil {
+		return nil, err
+	}
+
+	return r.venueService.GetAPIKeys(ctx, obj.ID)
 }
 
 // Mutation returns generated.MutationResolver implementation.
@@ -229,9 +318,13 @@ func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResol
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
 // Venue returns generated.VenueResolver implementation.
 func (r *Resolver) Venue() generated.VenueResolver { return &venueResolver{r} }
 
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
 type venueResolver struct{ *Resolver }
@@ -2,6 +2,7 @@ package graph_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/99designs/gqlgen/client"
 	"github.com/99designs/gqlgen/graphql/handler"
@@ -11,6 +12,8 @@ import (
 	"github.com/cobbinma/booking-platform/lib/gateway_api/graph/generated"
 	mock_resolver "github.com/cobbinma/booking-platform/lib/gateway_api/graph/mock"
 	booking2 "github.com/cobbinma/booking-platform/lib/gateway_api/internal/booking"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/cache"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/guest"
 	venue2 "github.com/cobbinma/booking-platform/lib/gateway_api/internal/venue"
 	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
 	api2 "github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/booking/api"
@@ -22,6 +25,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/url"
 	"testing"
 	"time"
 )
@@ -34,21 +40,19 @@ func Test_GetVenue(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
 
-	venueClient.EXPECT().GetVenue(gomock.Any(), &api.GetVenueRequest{
-		Id:   venueID,
-		Slug: "",
-	}).Return(&venue.Venue{
+	venueClient.EXPECT().BatchGetVenues(gomock.Any(), &api.BatchGetVenuesRequest{Ids: []string{venueID}}).Return(&api.BatchGetVenuesResponse{Venues: []*venue.Venue{{
 		Id:                  venueID,
 		Name:                "hop and vine",
 		OpeningHours:        defaultOpeningHours(),
 		SpecialOpeningHours: nil,
 		Slug:                "hop-and-vine",
-	}, nil)
+		Version:             4,
+	}}}, nil)
 
 	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -71,10 +75,11 @@ func Test_GetVenue(t *testing.T) {
 				ValidFrom    string `json:"validFrom"`
 				ValidThrough string `json:"validThrough"`
 			} `json:"specialOpeningHours"`
-			Slug string `json:"slug"`
+			Slug    string `json:"slug"`
+			Version int    `json:"version"`
 		} `json:"getVenue"`
 	}
-	c.MustPost(`{getVenue(filter:{id:"a3291740-e89f-4cc0-845c-75c4c39842c9"}){id,name,openingHours{dayOfWeek,opens,closes,validFrom,validThrough},specialOpeningHours{dayOfWeek,opens, closes, validFrom,validThrough},slug}}`, &resp)
+	c.MustPost(`{getVenue(filter:{id:"a3291740-e89f-4cc0-845c-75c4c39842c9"}){id,name,openingHours{dayOfWeek,opens,closes,validFrom,validThrough},specialOpeningHours{dayOfWeek,opens, closes, validFrom,validThrough},slug,version}}`, &resp)
 
 	cupaloy.SnapshotT(t, resp)
 
@@ -98,24 +103,23 @@ func Test_GetVenueTables(t *testing.T) {
 		Slug:                "hop-and-vine",
 	}, nil)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: true}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{VenueIds: []string{venueID}}, nil)
 
-	venueClient.EXPECT().GetTables(gomock.Any(), &api.GetTablesRequest{VenueId: venueID}).Return(&api.GetTablesResponse{Tables: []*venue.Table{
-		{
-			Id:       "175fd06d-9a60-4ea6-86ca-bb96ca861208",
-			Name:     "table one",
-			Capacity: 4,
+	venueClient.EXPECT().GetTablesBatch(gomock.Any(), &api.GetTablesBatchRequest{VenueIds: []string{venueID}}).Return(&api.GetTablesBatchResponse{Venues: []*api.VenueTables{{
+		VenueId: venueID,
+		Tables: []*venue.Table{
+			{
+				Id:       "175fd06d-9a60-4ea6-86ca-bb96ca861208",
+				Name:     "table one",
+				Capacity: 4,
+			},
 		},
-	}}, nil)
+	}}}, nil)
 
 	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -157,23 +161,20 @@ func Test_GetVenueTablesNotAuthorised(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
 
-	venueClient.EXPECT().GetVenue(gomock.Any(), &api.GetVenueRequest{
-		Id:   venueID,
-		Slug: "",
-	}).Return(&venue.Venue{
+	venueClient.EXPECT().BatchGetVenues(gomock.Any(), &api.BatchGetVenuesRequest{Ids: []string{venueID}}).Return(&api.BatchGetVenuesResponse{Venues: []*venue.Venue{{
 		Id:                  venueID,
 		Name:                "hop and vine",
 		OpeningHours:        defaultOpeningHours(),
 		SpecialOpeningHours: nil,
 		Slug:                "hop-and-vine",
-	}, nil)
+	}}}, nil)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{VenueId: venueID, Email: "test@test.com"}).Return(&api.IsAdminResponse{IsAdmin: false}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{}, nil)
 
 	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -228,21 +229,24 @@ func Test_GetOpeningHoursSpecification(t *testing.T) {
 		Slug:                "hop-and-vine",
 	}, nil)
 
-	venueClient.EXPECT().GetOpeningHoursSpecification(gomock.Any(), &api.GetOpeningHoursSpecificationRequest{
+	venueClient.EXPECT().GetOpeningHoursSpecificationBatch(gomock.Any(), &api.GetOpeningHoursSpecificationBatchRequest{
+		VenueIds: []string{venueID},
+		Date:     date.Format(time.RFC3339),
+	}).Return(&api.GetOpeningHoursSpecificationBatchResponse{Venues: []*api.VenueOpeningHoursSpecification{{
 		VenueId: venueID,
-		Date:    date.Format(time.RFC3339),
-	}).Return(&api.GetOpeningHoursSpecificationResponse{Specification: &venue.OpeningHoursSpecification{
-		DayOfWeek:    1,
-		Opens:        "10:00",
-		Closes:       "19:00",
-		ValidFrom:    "",
-		ValidThrough: "",
-	}}, nil)
+		Specification: &venue.OpeningHoursSpecification{
+			DayOfWeek:    1,
+			Opens:        "10:00",
+			Closes:       "19:00",
+			ValidFrom:    "",
+			ValidThrough: "",
+		},
+	}}}, nil)
 
 	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -298,18 +302,15 @@ func Test_GetVenueAdmins(t *testing.T) {
 		Slug:                "hop-and-vine",
 	}, nil)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: true}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{VenueIds: []string{venueID}}, nil)
 
-	venueClient.EXPECT().GetAdmins(gomock.Any(), &api.GetAdminsRequest{VenueId: venueID}).Return(&api.GetAdminsResponse{Admins: []string{"test@test.com"}}, nil)
+	venueClient.EXPECT().GetAdminsBatch(gomock.Any(), &api.GetAdminsBatchRequest{VenueIds: []string{venueID}}).
+		Return(&api.GetAdminsBatchResponse{Venues: []*api.VenueAdmins{{VenueId: venueID, Admins: []string{"test@test.com"}}}}, nil)
 
 	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -347,27 +348,20 @@ func Test_GetVenueAdminsNotAuthorised(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
 
-	venueClient.EXPECT().GetVenue(gomock.Any(), &api.GetVenueRequest{
-		Id:   venueID,
-		Slug: "",
-	}).Return(&venue.Venue{
+	venueClient.EXPECT().BatchGetVenues(gomock.Any(), &api.BatchGetVenuesRequest{Ids: []string{venueID}}).Return(&api.BatchGetVenuesResponse{Venues: []*venue.Venue{{
 		Id:                  venueID,
 		Name:                "hop and vine",
 		OpeningHours:        defaultOpeningHours(),
 		SpecialOpeningHours: nil,
 		Slug:                "hop-and-vine",
-	}, nil)
+	}}}, nil)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: false}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{}, nil)
 
 	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -418,11 +412,7 @@ func Test_GetVenueBookings(t *testing.T) {
 		Slug:                "hop-and-vine",
 	}, nil)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: true}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{VenueIds: []string{venueID}}, nil)
 
 	limit := 5
 	date := time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -455,7 +445,7 @@ func Test_GetVenueBookings(t *testing.T) {
 	bookingSrv, _, err := booking2.NewBookingClient("", nil, nil, booking2.WithClient(bookingClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, bookingSrv)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, bookingSrv))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -519,16 +509,12 @@ func Test_GetVenueBookingsNotAuthorised(t *testing.T) {
 		Slug:                "hop-and-vine",
 	}, nil)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: false}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{}, nil)
 
 	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -579,16 +565,12 @@ func Test_AddTableNotAuthorised(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: false}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{}, nil)
 
 	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -600,7 +582,7 @@ func Test_AddTableNotAuthorised(t *testing.T) {
 			Capacity int    `json:"capacity"`
 		} `json:"addTable"`
 	}
-	assert.Error(t, c.Post(`mutation{addTable(input:{venueId:"a3291740-e89f-4cc0-845c-75c4c39842c9",name:"test table",capacity:5}) {id,name,capacity}}`, &resp), "user is not admin")
+	assert.Error(t, c.Post(`mutation{addTable(input:{venueId:"a3291740-e89f-4cc0-845c-75c4c39842c9",name:"test table",capacity:5,version:3}) {id,name,capacity}}`, &resp), "user is not admin")
 	cupaloy.SnapshotT(t, resp)
 
 	ctrl.Finish()
@@ -611,15 +593,12 @@ func Test_AddTable(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: true}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{VenueIds: []string{venueID}}, nil)
 	venueClient.EXPECT().AddTable(gomock.Any(), &api.AddTableRequest{
 		VenueId:  venueID,
 		Name:     "test table",
 		Capacity: 5,
+		Version:  3,
 	}).Return(&venue.Table{
 		Id:       "bfcc0d78-83e7-4830-96ab-96cdbd0357c7",
 		Name:     "test table",
@@ -637,10 +616,10 @@ func Test_AddTable(t *testing.T) {
 	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
-	client.New(e).MustPost(`mutation{addTable(input:{venueId:"a3291740-e89f-4cc0-845c-75c4c39842c9",name:"test table",capacity:5}) {id,name,capacity}}`, &resp)
+	client.New(e).MustPost(`mutation{addTable(input:{venueId:"a3291740-e89f-4cc0-845c-75c4c39842c9",name:"test table",capacity:5,version:3}) {id,name,capacity}}`, &resp)
 
 	cupaloy.SnapshotT(t, resp)
 	ctrl.Finish()
@@ -651,14 +630,11 @@ func Test_UpdateOpeningHours(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: true}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{VenueIds: []string{venueID}}, nil)
 
 	venueClient.EXPECT().UpdateOpeningHours(gomock.Any(), &api.UpdateOpeningHoursRequest{
 		VenueId: venueID,
+		Version: 3,
 		OpeningHours: []*venue.OpeningHoursSpecification{
 			{
 				DayOfWeek: 1,
@@ -685,28 +661,148 @@ func Test_UpdateOpeningHours(t *testing.T) {
 	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
-	client.New(e).MustPost(`mutation{updateOpeningHours(input:{venueId:"a3291740-e89f-4cc0-845c-75c4c39842c9",openingHours:[{dayOfWeek:1,opens:"10:00",closes:"22:00"}]}) {dayOfWeek,opens,closes}}`, &resp)
+	client.New(e).MustPost(`mutation{updateOpeningHours(input:{venueId:"a3291740-e89f-4cc0-845c-75c4c39842c9",openingHours:[{dayOfWeek:1,opens:"10:00",closes:"22:00"}],version:3}) {dayOfWeek,opens,closes}}`, &resp)
 
 	cupaloy.SnapshotT(t, resp)
 	ctrl.Finish()
 }
 
+func Test_UpdateOpeningHoursVersionConflict(t *testing.T) {
+	venueID := "a3291740-e89f-4cc0-845c-75c4c39842c9"
+	ctrl := gomock.NewController(t)
+	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
+
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{VenueIds: []string{venueID}}, nil)
+
+	venueClient.EXPECT().UpdateOpeningHours(gomock.Any(), &api.UpdateOpeningHoursRequest{
+		VenueId:      venueID,
+		Version:      2,
+		OpeningHours: []*venue.OpeningHoursSpecification{},
+	}).Return(nil, status.Error(codes.Aborted, "venue has been modified since version 2"))
+
+	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
+	require.NoError(t, err)
+
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
+	h.SetErrorPresenter(graph.NewErrorPresenter(zap.NewNop().Sugar()))
+	e := echo.New()
+	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
+
+	resp, err := client.New(e).RawPost(`mutation{updateOpeningHours(input:{venueId:"a3291740-e89f-4cc0-845c-75c4c39842c9",openingHours:[],version:2}) {dayOfWeek}}`)
+	require.NoError(t, err)
+
+	var errs []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	}
+	require.NoError(t, json.Unmarshal(resp.Errors, &errs))
+	require.Len(t, errs, 1)
+	assert.Equal(t, models.ErrVersionConflict.Error(), errs[0].Message)
+	assert.Equal(t, "VERSION_CONFLICT", errs[0].Extensions["code"])
+	ctrl.Finish()
+}
+
+func Test_GetVenueUnavailable(t *testing.T) {
+	venueID := "a3291740-e89f-4cc0-845c-75c4c39842c9"
+	ctrl := gomock.NewController(t)
+	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
+
+	venueClient.EXPECT().BatchGetVenues(gomock.Any(), &api.BatchGetVenuesRequest{Ids: []string{venueID}}).
+		Return(nil, status.Error(codes.Unavailable, "venue is unavailable : circuit breaker is open"))
+
+	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
+	require.NoError(t, err)
+
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
+	h.SetErrorPresenter(graph.NewErrorPresenter(zap.NewNop().Sugar()))
+	e := echo.New()
+	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
+
+	resp, err := client.New(e).RawPost(fmt.Sprintf(`{getVenue(filter:{id:"%s"}){name}}`, venueID))
+	require.NoError(t, err)
+
+	var errs []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	}
+	require.NoError(t, json.Unmarshal(resp.Errors, &errs))
+	require.Len(t, errs, 1)
+	assert.Equal(t, models.ErrServiceUnavailable.Error(), errs[0].Message)
+	assert.Equal(t, "UNAVAILABLE", errs[0].Extensions["code"])
+	ctrl.Finish()
+}
+
+func Test_ErrorsCarryRequestID(t *testing.T) {
+	venueID := "a3291740-e89f-4cc0-845c-75c4c39842c9"
+	ctrl := gomock.NewController(t)
+	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
+
+	venueClient.EXPECT().BatchGetVenues(gomock.Any(), &api.BatchGetVenuesRequest{Ids: []string{venueID}}).
+		Return(nil, status.Error(codes.Unavailable, "venue is unavailable : circuit breaker is open")).Times(2)
+
+	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
+	require.NoError(t, err)
+
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
+	h.SetErrorPresenter(graph.NewErrorPresenter(zap.NewNop().Sugar()))
+	e := echo.New()
+	e.POST("/", echo.WrapHandler(h), middleware.RequestID(), middleware.User(mockUserService{}))
+
+	query := fmt.Sprintf(`{getVenue(filter:{id:"%s"}){name}}`, venueID)
+	for _, test := range []struct {
+		name   string
+		header string
+		expect func(t *testing.T, id interface{})
+	}{
+		{
+			name:   "request id sent by the caller",
+			header: "0a4c5e9d-support",
+			expect: func(t *testing.T, id interface{}) {
+				assert.Equal(t, "0a4c5e9d-support", id)
+			},
+		},
+		{
+			name: "request id generated by the gateway",
+			expect: func(t *testing.T, id interface{}) {
+				assert.Len(t, id, 32)
+			},
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var options []client.Option
+			if test.header != "" {
+				options = append(options, client.AddHeader(echo.HeaderXRequestID, test.header))
+			}
+
+			resp, err := client.New(e).RawPost(query, options...)
+			require.NoError(t, err)
+
+			var errs []struct {
+				Extensions map[string]interface{} `json:"extensions"`
+			}
+			require.NoError(t, json.Unmarshal(resp.Errors, &errs))
+			require.Len(t, errs, 1)
+			assert.Equal(t, "UNAVAILABLE", errs[0].Extensions["code"])
+			test.expect(t, errs[0].Extensions["requestId"])
+		})
+	}
+	ctrl.Finish()
+}
+
 func Test_UpdateSpecialOpeningHours(t *testing.T) {
 	venueID := "a3291740-e89f-4cc0-845c-75c4c39842c9"
 	ctrl := gomock.NewController(t)
 	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
 	date := time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC).Format(time.RFC3339)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: true}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{VenueIds: []string{venueID}}, nil)
 	venueClient.EXPECT().UpdateSpecialOpeningHours(gomock.Any(), &api.UpdateOpeningHoursRequest{
 		VenueId: venueID,
+		Version: 3,
 		OpeningHours: []*venue.OpeningHoursSpecification{
 			{
 				DayOfWeek:    1,
@@ -737,10 +833,10 @@ func Test_UpdateSpecialOpeningHours(t *testing.T) {
 	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
-	client.New(e).MustPost(`mutation{updateSpecialOpeningHours(input:{venueId:"a3291740-e89f-4cc0-845c-75c4c39842c9",specialOpeningHours:[{dayOfWeek:1,validFrom:"3000-01-01T00:00:00Z",validThrough:"3000-01-01T00:00:00Z"}]}) {dayOfWeek,opens,closes,validFrom,validThrough}}`, &resp)
+	client.New(e).MustPost(`mutation{updateSpecialOpeningHours(input:{venueId:"a3291740-e89f-4cc0-845c-75c4c39842c9",specialOpeningHours:[{dayOfWeek:1,validFrom:"3000-01-01T00:00:00Z",validThrough:"3000-01-01T00:00:00Z"}],version:3}) {dayOfWeek,opens,closes,validFrom,validThrough}}`, &resp)
 
 	cupaloy.SnapshotT(t, resp)
 	ctrl.Finish()
@@ -751,16 +847,12 @@ func Test_RemoveTableNotAuthorised(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: false}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{}, nil)
 
 	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -772,7 +864,7 @@ func Test_RemoveTableNotAuthorised(t *testing.T) {
 			Capacity int    `json:"capacity"`
 		} `json:"removeTable"`
 	}
-	assert.Error(t, c.Post(`mutation{removeTable(input:{venueId:"a3291740-e89f-4cc0-845c-75c4c39842c9",tableId:"bfcc0d78-83e7-4830-96ab-96cdbd0357c7"}) {id,name,capacity}}`, &resp), "user is not admin")
+	assert.Error(t, c.Post(`mutation{removeTable(input:{venueId:"a3291740-e89f-4cc0-845c-75c4c39842c9",tableId:"bfcc0d78-83e7-4830-96ab-96cdbd0357c7",version:3}) {id,name,capacity}}`, &resp), "user is not admin")
 	cupaloy.SnapshotT(t, resp)
 
 	ctrl.Finish()
@@ -783,14 +875,11 @@ func Test_RemoveTable(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: true}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{VenueIds: []string{venueID}}, nil)
 	venueClient.EXPECT().RemoveTable(gomock.Any(), &api.RemoveTableRequest{
 		VenueId: venueID,
 		TableId: "bfcc0d78-83e7-4830-96ab-96cdbd0357c7",
+		Version: 3,
 	}).Return(&venue.Table{
 		Id:       "bfcc0d78-83e7-4830-96ab-96cdbd0357c7",
 		Name:     "test table",
@@ -808,10 +897,10 @@ func Test_RemoveTable(t *testing.T) {
 	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
-	client.New(e).MustPost(`mutation{removeTable(input:{venueId:"a3291740-e89f-4cc0-845c-75c4c39842c9",tableId:"bfcc0d78-83e7-4830-96ab-96cdbd0357c7"}) {id,name,capacity}}`, &resp)
+	client.New(e).MustPost(`mutation{removeTable(input:{venueId:"a3291740-e89f-4cc0-845c-75c4c39842c9",tableId:"bfcc0d78-83e7-4830-96ab-96cdbd0357c7",version:3}) {id,name,capacity}}`, &resp)
 
 	cupaloy.SnapshotT(t, resp)
 	ctrl.Finish()
@@ -822,16 +911,12 @@ func Test_AddAdminNotAuthorised(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: false}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{}, nil)
 
 	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -850,11 +935,7 @@ func Test_AddAdmin(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: true}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{VenueIds: []string{venueID}}, nil)
 	venueClient.EXPECT().AddAdmin(gomock.Any(), &api.AddAdminRequest{
 		VenueId: venueID,
 		Email:   "test@test.com",
@@ -867,7 +948,7 @@ func Test_AddAdmin(t *testing.T) {
 	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	client.New(e).MustPost(`mutation{addAdmin(input:{venueId:"a3291740-e89f-4cc0-845c-75c4c39842c9",email:"test@test.com"})}`, &resp)
@@ -881,16 +962,12 @@ func Test_RemoveAdminNotAuthorised(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: false}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{}, nil)
 
 	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -909,11 +986,7 @@ func Test_RemoveAdmin(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: true}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{VenueIds: []string{venueID}}, nil)
 	venueClient.EXPECT().RemoveAdmin(gomock.Any(), &api.RemoveAdminRequest{
 		VenueId: venueID,
 		Email:   "test@test.com",
@@ -926,7 +999,7 @@ func Test_RemoveAdmin(t *testing.T) {
 	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	client.New(e).MustPost(`mutation{removeAdmin(input:{venueId:"a3291740-e89f-4cc0-845c-75c4c39842c9",email:"test@test.com"})}`, &resp)
@@ -935,6 +1008,181 @@ func Test_RemoveAdmin(t *testing.T) {
 	ctrl.Finish()
 }
 
+func Test_AdminCacheIsPerVenue(t *testing.T) {
+	administered := "a3291740-e89f-4cc0-845c-75c4c39842c9"
+	other := "c2f6e3a1-8b4d-4f7e-9a0c-5d1b2e3f4a5b"
+	ctrl := gomock.NewController(t)
+	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
+
+	gomock.InOrder(
+		venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{administered}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{VenueIds: []string{administered}}, nil),
+		venueClient.EXPECT().AddTable(gomock.Any(), gomock.Any()).Return(&venue.Table{Id: "table one", Name: "one", Capacity: 4}, nil),
+		venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{other}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{}, nil),
+		venueClient.EXPECT().RemoveAdmin(gomock.Any(), &api.RemoveAdminRequest{VenueId: administered, Email: "test@test.com"}).Return(&api.RemoveAdminResponse{Email: "test@test.com"}, nil),
+		venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{administered}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{}, nil),
+	)
+
+	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
+	require.NoError(t, err)
+
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
+	e := echo.New()
+	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
+	c := client.New(e)
+
+	var resp map[string]interface{}
+	addTable := `mutation{addTable(input:{venueId:"%s",name:"one",capacity:4,version:1}){id}}`
+	c.MustPost(fmt.Sprintf(addTable, administered), &resp)
+	assert.Error(t, c.Post(fmt.Sprintf(addTable, other), &resp), "administering one venue should not allow changing another")
+
+	c.MustPost(fmt.Sprintf(`mutation{removeAdmin(input:{venueId:"%s",email:"test@test.com"})}`, administered), &resp)
+	assert.Error(t, c.Post(fmt.Sprintf(addTable, administered), &resp), "removed administrator should not be remembered")
+
+	ctrl.Finish()
+}
+
+func Test_CreateAPIKey(t *testing.T) {
+	venueID := "a3291740-e89f-4cc0-845c-75c4c39842c9"
+	ctrl := gomock.NewController(t)
+	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
+
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{VenueIds: []string{venueID}}, nil)
+	venueClient.EXPECT().CreateApiKey(gomock.Any(), &api.CreateApiKeyRequest{
+		VenueId: venueID,
+		Name:    "till",
+		Role:    venue.ApiKeyRole_MANAGE_BOOKINGS,
+	}).Return(&api.CreateApiKeyResponse{
+		ApiKey: &venue.ApiKey{
+			Id:        "0d5ab7f4-2a76-4b4f-8f3c-1f1a4e2ad0f3",
+			VenueId:   venueID,
+			Name:      "till",
+			Role:      venue.ApiKeyRole_MANAGE_BOOKINGS,
+			Prefix:    "bpk_Zm9vYmFy",
+			CreatedAt: "2021-06-01T12:00:00Z",
+		},
+		Key: "bpk_Zm9vYmFyYmF6",
+	}, nil)
+
+	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
+	require.NoError(t, err)
+
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
+	e := echo.New()
+	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
+
+	var resp struct {
+		CreateAPIKey struct {
+			Key    string `json:"key"`
+			APIKey struct {
+				ID         string  `json:"id"`
+				VenueID    string  `json:"venueId"`
+				Name       string  `json:"name"`
+				Role       string  `json:"role"`
+				Prefix     string  `json:"prefix"`
+				CreatedAt  string  `json:"createdAt"`
+				LastUsedAt *string `json:"lastUsedAt"`
+				RevokedAt  *string `json:"revokedAt"`
+			} `json:"apiKey"`
+		} `json:"createApiKey"`
+	}
+	client.New(e).MustPost(fmt.Sprintf(`mutation{createApiKey(input:{venueId:"%s",name:"till",role:MANAGE_BOOKINGS}){key,apiKey{id,venueId,name,role,prefix,createdAt,lastUsedAt,revokedAt}}}`, venueID), &resp)
+
+	cupaloy.SnapshotT(t, resp)
+	ctrl.Finish()
+}
+
+func Test_CreateAPIKeyNotAuthorised(t *testing.T) {
+	venueID := "a3291740-e89f-4cc0-845c-75c4c39842c9"
+	ctrl := gomock.NewController(t)
+	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
+
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{}, nil)
+
+	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
+	require.NoError(t, err)
+
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
+	e := echo.New()
+	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
+
+	var resp struct {
+		CreateAPIKey struct {
+			Key string `json:"key"`
+		} `json:"createApiKey"`
+	}
+	assert.Error(t, client.New(e).Post(fmt.Sprintf(`mutation{createApiKey(input:{venueId:"%s",name:"till",role:READ_BOOKINGS}){key}}`, venueID), &resp), "user is not admin")
+
+	ctrl.Finish()
+}
+
+func Test_RevokeAPIKey(t *testing.T) {
+	venueID := "a3291740-e89f-4cc0-845c-75c4c39842c9"
+	keyID := "0d5ab7f4-2a76-4b4f-8f3c-1f1a4e2ad0f3"
+	ctrl := gomock.NewController(t)
+	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
+
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{VenueIds: []string{venueID}}, nil)
+	venueClient.EXPECT().BatchGetVenues(gomock.Any(), &api.BatchGetVenuesRequest{Ids: []string{venueID}}).Return(&api.BatchGetVenuesResponse{Venues: []*venue.Venue{{
+		Id:           venueID,
+		Name:         "hop and vine",
+		OpeningHours: defaultOpeningHours(),
+		Slug:         "hop-and-vine",
+	}}}, nil)
+	venueClient.EXPECT().RevokeApiKey(gomock.Any(), &api.RevokeApiKeyRequest{VenueId: venueID, Id: keyID}).Return(&venue.ApiKey{
+		Id:         keyID,
+		VenueId:    venueID,
+		Name:       "till",
+		Role:       venue.ApiKeyRole_READ_BOOKINGS,
+		Prefix:     "bpk_Zm9vYmFy",
+		CreatedAt:  "2021-06-01T12:00:00Z",
+		LastUsedAt: "2021-06-02T09:30:00Z",
+		RevokedAt:  "2021-06-03T18:00:00Z",
+	}, nil)
+	venueClient.EXPECT().GetApiKeys(gomock.Any(), &api.GetApiKeysRequest{VenueId: venueID}).Return(&api.GetApiKeysResponse{
+		ApiKeys: []*venue.ApiKey{{
+			Id:         keyID,
+			VenueId:    venueID,
+			Name:       "till",
+			Role:       venue.ApiKeyRole_READ_BOOKINGS,
+			Prefix:     "bpk_Zm9vYmFy",
+			CreatedAt:  "2021-06-01T12:00:00Z",
+			LastUsedAt: "2021-06-02T09:30:00Z",
+			RevokedAt:  "2021-06-03T18:00:00Z",
+		}},
+	}, nil)
+
+	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
+	require.NoError(t, err)
+
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
+	e := echo.New()
+	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
+	c := client.New(e)
+
+	type apiKey struct {
+		ID         string  `json:"id"`
+		Role       string  `json:"role"`
+		LastUsedAt *string `json:"lastUsedAt"`
+		RevokedAt  *string `json:"revokedAt"`
+	}
+	var revoked struct {
+		RevokeAPIKey apiKey `json:"revokeApiKey"`
+	}
+	c.MustPost(fmt.Sprintf(`mutation{revokeApiKey(input:{venueId:"%s",id:"%s"}){id,role,lastUsedAt,revokedAt}}`, venueID, keyID), &revoked)
+
+	var keys struct {
+		GetVenue struct {
+			APIKeys []apiKey `json:"apiKeys"`
+		} `json:"getVenue"`
+	}
+	c.MustPost(fmt.Sprintf(`{getVenue(filter:{id:"%s"}){apiKeys{id,role,lastUsedAt,revokedAt}}}`, venueID), &keys)
+
+	require.Len(t, keys.GetVenue.APIKeys, 1)
+	assert.Equal(t, revoked.RevokeAPIKey, keys.GetVenue.APIKeys[0])
+	cupaloy.SnapshotT(t, revoked)
+	ctrl.Finish()
+}
+
 func Test_GetSlot(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	bookingClient := mock_resolver.NewMockBookingAPIClient(ctrl)
@@ -962,7 +1210,7 @@ func Test_GetSlot(t *testing.T) {
 	bookingService, _, err := booking2.NewBookingClient("", nil, nil, booking2.WithClient(bookingClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), nil, bookingService)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), nil, bookingService))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -994,11 +1242,7 @@ func Test_CreateBooking(t *testing.T) {
 	startsAt, err := time.Parse(time.RFC3339, "3000-06-20T12:41:45Z")
 	require.NoError(t, err)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: false}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{}, nil)
 
 	bookingClient.EXPECT().CreateBooking(gomock.Any(), &api2.BookingInput{
 		VenueId:    venueID,
@@ -1027,7 +1271,7 @@ func Test_CreateBooking(t *testing.T) {
 	bookingService, _, err := booking2.NewBookingClient("", nil, nil, booking2.WithClient(bookingClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueService, bookingService)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueService, bookingService))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -1059,11 +1303,7 @@ func Test_CreateBooking_As_Admin(t *testing.T) {
 	startsAt, err := time.Parse(time.RFC3339, "3000-06-20T12:41:45Z")
 	require.NoError(t, err)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: true}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{VenueIds: []string{venueID}}, nil)
 
 	bookingClient.EXPECT().CreateBooking(gomock.Any(), &api2.BookingInput{
 		VenueId:    venueID,
@@ -1092,7 +1332,7 @@ func Test_CreateBooking_As_Admin(t *testing.T) {
 	bookingService, _, err := booking2.NewBookingClient("", nil, nil, booking2.WithClient(bookingClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueService, bookingService)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueService, bookingService))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -1123,16 +1363,12 @@ func Test_IsAdminTrue(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: true}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{VenueIds: []string{venueID}}, nil)
 
 	venueService, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueService, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueService, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -1154,16 +1390,12 @@ func Test_IsAdminFalse(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: false}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{}, nil)
 
 	venueService, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueService, nil)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueService, nil))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -1189,11 +1421,7 @@ func Test_CancelBooking(t *testing.T) {
 	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
 	bookingClient := mock_resolver.NewMockBookingAPIClient(ctrl)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: true}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{VenueIds: []string{venueID}}, nil)
 
 	bookingClient.EXPECT().CancelBooking(gomock.Any(), &api2.CancelBookingRequest{
 		Id: bookingID,
@@ -1215,7 +1443,7 @@ func Test_CancelBooking(t *testing.T) {
 	bookingService, _, err := booking2.NewBookingClient("", nil, nil, booking2.WithClient(bookingClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueService, bookingService)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueService, bookingService))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -1246,18 +1474,14 @@ func Test_CancelBookingNotAuthorised(t *testing.T) {
 	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
 	bookingClient := mock_resolver.NewMockBookingAPIClient(ctrl)
 
-	venueClient.EXPECT().IsAdmin(gomock.Any(), &api.IsAdminRequest{
-		VenueId: venueID,
-		Slug:    "",
-		Email:   "test@test.com",
-	}).Return(&api.IsAdminResponse{IsAdmin: false}, nil)
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).Return(&api.IsAdminBatchResponse{}, nil)
 
 	venueService, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
 	require.NoError(t, err)
 	bookingService, _, err := booking2.NewBookingClient("", nil, nil, booking2.WithClient(bookingClient))
 	require.NoError(t, err)
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(zap.NewNop().Sugar(), venueService, bookingService)}))
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueService, bookingService))))
 	e := echo.New()
 	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
 	c := client.New(e)
@@ -1281,6 +1505,99 @@ func Test_CancelBookingNotAuthorised(t *testing.T) {
 	ctrl.Finish()
 }
 
+func Test_GuestBooking(t *testing.T) {
+	venueID := "8a18e89b-339b-4e51-ab53-825aae59a070"
+	ctrl := gomock.NewController(t)
+	bookingClient := mock_resolver.NewMockBookingAPIClient(ctrl)
+	startsAt, err := time.Parse(time.RFC3339, "3000-06-20T12:41:45Z")
+	require.NoError(t, err)
+
+	bookingClient.EXPECT().GetSlot(gomock.Any(), &api2.SlotInput{
+		VenueId:  venueID,
+		Email:    "guest@test.com",
+		People:   2,
+		StartsAt: startsAt.Format(time.RFC3339),
+		Duration: 60,
+	}).Return(&api2.GetSlotResponse{
+		Match: &booking.Slot{
+			VenueId:  venueID,
+			Email:    "guest@test.com",
+			People:   2,
+			StartsAt: startsAt.Format(time.RFC3339),
+			EndsAt:   startsAt.Add(time.Minute * 60).Format(time.RFC3339),
+			Duration: 60,
+		},
+	}, nil)
+
+	bookingClient.EXPECT().CreateBooking(gomock.Any(), &api2.BookingInput{
+		VenueId:    venueID,
+		Email:      "guest@test.com",
+		People:     2,
+		StartsAt:   startsAt.Format(time.RFC3339),
+		Duration:   60,
+		GivenName:  "Guest",
+		FamilyName: "",
+	}).Return(&booking.Booking{
+		Id:        "cca3c988-9e11-4b81-9a98-c960fb4a3d97",
+		VenueId:   venueID,
+		Email:     "guest@test.com",
+		People:    2,
+		StartsAt:  startsAt.Format(time.RFC3339),
+		EndsAt:    startsAt.Add(time.Minute * 60).Format(time.RFC3339),
+		Duration:  60,
+		TableId:   "6d3fe85d-a1cb-457c-bd53-48a40ee998e3",
+		GivenName: "Guest",
+	}, nil)
+
+	bookingService, _, err := booking2.NewBookingClient("", nil, nil, booking2.WithClient(bookingClient))
+	require.NoError(t, err)
+
+	mailer := &guestMailer{}
+	guestBookings, err := guest.NewGuestBookings([]byte("a guest booking secret of 32 bytes"), "https://booking.example.com/confirm", mailer,
+		cache.NewMemory(time.Minute))
+	require.NoError(t, err)
+
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), nil, bookingService, graph.WithGuestBookings(guestBookings)))))
+	e := echo.New()
+	e.POST("/", echo.WrapHandler(h), middleware.User(guestUserService{}))
+	c := client.New(e)
+
+	var requested struct {
+		CreateGuestBooking struct {
+			Email string `json:"email"`
+		} `json:"createGuestBooking"`
+	}
+	c.MustPost(fmt.Sprintf(`mutation{createGuestBooking(input:{venueId:"%s",email:"guest@test.com",givenName:"Guest",people:2,startsAt:"3000-06-20T12:41:45Z",duration:60}){email}}`, venueID), &requested)
+	assert.Equal(t, "guest@test.com", requested.CreateGuestBooking.Email)
+	assert.Equal(t, "guest@test.com", mailer.email)
+
+	link, err := url.Parse(mailer.link)
+	require.NoError(t, err)
+	token := link.Query().Get("token")
+
+	var resp struct {
+		ConfirmGuestBooking struct {
+			ID       string `json:"id"`
+			VenueID  string `json:"venueId"`
+			Email    string `json:"email"`
+			People   int    `json:"people"`
+			StartsAt string `json:"startsAt"`
+			EndsAt   string `json:"endsAt"`
+			Duration int    `json:"duration"`
+			TableID  string `json:"tableId"`
+		} `json:"confirmGuestBooking"`
+	}
+	c.MustPost(`mutation($token:String!){confirmGuestBooking(token:$token){id,venueId,email,people,startsAt,endsAt,duration,tableId}}`, &resp, client.Var("token", token))
+
+	cupaloy.SnapshotT(t, resp)
+
+	err = c.Post(`mutation($token:String!){confirmGuestBooking(token:$token){id}}`, &resp, client.Var("token", token))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), models.ErrBookingLinkUsed.Error())
+
+	ctrl.Finish()
+}
+
 func defaultOpeningHours() []*venue.OpeningHoursSpecification {
 	return []*venue.OpeningHoursSpecification{{
 		DayOfWeek:    1,
@@ -1297,6 +1614,16 @@ func defaultOpeningHours() []*venue.OpeningHoursSpecification {
 	}}
 }
 
+type guestMailer struct {
+	email string
+	link  string
+}
+
+func (m *guestMailer) SendConfirmation(ctx context.Context, email string, link string) error {
+	m.email, m.link = email, link
+	return nil
+}
+
 var _ models.UserService = (*mockUserService)(nil)
 
 type mockUserService struct{}
@@ -1308,3 +1635,11 @@ func (m mockUserService) GetUser(ctx context.Context) (*models.User, error) {
 		FamilyName: "Test",
 	}, nil
 }
+
+var _ models.UserService = (*guestUserService)(nil)
+
+type guestUserService struct{}
+
+func (m guestUserService) GetUser(ctx context.Context) (*models.User, error) {
+	return nil, models.ErrNoToken
+}
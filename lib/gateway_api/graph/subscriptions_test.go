@@ -0,0 +1,194 @@
+package graph_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/cmd/api/middleware"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/graph"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/graph/generated"
+	mock_resolver "github.com/cobbinma/booking-platform/lib/gateway_api/graph/mock"
+	booking2 "github.com/cobbinma/booking-platform/lib/gateway_api/internal/booking"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/events"
+	venue2 "github.com/cobbinma/booking-platform/lib/gateway_api/internal/venue"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	api2 "github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/booking/api"
+	booking "github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/booking/models"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+// tokenVerifier accepts the token "valid", for a user with the email test@test.com.
+type tokenVerifier struct{}
+
+func (tokenVerifier) Verify(ctx context.Context, token string) (map[string]interface{}, error) {
+	if token != "valid" {
+		return nil, errors.New("token is not valid")
+	}
+
+	return map[string]interface{}{"email": "test@test.com"}, nil
+}
+
+// subscribedEvents tells the test once a subscription has been made, so changes are not published before it is.
+type subscribedEvents struct {
+	*events.Bookings
+	subscribed chan struct{}
+}
+
+func (e subscribedEvents) Subscribe(ctx context.Context, venueID string, date time.Time) <-chan *models.BookingChange {
+	defer close(e.subscribed)
+
+	return e.Bookings.Subscribe(ctx, venueID, date)
+}
+
+func newSubscriptionClient(venueClient api.VenueAPIClient, bookingClient api2.BookingAPIClient, options ...func(*graph.Resolver)) (*client.Client, error) {
+	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
+	if err != nil {
+		return nil, err
+	}
+	bookingSrv, _, err := booking2.NewBookingClient("", nil, nil, booking2.WithClient(bookingClient))
+	if err != nil {
+		return nil, err
+	}
+
+	h := handler.New(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, bookingSrv, options...))))
+	h.AddTransport(transport.Websocket{
+		InitFunc:              middleware.WebsocketInit(tokenVerifier{}, models.NewClaimMapping(""), mockUserService{}),
+		KeepAlivePingInterval: time.Second,
+	})
+	h.AddTransport(transport.POST{})
+	e := echo.New()
+	e.GET("/", echo.WrapHandler(h))
+	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
+
+	return client.New(e), nil
+}
+
+func Test_BookingsChanged(t *testing.T) {
+	venueID := "8a18e89b-339b-4e51-ab53-825aae59a070"
+	startsAt := time.Date(3000, 6, 20, 12, 30, 0, 0, time.UTC)
+	ctrl := gomock.NewController(t)
+	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
+	bookingClient := mock_resolver.NewMockBookingAPIClient(ctrl)
+
+	created := &booking.Booking{
+		Id:       "cca3c988-9e11-4b81-9a98-c960fb4a3d97",
+		VenueId:  venueID,
+		Email:    "test@test.com",
+		People:   4,
+		StartsAt: startsAt.Format(time.RFC3339),
+		EndsAt:   startsAt.Add(time.Hour).Format(time.RFC3339),
+		Duration: 60,
+		TableId:  "6d3fe85d-a1cb-457c-bd53-48a40ee998e3",
+	}
+	nextDay := &booking.Booking{
+		Id:       "1e0c7a2b-5d4f-4c3e-8b9a-0f1e2d3c4b5a",
+		VenueId:  venueID,
+		Email:    "test@test.com",
+		People:   4,
+		StartsAt: startsAt.AddDate(0, 0, 1).Format(time.RFC3339),
+		EndsAt:   startsAt.AddDate(0, 0, 1).Add(time.Hour).Format(time.RFC3339),
+		Duration: 60,
+		TableId:  "6d3fe85d-a1cb-457c-bd53-48a40ee998e3",
+	}
+
+	// the subscription's check that the user administers the venue is remembered for the mutations
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).
+		Return(&api.IsAdminBatchResponse{VenueIds: []string{venueID}}, nil)
+	gomock.InOrder(
+		bookingClient.EXPECT().CreateBooking(gomock.Any(), gomock.Any()).Return(nextDay, nil),
+		bookingClient.EXPECT().CreateBooking(gomock.Any(), gomock.Any()).Return(created, nil),
+		bookingClient.EXPECT().CancelBooking(gomock.Any(), &api2.CancelBookingRequest{Id: created.Id}).Return(created, nil),
+	)
+
+	subscribed := make(chan struct{})
+	c, err := newSubscriptionClient(venueClient, bookingClient, graph.WithBookingEvents(subscribedEvents{events.NewBookings(), subscribed}))
+	require.NoError(t, err)
+
+	sub := c.WebsocketWithPayload(fmt.Sprintf(`subscription{bookingsChanged(venueId:"%s",date:"3000-06-20T00:00:00Z"){type,booking{id,startsAt}}}`, venueID),
+		map[string]interface{}{"Authorization": "Bearer valid"})
+	defer sub.Close()
+	select {
+	case <-subscribed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription was not made")
+	}
+
+	var resp map[string]interface{}
+	createBooking := `mutation{createBooking(input:{venueId:"%s",email:"test@test.com",people:4,startsAt:"%s",duration:60}){id}}`
+	c.MustPost(fmt.Sprintf(createBooking, venueID, nextDay.StartsAt), &resp)
+	c.MustPost(fmt.Sprintf(createBooking, venueID, created.StartsAt), &resp)
+	c.MustPost(fmt.Sprintf(`mutation{cancelBooking(input:{venueId:"%s",id:"%s"}){id}}`, venueID, created.Id), &resp)
+
+	type change struct {
+		BookingsChanged struct {
+			Type    string `json:"type"`
+			Booking struct {
+				ID       string `json:"id"`
+				StartsAt string `json:"startsAt"`
+			} `json:"booking"`
+		} `json:"bookingsChanged"`
+	}
+	var first, second change
+	require.NoError(t, sub.Next(&first))
+	require.NoError(t, sub.Next(&second))
+
+	assert.Equal(t, "CREATED", first.BookingsChanged.Type)
+	assert.Equal(t, created.Id, first.BookingsChanged.Booking.ID, "bookings on other dates should not be sent")
+	assert.Equal(t, "CANCELLED", second.BookingsChanged.Type)
+	assert.Equal(t, created.Id, second.BookingsChanged.Booking.ID)
+
+	ctrl.Finish()
+}
+
+func Test_BookingsChangedNotAdmin(t *testing.T) {
+	venueID := "8a18e89b-339b-4e51-ab53-825aae59a070"
+	ctrl := gomock.NewController(t)
+	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
+
+	venueClient.EXPECT().IsAdminBatch(gomock.Any(), &api.IsAdminBatchRequest{VenueIds: []string{venueID}, Email: "test@test.com"}).
+		Return(&api.IsAdminBatchResponse{}, nil)
+
+	c, err := newSubscriptionClient(venueClient, mock_resolver.NewMockBookingAPIClient(ctrl))
+	require.NoError(t, err)
+
+	sub := c.WebsocketWithPayload(fmt.Sprintf(`subscription{bookingsChanged(venueId:"%s",date:"3000-06-20T00:00:00Z"){type}}`, venueID),
+		map[string]interface{}{"Authorization": "Bearer valid"})
+	defer sub.Close()
+
+	var resp map[string]interface{}
+	assert.EqualError(t, sub.Next(&resp), `[{"message":"only administrators of the venue may do this","path":["bookingsChanged"]}]`)
+
+	ctrl.Finish()
+}
+
+func Test_BookingsChangedUnauthenticated(t *testing.T) {
+	for name, payload := range map[string]map[string]interface{}{
+		"without a token":     nil,
+		"with invalid token":  {"Authorization": "Bearer expired"},
+		"without bearer type": {"Authorization": "valid"},
+	} {
+		payload := payload
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c, err := newSubscriptionClient(mock_resolver.NewMockVenueAPIClient(ctrl), mock_resolver.NewMockBookingAPIClient(ctrl))
+			require.NoError(t, err)
+
+			sub := c.WebsocketWithPayload(`subscription{bookingsChanged(venueId:"8a18e89b-339b-4e51-ab53-825aae59a070",date:"3000-06-20T00:00:00Z"){type}}`, payload)
+			defer sub.Close()
+
+			var resp map[string]interface{}
+			assert.Error(t, sub.Next(&resp), "connection should be refused")
+			ctrl.Finish()
+		})
+	}
+}
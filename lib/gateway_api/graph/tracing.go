@@ -0,0 +1,79 @@
+package graph
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/cobbinma/booking-platform/lib/gateway_api/graph"
+
+// Tracing is a gqlgen extension starting a span for each operation and for each field with a resolver, so slow
+// resolvers can be told apart from the services they call.
+type Tracing struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.FieldInterceptor
+} = Tracing{}
+
+func (Tracing) ExtensionName() string {
+	return "Tracing"
+}
+
+func (Tracing) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (Tracing) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	oc := graphql.GetOperationContext(ctx)
+	name := oc.OperationName
+	if name == "" && oc.Operation != nil {
+		name = oc.Operation.Name
+	}
+
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "graphql.operation "+name, trace.WithAttributes(
+		attribute.String("graphql.operation.name", name),
+	))
+	if oc.Operation != nil {
+		span.SetAttributes(attribute.String("graphql.operation.type", string(oc.Operation.Operation)))
+	}
+
+	handler := next(ctx)
+
+	// the span covers the operation until its first response, subscriptions are not traced beyond that
+	return func(ctx context.Context) *graphql.Response {
+		defer span.End()
+
+		resp := handler(ctx)
+		if resp != nil && len(resp.Errors) > 0 {
+			span.SetStatus(codes.Error, resp.Errors.Error())
+		}
+
+		return resp
+	}
+}
+
+func (Tracing) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	ctx, span := otel.Tracer(tracerName).Start(ctx, fc.Object+"."+fc.Field.Name, trace.WithAttributes(
+		attribute.String("graphql.field.path", fc.Path().String()),
+	))
+	defer span.End()
+
+	res, err := next(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return res, err
+}
@@ -0,0 +1,60 @@
+package graph_test
+
+import (
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/cmd/api/middleware"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/graph"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/graph/generated"
+	mock_resolver "github.com/cobbinma/booking-platform/lib/gateway_api/graph/mock"
+	venue2 "github.com/cobbinma/booking-platform/lib/gateway_api/internal/venue"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	venue "github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/models"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func Test_Tracing(t *testing.T) {
+	sr := new(oteltest.SpanRecorder)
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr)))
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+	})
+
+	venueID := "a3291740-e89f-4cc0-845c-75c4c39842c9"
+	ctrl := gomock.NewController(t)
+	venueClient := mock_resolver.NewMockVenueAPIClient(ctrl)
+
+	venueClient.EXPECT().BatchGetVenues(gomock.Any(), &api.BatchGetVenuesRequest{Ids: []string{venueID}}).
+		Return(&api.BatchGetVenuesResponse{Venues: []*venue.Venue{{Id: venueID, Name: "hop and vine", Slug: "hop-and-vine"}}}, nil)
+
+	venueSrv, _, err := venue2.NewVenueClient("", nil, nil, venue2.WithClient(venueClient))
+	require.NoError(t, err)
+
+	h := handler.NewDefaultServer(generated.NewExecutableSchema(graph.NewConfig(graph.NewResolver(zap.NewNop().Sugar(), venueSrv, nil))))
+	h.Use(graph.Tracing{})
+	e := echo.New()
+	e.POST("/", echo.WrapHandler(h), middleware.User(mockUserService{}))
+	c := client.New(e)
+
+	var resp struct {
+		GetVenue struct {
+			Name string `json:"name"`
+		} `json:"getVenue"`
+	}
+	c.MustPost(`query TracingVenue { getVenue(filter: {id: "a3291740-e89f-4cc0-845c-75c4c39842c9"}) { name } }`, &resp)
+
+	spans := sr.Completed()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "Query.getVenue", spans[0].Name())
+	assert.Equal(t, "graphql.operation TracingVenue", spans[1].Name())
+	assert.Equal(t, spans[1].SpanContext().SpanID(), spans[0].ParentSpanID())
+	assert.Equal(t, spans[1].SpanContext().TraceID(), spans[0].SpanContext().TraceID())
+}
@@ -0,0 +1,81 @@
+package balancing
+
+import (
+	"encoding/json"
+	"fmt"
+	"google.golang.org/grpc"
+	_ "google.golang.org/grpc/health" // registers the client side health checking used by the service config
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+	"strings"
+	"time"
+)
+
+// Options says how calls are spread across the replicas of a service.
+type Options struct {
+	// Endpoints are the addresses of the service's replicas. A single endpoint may instead be a target naming a
+	// resolver, such as dns:///venue:8888, whose addresses are looked up again as replicas come and go.
+	Endpoints []string
+	// HealthCheck stops calls going to replicas that do not report themselves serving with the gRPC health protocol,
+	// for the named service.
+	HealthCheck bool
+	ServiceName string
+	// KeepaliveTime is how long a connection may be idle before it is pinged, so broken connections to replicas are
+	// noticed without waiting for a call to fail. Keepalive pings are not sent when it is zero.
+	KeepaliveTime    time.Duration
+	KeepaliveTimeout time.Duration
+}
+
+type serviceConfig struct {
+	LoadBalancingConfig []map[string]struct{} `json:"loadBalancingConfig"`
+	HealthCheckConfig   *healthCheckConfig    `json:"healthCheckConfig,omitempty"`
+}
+
+type healthCheckConfig struct {
+	ServiceName string `json:"serviceName"`
+}
+
+// Dial returns the target to dial the named service with and the options that balance calls across its replicas
+// round robin.
+func Dial(name string, o Options) (string, []grpc.DialOption, error) {
+	if len(o.Endpoints) == 0 {
+		return "", nil, fmt.Errorf("no endpoints given for %s", name)
+	}
+
+	config := serviceConfig{LoadBalancingConfig: []map[string]struct{}{{"round_robin": {}}}}
+	if o.HealthCheck {
+		config.HealthCheckConfig = &healthCheckConfig{ServiceName: o.ServiceName}
+	}
+	b, err := json.Marshal(config)
+	if err != nil {
+		return "", nil, fmt.Errorf("could not marshal service config : %w", err)
+	}
+
+	opts := []grpc.DialOption{grpc.WithDefaultServiceConfig(string(b))}
+	if o.KeepaliveTime > 0 {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                o.KeepaliveTime,
+			Timeout:             o.KeepaliveTimeout,
+			PermitWithoutStream: true,
+		}))
+	}
+
+	if len(o.Endpoints) == 1 {
+		return o.Endpoints[0], opts, nil
+	}
+
+	// a list of replicas is given to the client by a resolver of its own, named for the service
+	addresses := make([]resolver.Address, len(o.Endpoints))
+	for i := range o.Endpoints {
+		if strings.Contains(o.Endpoints[i], "://") {
+			return "", nil, fmt.Errorf("endpoint %q of %s must be an address when more than one is given", o.Endpoints[i], name)
+		}
+		addresses[i] = resolver.Address{Addr: o.Endpoints[i]}
+	}
+
+	r := manual.NewBuilderWithScheme(name)
+	r.InitialState(resolver.State{Addresses: addresses})
+
+	return fmt.Sprintf("%s:///%s", name, name), append(opts, grpc.WithResolvers(r)), nil
+}
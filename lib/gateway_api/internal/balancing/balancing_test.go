@@ -0,0 +1,121 @@
+package balancing_test
+
+import (
+	"context"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/balancing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// replica is a health server that counts the calls it answers.
+type replica struct {
+	address string
+	health  *health.Server
+	calls   int64
+}
+
+func newReplica(t *testing.T) *replica {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	r := &replica{address: lis.Addr().String(), health: health.NewServer()}
+	s := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		atomic.AddInt64(&r.calls, 1)
+		return handler(ctx, req)
+	}))
+	healthpb.RegisterHealthServer(s, r.health)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	return r
+}
+
+func (r *replica) answered() int64 {
+	return atomic.LoadInt64(&r.calls)
+}
+
+func dial(t *testing.T, options balancing.Options) healthpb.HealthClient {
+	target, opts, err := balancing.Dial("test", options)
+	require.NoError(t, err)
+
+	conn, err := grpc.Dial(target, append(opts, grpc.WithInsecure())...)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return healthpb.NewHealthClient(conn)
+}
+
+func check(t *testing.T, client healthpb.HealthClient) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := client.Check(ctx, &healthpb.HealthCheckRequest{}, grpc.WaitForReady(true))
+	require.NoError(t, err)
+}
+
+func Test_RoundRobin(t *testing.T) {
+	a, b := newReplica(t), newReplica(t)
+	client := dial(t, balancing.Options{Endpoints: []string{a.address, b.address}})
+
+	assert.Eventually(t, func() bool {
+		check(t, client)
+		return a.answered() > 0 && b.answered() > 0
+	}, 5*time.Second, 10*time.Millisecond, "calls should reach every replica")
+
+	before := a.answered()
+	for i := 0; i < 10; i++ {
+		check(t, client)
+	}
+	assert.Equal(t, int64(5), a.answered()-before, "calls should be spread evenly")
+}
+
+func Test_HealthCheck(t *testing.T) {
+	a, b := newReplica(t), newReplica(t)
+	a.health.SetServingStatus("test.api.TestAPI", healthpb.HealthCheckResponse_SERVING)
+	b.health.SetServingStatus("test.api.TestAPI", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	client := dial(t, balancing.Options{
+		Endpoints:   []string{a.address, b.address},
+		HealthCheck: true,
+		ServiceName: "test.api.TestAPI",
+	})
+
+	for i := 0; i < 10; i++ {
+		check(t, client)
+	}
+	assert.Equal(t, int64(10), a.answered())
+	assert.Equal(t, int64(0), b.answered(), "replica that is not serving should not be called")
+
+	b.health.SetServingStatus("test.api.TestAPI", healthpb.HealthCheckResponse_SERVING)
+	assert.Eventually(t, func() bool {
+		check(t, client)
+		return b.answered() > 0
+	}, 5*time.Second, 10*time.Millisecond, "replica should be called once it is serving")
+}
+
+func Test_Dial(t *testing.T) {
+	target, _, err := balancing.Dial("venue", balancing.Options{Endpoints: []string{"dns:///venue:8888"}})
+	require.NoError(t, err)
+	assert.Equal(t, "dns:///venue:8888", target, "single endpoint should be dialled as given")
+
+	target, _, err = balancing.Dial("venue", balancing.Options{Endpoints: []string{"venue-0:8888", "venue-1:8888"}})
+	require.NoError(t, err)
+	assert.Equal(t, "venue:///venue", target)
+
+	_, _, err = balancing.Dial("venue", balancing.Options{})
+	assert.Error(t, err)
+
+	_, _, err = balancing.Dial("venue", balancing.Options{Endpoints: []string{"venue-0:8888", "dns:///venue:8888"}})
+	assert.Error(t, err)
+}
@@ -4,8 +4,13 @@ import (
 	"context"
 	"fmt"
 	"github.com/cobbinma/booking-platform/lib/gateway_api/graph"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/balancing"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/correlation"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/health"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/resilience"
 	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
 	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/booking/api"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"golang.org/x/oauth2"
 	"google.golang.org/grpc"
@@ -14,10 +19,14 @@ import (
 	"time"
 )
 
-func NewBookingClient(url string, log *zap.SugaredLogger, token *oauth2.Token, options ...func(*bookingClient)) (graph.BookingService, func(log *zap.SugaredLogger), error) {
+func NewBookingClient(url string, log *zap.SugaredLogger, tokens oauth2.TokenSource, options ...func(*bookingClient)) (graph.BookingService, func(log *zap.SugaredLogger), error) {
 	bc := &bookingClient{
-		client: nil,
-		log:    log,
+		client:     nil,
+		log:        log,
+		certFile:   "localhost.crt",
+		serverName: "localhost",
+		policy:     DefaultPolicy(),
+		balancing:  DefaultBalancing(),
 	}
 	cl := func(log *zap.SugaredLogger) {}
 
@@ -26,20 +35,37 @@ func NewBookingClient(url string, log *zap.SugaredLogger, token *oauth2.Token, o
 	}
 
 	if bc.client == nil {
-		creds, err := credentials.NewClientTLSFromFile("localhost.crt", "localhost")
+		creds, err := credentials.NewClientTLSFromFile(bc.certFile, bc.serverName)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to load credentials : %w", err)
 		}
 
 		opts := []grpc.DialOption{
-			grpc.WithPerRPCCredentials(oauth.NewOauthAccess(token)),
+			grpc.WithPerRPCCredentials(oauth.TokenSource{TokenSource: tokens}),
 			grpc.WithTransportCredentials(creds),
+			grpc.WithChainUnaryInterceptor(
+				correlation.UnaryClientInterceptor(),
+				resilience.UnaryClientInterceptor("booking", bc.policy),
+				otelgrpc.UnaryClientInterceptor(),
+			),
+			grpc.WithChainStreamInterceptor(correlation.StreamClientInterceptor(), otelgrpc.StreamClientInterceptor()),
 		}
-		conn, err := grpc.Dial(url, opts...)
+		if len(bc.balancing.Endpoints) == 0 {
+			bc.balancing.Endpoints = []string{url}
+		}
+		target, balancingOpts, err := balancing.Dial("booking", bc.balancing)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not balance calls : %w", err)
+		}
+		conn, err := grpc.Dial(target, append(opts, balancingOpts...)...)
 		if err != nil {
 			return nil, nil, fmt.Errorf("could not connect : %s", err)
 		}
 
+		if bc.readiness != nil {
+			bc.readiness.Add("booking", health.Conn(conn))
+		}
+
 		cl = func(log *zap.SugaredLogger) {
 			if err := conn.Close(); err != nil {
 				log.Error("could not close connection : %s", err)
@@ -57,9 +83,67 @@ func WithClient(client api.BookingAPIClient) func(*bookingClient) {
 	}
 }
 
+// WithTLS verifies the service with the certificate in the file, expecting it to be issued for the server name.
+func WithTLS(certFile string, serverName string) func(*bookingClient) {
+	return func(c *bookingClient) {
+		c.certFile = certFile
+		c.serverName = serverName
+	}
+}
+
+// WithPolicy sets the deadlines, retries and circuit breaking of calls to the service.
+func WithPolicy(policy resilience.Policy) func(*bookingClient) {
+	return func(c *bookingClient) {
+		c.policy = policy
+	}
+}
+
+// WithReadiness reports the gateway ready only while the service can be reached.
+func WithReadiness(readiness *health.Readiness) func(*bookingClient) {
+	return func(c *bookingClient) {
+		c.readiness = readiness
+	}
+}
+
+// WithBalancing spreads calls across the replicas of the service. The url the client is created with is dialled when no
+// endpoints are given.
+func WithBalancing(options balancing.Options) func(*bookingClient) {
+	return func(c *bookingClient) {
+		c.balancing = options
+	}
+}
+
+// DefaultBalancing neither checks the health of replicas nor pings idle connections, as the service does not yet serve
+// the gRPC health protocol.
+func DefaultBalancing() balancing.Options {
+	return balancing.Options{ServiceName: "booking.api.BookingAPI"}
+}
+
+const serviceName = "/booking.api.BookingAPI"
+
+// DefaultPolicy retries the calls that only read from the service.
+func DefaultPolicy() resilience.Policy {
+	policy := resilience.DefaultPolicy()
+	policy.Timeout = 5 * time.Second
+	policy.Timeouts = map[string]time.Duration{
+		serviceName + "/CreateBooking": 10 * time.Second,
+	}
+	policy.Idempotent = map[string]bool{
+		serviceName + "/GetSlot":     true,
+		serviceName + "/GetBookings": true,
+	}
+
+	return policy
+}
+
 type bookingClient struct {
-	client api.BookingAPIClient
-	log    *zap.SugaredLogger
+	client     api.BookingAPIClient
+	log        *zap.SugaredLogger
+	certFile   string
+	serverName string
+	policy     resilience.Policy
+	balancing  balancing.Options
+	readiness  *health.Readiness
 }
 
 func (b bookingClient) CancelBooking(ctx context.Context, input models.CancelBookingInput) (*models.Booking, error) {
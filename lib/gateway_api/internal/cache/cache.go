@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"github.com/patrickmn/go-cache"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Cache holds values for a while. Values are bytes so a cache shared between replicas of the gateway, such as redis, can
+// be used in place of the one held in memory. A ttl that is not positive keeps the value until it is deleted.
+type Cache interface {
+	// Get returns the value of the key, and whether it has one.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set gives the key the value until the ttl has passed.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Add gives the key the value until the ttl has passed unless it already has one, reporting whether it was given it.
+	// It is atomic, so of the callers adding the same key only one is told it did.
+	Add(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error)
+	// Increment adds one to the count held by the key and returns it, starting the count for the ttl when there is none.
+	// It is atomic, so concurrent callers are each given a different count.
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
+	// Delete removes the keys, ignoring those that have no value.
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// NewMemory returns a cache held in the gateway's memory, removing expired values every cleanup interval.
+func NewMemory(cleanupInterval time.Duration) Cache {
+	return memory{cache: cache.New(cache.NoExpiration, cleanupInterval), counts: &sync.Mutex{}}
+}
+
+type memory struct {
+	cache *cache.Cache
+	// counts is held while a count is read and replaced, so concurrent increments are not lost
+	counts *sync.Mutex
+}
+
+func (m memory) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, found := m.cache.Get(key)
+	if !found {
+		return nil, false, nil
+	}
+
+	return value.([]byte), true, nil
+}
+
+func (m memory) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	m.cache.Set(key, value, ttl)
+
+	return nil
+}
+
+func (m memory) Add(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	return m.cache.Add(key, value, ttl) == nil, nil
+}
+
+func (m memory) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	m.counts.Lock()
+	defer m.counts.Unlock()
+
+	value, expires, found := m.cache.GetWithExpiration(key)
+	if !found {
+		m.cache.Set(key, []byte("1"), ttl)
+		return 1, nil
+	}
+
+	n, err := strconv.ParseInt(string(value.([]byte)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("value of '%s' is not a count : %w", key, err)
+	}
+	n++
+
+	// the count keeps the expiry it was started with
+	ttl = cache.NoExpiration
+	if !expires.IsZero() {
+		if ttl = time.Until(expires); ttl <= 0 {
+			ttl = time.Nanosecond
+		}
+	}
+	m.cache.Set(key, []byte(strconv.FormatInt(n, 10)), ttl)
+
+	return n, nil
+}
+
+func (m memory) Delete(ctx context.Context, keys ...string) error {
+	for _, key := range keys {
+		m.cache.Delete(key)
+	}
+
+	return nil
+}
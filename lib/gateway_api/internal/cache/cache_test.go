@@ -0,0 +1,84 @@
+package cache_test
+
+import (
+	"context"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func Test_Memory(t *testing.T) {
+	ctx := context.Background()
+	c := cache.NewMemory(time.Minute)
+
+	_, found, err := c.Get(ctx, "venue")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, c.Set(ctx, "venue", []byte("hop and vine"), time.Minute))
+	require.NoError(t, c.Set(ctx, "tables", []byte("table one"), time.Minute))
+	value, found, err := c.Get(ctx, "venue")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []byte("hop and vine"), value)
+
+	require.NoError(t, c.Delete(ctx, "venue", "unknown"))
+	_, found, err = c.Get(ctx, "venue")
+	require.NoError(t, err)
+	assert.False(t, found, "deleted key should have no value")
+	_, found, err = c.Get(ctx, "tables")
+	require.NoError(t, err)
+	assert.True(t, found, "keys not deleted should keep their value")
+}
+
+func Test_MemoryExpires(t *testing.T) {
+	ctx := context.Background()
+	c := cache.NewMemory(time.Minute)
+
+	require.NoError(t, c.Set(ctx, "venue", []byte("hop and vine"), time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+
+	_, found, err := c.Get(ctx, "venue")
+	require.NoError(t, err)
+	assert.False(t, found, "value should expire once the ttl has passed")
+}
+
+func Test_MemoryAdd(t *testing.T) {
+	ctx := context.Background()
+	c := cache.NewMemory(time.Minute)
+
+	added, err := c.Add(ctx, "link", []byte("used"), time.Minute)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	added, err = c.Add(ctx, "link", []byte("used again"), time.Minute)
+	require.NoError(t, err)
+	assert.False(t, added, "key with a value should not be given another")
+	value, _, err := c.Get(ctx, "link")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("used"), value)
+
+	require.NoError(t, c.Set(ctx, "expiring", []byte("used"), time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+	added, err = c.Add(ctx, "expiring", []byte("used again"), time.Minute)
+	require.NoError(t, err)
+	assert.True(t, added, "expired key should be given the value")
+}
+
+func Test_MemoryIncrement(t *testing.T) {
+	ctx := context.Background()
+	c := cache.NewMemory(time.Minute)
+
+	for expected := int64(1); expected <= 3; expected++ {
+		n, err := c.Increment(ctx, "requests", 20*time.Millisecond)
+		require.NoError(t, err)
+		assert.Equal(t, expected, n)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	n, err := c.Increment(ctx, "requests", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n, "count should start again once the ttl it was started with has passed")
+}
@@ -0,0 +1,167 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/health"
+	"github.com/gomodule/redigo/redis"
+	"go.uber.org/zap"
+	"net/url"
+	"time"
+)
+
+// increment starts a count with its expiry in one step, so a count is never left without one.
+var increment = redis.NewScript(1, `
+local n = redis.call("INCR", KEYS[1])
+if n == 1 and tonumber(ARGV[1]) > 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return n
+`)
+
+type redisCache struct {
+	pool      *redis.Pool
+	readiness *health.Readiness
+}
+
+// NewRedis returns a cache held by the redis server at the url, such as redis://:password@redis:6379/0, and shared by
+// every replica of the gateway given it. Values outlive the gateway's restarts for as long as redis keeps them.
+func NewRedis(rawURL string, options ...func(*redisCache)) (Cache, func(log *zap.SugaredLogger), error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not parse redis url : %w", err)
+	}
+	if (u.Scheme != "redis" && u.Scheme != "rediss") || u.Host == "" {
+		return nil, nil, fmt.Errorf("redis url must be a redis:// or rediss:// url with a host")
+	}
+
+	r := &redisCache{
+		pool: &redis.Pool{
+			MaxIdle:     10,
+			IdleTimeout: 5 * time.Minute,
+			DialContext: func(ctx context.Context) (redis.Conn, error) {
+				return redis.DialURLContext(ctx, rawURL)
+			},
+		},
+	}
+	for _, option := range options {
+		option(r)
+	}
+
+	if r.readiness != nil {
+		r.readiness.Add("cache", r.ready)
+	}
+
+	cl := func(log *zap.SugaredLogger) {
+		if err := r.pool.Close(); err != nil {
+			log.Errorf("could not close redis connections : %s", err)
+		}
+	}
+
+	return r, cl, nil
+}
+
+// WithReadiness reports the gateway ready only while redis can be reached.
+func WithReadiness(readiness *health.Readiness) func(*redisCache) {
+	return func(r *redisCache) {
+		r.readiness = readiness
+	}
+}
+
+func (r *redisCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := redis.Bytes(r.do(ctx, "GET", key))
+	if errors.Is(err, redis.ErrNil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("could not get '%s' : %w", key, err)
+	}
+
+	return value, true, nil
+}
+
+func (r *redisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if _, err := r.do(ctx, "SET", append([]interface{}{key, value}, expiry(ttl)...)...); err != nil {
+		return fmt.Errorf("could not set '%s' : %w", key, err)
+	}
+
+	return nil
+}
+
+func (r *redisCache) Add(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	_, err := redis.String(r.do(ctx, "SET", append([]interface{}{key, value, "NX"}, expiry(ttl)...)...))
+	if errors.Is(err, redis.ErrNil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("could not add '%s' : %w", key, err)
+	}
+
+	return true, nil
+}
+
+func (r *redisCache) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	conn, err := r.pool.GetContext(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("could not connect to redis : %w", err)
+	}
+	defer conn.Close()
+
+	n, err := redis.Int64(increment.DoContext(ctx, conn, key, milliseconds(ttl)))
+	if err != nil {
+		return 0, fmt.Errorf("could not increment '%s' : %w", key, err)
+	}
+
+	return n, nil
+}
+
+func (r *redisCache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, len(keys))
+	for i, key := range keys {
+		args[i] = key
+	}
+	if _, err := r.do(ctx, "DEL", args...); err != nil {
+		return fmt.Errorf("could not delete keys : %w", err)
+	}
+
+	return nil
+}
+
+func (r *redisCache) ready(ctx context.Context) error {
+	_, err := r.do(ctx, "PING")
+
+	return err
+}
+
+func (r *redisCache) do(ctx context.Context, command string, args ...interface{}) (interface{}, error) {
+	conn, err := r.pool.GetContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to redis : %w", err)
+	}
+	defer conn.Close()
+
+	return redis.DoContext(conn, ctx, command, args...)
+}
+
+// expiry returns the arguments setting a key to expire once the ttl has passed, rounded up to a whole millisecond, or
+// none when the ttl is not positive so the key is kept, as it is in memory.
+func expiry(ttl time.Duration) []interface{} {
+	if ttl <= 0 {
+		return nil
+	}
+
+	return []interface{}{"PX", milliseconds(ttl)}
+}
+
+func milliseconds(ttl time.Duration) int64 {
+	if ttl <= 0 {
+		return 0
+	}
+
+	return int64((ttl + time.Millisecond - 1) / time.Millisecond)
+}
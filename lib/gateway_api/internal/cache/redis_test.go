@@ -0,0 +1,150 @@
+package cache_test
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/cache"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newRedis returns a cache held by a redis server run for the test, and the server.
+func newRedis(t *testing.T) (cache.Cache, *miniredis.Miniredis) {
+	server := miniredis.RunT(t)
+	c, closeCache, err := cache.NewRedis("redis://" + server.Addr())
+	require.NoError(t, err)
+	t.Cleanup(func() { closeCache(zap.NewNop().Sugar()) })
+
+	return c, server
+}
+
+func Test_Redis(t *testing.T) {
+	ctx := context.Background()
+	c, server := newRedis(t)
+
+	_, found, err := c.Get(ctx, "venue")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, c.Set(ctx, "venue", []byte("hop and vine"), time.Minute))
+	require.NoError(t, c.Set(ctx, "tables", []byte("table one"), time.Minute))
+	value, found, err := c.Get(ctx, "venue")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []byte("hop and vine"), value)
+
+	require.NoError(t, c.Delete(ctx, "venue", "unknown"))
+	_, found, err = c.Get(ctx, "venue")
+	require.NoError(t, err)
+	assert.False(t, found, "deleted key should have no value")
+	_, found, err = c.Get(ctx, "tables")
+	require.NoError(t, err)
+	assert.True(t, found, "keys not deleted should keep their value")
+
+	server.FastForward(time.Minute)
+	_, found, err = c.Get(ctx, "tables")
+	require.NoError(t, err)
+	assert.False(t, found, "value should expire once the ttl has passed")
+}
+
+func Test_RedisAdd(t *testing.T) {
+	ctx := context.Background()
+	c, server := newRedis(t)
+
+	added, err := c.Add(ctx, "link", []byte("used"), time.Minute)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	added, err = c.Add(ctx, "link", []byte("used again"), time.Minute)
+	require.NoError(t, err)
+	assert.False(t, added, "key with a value should not be given another")
+	value, _, err := c.Get(ctx, "link")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("used"), value)
+
+	server.FastForward(time.Minute)
+	added, err = c.Add(ctx, "link", []byte("used again"), time.Minute)
+	require.NoError(t, err)
+	assert.True(t, added, "expired key should be given the value")
+}
+
+// Test_RedisShared checks replicas of the gateway given the same redis share its values, so a key added through one
+// cannot be added through another.
+func Test_RedisShared(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+
+	replicas := make([]cache.Cache, 3)
+	for i := range replicas {
+		c, closeCache, err := cache.NewRedis("redis://" + server.Addr())
+		require.NoError(t, err)
+		t.Cleanup(func() { closeCache(zap.NewNop().Sugar()) })
+		replicas[i] = c
+	}
+
+	var wg sync.WaitGroup
+	added := make(chan bool, len(replicas))
+	for _, c := range replicas {
+		wg.Add(1)
+		go func(c cache.Cache) {
+			defer wg.Done()
+			ok, err := c.Add(ctx, "link", []byte("used"), time.Minute)
+			assert.NoError(t, err)
+			added <- ok
+		}(c)
+	}
+	wg.Wait()
+	close(added)
+
+	claimed := 0
+	for ok := range added {
+		if ok {
+			claimed++
+		}
+	}
+	assert.Equal(t, 1, claimed, "only one replica should add the key")
+}
+
+func Test_RedisIncrement(t *testing.T) {
+	ctx := context.Background()
+	c, server := newRedis(t)
+
+	for expected := int64(1); expected <= 3; expected++ {
+		n, err := c.Increment(ctx, "requests", time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, expected, n)
+	}
+	assert.Equal(t, time.Minute, server.TTL("requests"), "count should keep the ttl it was started with")
+
+	server.FastForward(time.Minute)
+	n, err := c.Increment(ctx, "requests", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n, "count should start again once the ttl has passed")
+}
+
+func Test_RedisReadiness(t *testing.T) {
+	server := miniredis.RunT(t)
+	readiness := health.NewReadiness(time.Second)
+	_, closeCache, err := cache.NewRedis("redis://"+server.Addr(), cache.WithReadiness(readiness))
+	require.NoError(t, err)
+	defer closeCache(zap.NewNop().Sugar())
+
+	report := readiness.Check(context.Background())
+	assert.Equal(t, health.StatusOK, report.Dependencies["cache"].Status)
+
+	server.Close()
+	report = readiness.Check(context.Background())
+	assert.Equal(t, health.StatusUnavailable, report.Dependencies["cache"].Status)
+}
+
+func Test_NewRedis(t *testing.T) {
+	for _, url := range []string{"redis:6379", "http://redis:6379", "://"} {
+		_, _, err := cache.NewRedis(url)
+		assert.Error(t, err, "url %q should not be accepted", url)
+	}
+}
@@ -0,0 +1,35 @@
+package correlation
+
+import (
+	"context"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the gRPC metadata the request id is sent to services in, so their logs can be correlated with the
+// gateway's.
+const MetadataKey = "x-request-id"
+
+// UnaryClientInterceptor sends the request id in the context with each call.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoing(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor sends the request id in the context with each stream.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoing(ctx), desc, cc, method, opts...)
+	}
+}
+
+func outgoing(ctx context.Context) context.Context {
+	id := models.GetRequestIDFromContext(ctx)
+	if id == "" {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, id)
+}
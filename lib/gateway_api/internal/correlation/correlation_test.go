@@ -0,0 +1,44 @@
+package correlation_test
+
+import (
+	"context"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/correlation"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"testing"
+)
+
+func Test_UnaryClientInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected []string
+	}{
+		{
+			name:     "request id sent",
+			ctx:      models.AddRequestIDToContext(context.Background(), "4f1c2a"),
+			expected: []string{"4f1c2a"},
+		},
+		{
+			name: "nothing sent without a request id",
+			ctx:  context.Background(),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var md metadata.MD
+			err := correlation.UnaryClientInterceptor()(test.ctx, "/venue.api.VenueAPI/GetVenue", nil, nil, nil,
+				func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+					md, _ = metadata.FromOutgoingContext(ctx)
+					return nil
+				})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, md.Get(correlation.MetadataKey))
+		})
+	}
+}
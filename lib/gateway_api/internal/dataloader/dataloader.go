@@ -0,0 +1,120 @@
+package dataloader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Fetch gets the values of a batch of keys, leaving out keys that have no value.
+type Fetch func(ctx context.Context, keys []string) (map[string]interface{}, error)
+
+// Loader gathers the keys loaded within a short wait into one batch, so resolving a field of many objects makes one call
+// rather than one per object. Every key is only fetched once, so a loader should only live as long as a request.
+type Loader struct {
+	fetch    Fetch
+	wait     time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	results map[string]*result
+	batch   *batch
+}
+
+type result struct {
+	done  chan struct{}
+	value interface{}
+	found bool
+	err   error
+}
+
+type batch struct {
+	keys    []string
+	results map[string]*result
+}
+
+// New returns a loader fetching keys once the wait has passed since the first of a batch was loaded, or as soon as
+// maxBatch keys have been.
+func New(fetch Fetch, wait time.Duration, maxBatch int) *Loader {
+	return &Loader{
+		fetch:    fetch,
+		wait:     wait,
+		maxBatch: maxBatch,
+		results:  map[string]*result{},
+	}
+}
+
+// Load returns the value of the key and whether it has one, waiting for the batch it is fetched in. The batch is fetched
+// with the context of the load that started it.
+func (l *Loader) Load(ctx context.Context, key string) (interface{}, bool, error) {
+	l.mu.Lock()
+	res, ok := l.results[key]
+	if !ok {
+		res = &result{done: make(chan struct{})}
+		l.results[key] = res
+		l.add(ctx, key, res)
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-res.done:
+		return res.value, res.found, res.err
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	}
+}
+
+// add puts the key in the batch being gathered, starting one if there is none. The caller must hold the lock.
+func (l *Loader) add(ctx context.Context, key string, res *result) {
+	if l.batch == nil {
+		b := &batch{results: map[string]*result{}}
+		l.batch = b
+		time.AfterFunc(l.wait, func() {
+			l.mu.Lock()
+			if l.batch != b {
+				// the batch filled up and was fetched before the wait was over
+				l.mu.Unlock()
+				return
+			}
+			l.batch = nil
+			l.mu.Unlock()
+
+			l.run(ctx, b)
+		})
+	}
+
+	l.batch.keys = append(l.batch.keys, key)
+	l.batch.results[key] = res
+
+	if l.maxBatch > 0 && len(l.batch.keys) >= l.maxBatch {
+		b := l.batch
+		l.batch = nil
+		go l.run(ctx, b)
+	}
+}
+
+// run fetches a batch, giving every key in it the result. Keys are sorted so the same keys always make the same call.
+func (l *Loader) run(ctx context.Context, b *batch) {
+	sort.Strings(b.keys)
+
+	var values map[string]interface{}
+	err := errors.New("could not fetch batch")
+	// loads waiting on the batch would wait forever if a fetch that panics or exits its goroutine left it unfinished
+	defer func() {
+		if r := recover(); r != nil {
+			values, err = nil, fmt.Errorf("could not fetch batch : %v", r)
+		}
+
+		for _, key := range b.keys {
+			res := b.results[key]
+			res.value, res.found = values[key]
+			res.err = err
+			close(res.done)
+		}
+	}()
+
+	values, err = l.fetch(ctx, b.keys)
+}
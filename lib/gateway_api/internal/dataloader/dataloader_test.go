@@ -0,0 +1,138 @@
+package dataloader_test
+
+import (
+	"context"
+	"errors"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/dataloader"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recorder is a fetch returning the key as its value, recording the batches it is called with.
+type recorder struct {
+	mu      sync.Mutex
+	batches [][]string
+	missing map[string]bool
+	err     error
+}
+
+func (r *recorder) fetch(ctx context.Context, keys []string) (map[string]interface{}, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.batches = append(r.batches, keys)
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	values := map[string]interface{}{}
+	for _, key := range keys {
+		if !r.missing[key] {
+			values[key] = "value of " + key
+		}
+	}
+
+	return values, nil
+}
+
+func (r *recorder) calls() [][]string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.batches
+}
+
+// loadAll loads every key at once, returning the values in the order of the keys.
+func loadAll(t *testing.T, loader *dataloader.Loader, keys ...string) []interface{} {
+	values := make([]interface{}, len(keys))
+	var wg sync.WaitGroup
+	for i := range keys {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			value, _, err := loader.Load(context.Background(), keys[i])
+			assert.NoError(t, err)
+			values[i] = value
+		}(i)
+	}
+	wg.Wait()
+
+	return values
+}
+
+func Test_LoaderBatches(t *testing.T) {
+	r := &recorder{}
+	loader := dataloader.New(r.fetch, 20*time.Millisecond, 100)
+
+	values := loadAll(t, loader, "c", "a", "b", "a")
+
+	assert.Equal(t, []interface{}{"value of c", "value of a", "value of b", "value of a"}, values)
+	assert.Equal(t, [][]string{{"a", "b", "c"}}, r.calls(), "keys should be fetched once, in one sorted batch")
+
+	value, found, err := loader.Load(context.Background(), "b")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "value of b", value)
+	assert.Len(t, r.calls(), 1, "keys already loaded should not be fetched again")
+}
+
+func Test_LoaderMaxBatch(t *testing.T) {
+	r := &recorder{}
+	loader := dataloader.New(r.fetch, time.Hour, 2)
+
+	loadAll(t, loader, "a", "b")
+	loadAll(t, loader, "c", "d")
+
+	assert.Len(t, r.calls(), 2, "full batches should be fetched without waiting")
+}
+
+func Test_LoaderMissing(t *testing.T) {
+	r := &recorder{missing: map[string]bool{"b": true}}
+	loader := dataloader.New(r.fetch, time.Millisecond, 100)
+
+	value, found, err := loader.Load(context.Background(), "b")
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Nil(t, value)
+}
+
+func Test_LoaderError(t *testing.T) {
+	r := &recorder{err: errors.New("connection refused")}
+	loader := dataloader.New(r.fetch, 10*time.Millisecond, 100)
+
+	var wg sync.WaitGroup
+	for _, key := range []string{"a", "b"} {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			_, _, err := loader.Load(context.Background(), key)
+			assert.EqualError(t, err, "connection refused", "every key of a failed batch should fail")
+		}(key)
+	}
+	wg.Wait()
+}
+
+func Test_LoaderPanic(t *testing.T) {
+	loader := dataloader.New(func(ctx context.Context, keys []string) (map[string]interface{}, error) {
+		panic("nil pointer")
+	}, time.Millisecond, 100)
+
+	_, _, err := loader.Load(context.Background(), "a")
+	assert.EqualError(t, err, "could not fetch batch : nil pointer")
+}
+
+func Test_LoaderCancelled(t *testing.T) {
+	loader := dataloader.New(func(ctx context.Context, keys []string) (map[string]interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}, time.Millisecond, 100)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, _, err := loader.Load(ctx, "a")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
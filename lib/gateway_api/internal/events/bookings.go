@@ -0,0 +1,112 @@
+package events
+
+import (
+	"context"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"sync"
+	"time"
+)
+
+const defaultBuffer = 16
+
+// Bookings passes changes to bookings on to the subscriptions watching the venue and date they start on. Changes are
+// only passed to subscriptions made on the same gateway, so other sources of changes, such as the booking service, can
+// publish to it.
+type Bookings struct {
+	buffer int
+
+	mu          sync.Mutex
+	subscribers map[string]map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	date    time.Time
+	changes chan *models.BookingChange
+}
+
+// WithBuffer sets how many changes a subscription may fall behind by before it is ended.
+func WithBuffer(buffer int) func(*Bookings) {
+	return func(b *Bookings) {
+		if buffer > 0 {
+			b.buffer = buffer
+		}
+	}
+}
+
+func NewBookings(options ...func(*Bookings)) *Bookings {
+	b := &Bookings{
+		buffer:      defaultBuffer,
+		subscribers: map[string]map[*subscriber]struct{}{},
+	}
+	for i := range options {
+		options[i](b)
+	}
+
+	return b
+}
+
+// Subscribe returns the changes to bookings of the venue starting on the date, until the context is done. The channel is
+// closed when the subscription ends, including when it has fallen too far behind, so it never holds up a publisher.
+func (b *Bookings) Subscribe(ctx context.Context, venueID string, date time.Time) <-chan *models.BookingChange {
+	s := &subscriber{date: date, changes: make(chan *models.BookingChange, b.buffer)}
+
+	b.mu.Lock()
+	if b.subscribers[venueID] == nil {
+		b.subscribers[venueID] = map[*subscriber]struct{}{}
+	}
+	b.subscribers[venueID][s] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.unsubscribe(venueID, s)
+	}()
+
+	return s.changes
+}
+
+// Publish passes the change on to the subscriptions watching its booking's venue and date.
+func (b *Bookings) Publish(ctx context.Context, change models.BookingChange) {
+	if change.Booking == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for s := range b.subscribers[change.Booking.VenueID] {
+		if !sameDay(s.date, change.Booking.StartsAt) {
+			continue
+		}
+
+		select {
+		case s.changes <- &change:
+		default:
+			b.unsubscribe(change.Booking.VenueID, s)
+		}
+	}
+}
+
+// unsubscribe ends the subscription, if it has not already been. The caller must hold the lock.
+func (b *Bookings) unsubscribe(venueID string, s *subscriber) {
+	if _, ok := b.subscribers[venueID][s]; !ok {
+		return
+	}
+
+	delete(b.subscribers[venueID], s)
+	if len(b.subscribers[venueID]) == 0 {
+		delete(b.subscribers, venueID)
+	}
+	close(s.changes)
+}
+
+// sameDay reports whether the booking starts on the date, in the date's time zone.
+func sameDay(date time.Time, startsAt time.Time) bool {
+	y1, m1, d1 := date.Date()
+	y2, m2, d2 := startsAt.In(date.Location()).Date()
+
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
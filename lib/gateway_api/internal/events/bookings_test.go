@@ -0,0 +1,93 @@
+package events_test
+
+import (
+	"context"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/events"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+const (
+	hopAndVine = "2b7b9d4e-5f0c-4b6a-8b0e-1c0d9f3e8a11"
+	theCrown   = "9f4c2a1e-7d3b-4e5f-a6b7-c8d9e0f1a2b3"
+)
+
+func change(id string, venueID string, startsAt time.Time) models.BookingChange {
+	return models.BookingChange{
+		Type:    models.BookingChangeTypeCreated,
+		Booking: &models.Booking{ID: id, VenueID: venueID, StartsAt: startsAt},
+	}
+}
+
+func Test_BookingsPublish(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bookings := events.NewBookings()
+	date := time.Date(3000, 6, 20, 0, 0, 0, 0, time.UTC)
+
+	changes := bookings.Subscribe(ctx, hopAndVine, date)
+
+	bookings.Publish(ctx, change("other venue", theCrown, date.Add(12*time.Hour)))
+	bookings.Publish(ctx, change("day before", hopAndVine, date.Add(-time.Minute)))
+	bookings.Publish(ctx, change("next day", hopAndVine, date.AddDate(0, 0, 1)))
+	bookings.Publish(ctx, change("lunch", hopAndVine, date.Add(12*time.Hour)))
+	// half past eleven at night in new york is the next day in utc, but is still on the date subscribed to
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	late := events.NewBookings()
+	lateChanges := late.Subscribe(ctx, hopAndVine, time.Date(3000, 6, 20, 0, 0, 0, 0, newYork))
+	late.Publish(ctx, change("late", hopAndVine, time.Date(3000, 6, 21, 3, 30, 0, 0, time.UTC)))
+
+	select {
+	case c := <-changes:
+		assert.Equal(t, "lunch", c.Booking.ID, "only bookings of the venue starting on the date should be sent")
+	default:
+		t.Fatal("change was not sent")
+	}
+	assert.Empty(t, changes)
+
+	select {
+	case c := <-lateChanges:
+		assert.Equal(t, "late", c.Booking.ID)
+	default:
+		t.Fatal("change was not sent in the time zone of the date")
+	}
+}
+
+func Test_BookingsSubscriptionEnds(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	bookings := events.NewBookings()
+	date := time.Date(3000, 6, 20, 0, 0, 0, 0, time.UTC)
+
+	changes := bookings.Subscribe(ctx, hopAndVine, date)
+	cancel()
+
+	select {
+	case _, ok := <-changes:
+		assert.False(t, ok, "changes should be closed")
+	case <-time.After(time.Second):
+		t.Fatal("subscription did not end with its context")
+	}
+
+	bookings.Publish(context.Background(), change("lunch", hopAndVine, date.Add(12*time.Hour)))
+}
+
+func Test_BookingsSlowSubscriber(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bookings := events.NewBookings(events.WithBuffer(1))
+	date := time.Date(3000, 6, 20, 0, 0, 0, 0, time.UTC)
+
+	changes := bookings.Subscribe(ctx, hopAndVine, date)
+	bookings.Publish(ctx, change("lunch", hopAndVine, date.Add(12*time.Hour)))
+	bookings.Publish(ctx, change("dinner", hopAndVine, date.Add(19*time.Hour)))
+
+	c, ok := <-changes
+	require.True(t, ok)
+	assert.Equal(t, "lunch", c.Booking.ID)
+	_, ok = <-changes
+	assert.False(t, ok, "subscription that fell behind should be ended rather than hold up publishing")
+}
@@ -0,0 +1,185 @@
+package guest
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/graph"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/cache"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultLinkTTL      = 30 * time.Minute
+	defaultRequestLimit = 5
+	requestWindow       = time.Hour
+	minSecretSize       = 32
+)
+
+type guestBookings struct {
+	secret  []byte
+	linkURL *url.URL
+	ttl     time.Duration
+	mailer  Mailer
+	shared  cache.Cache
+	limit   int
+}
+
+// WithLinkTTL sets how long a confirmation link can be used for.
+func WithLinkTTL(ttl time.Duration) func(*guestBookings) {
+	return func(gb *guestBookings) {
+		if ttl > 0 {
+			gb.ttl = ttl
+		}
+	}
+}
+
+// WithRequestLimit sets how many links may be sent to an email, and requested from an address, each hour.
+func WithRequestLimit(limit int) func(*guestBookings) {
+	return func(gb *guestBookings) {
+		if limit > 0 {
+			gb.limit = limit
+		}
+	}
+}
+
+// NewGuestBookings holds guest bookings in links signed with the secret, so nothing is stored until the guest proves
+// they own the email by following the link. Used links and requests for links are kept in the cache, which must be shared
+// by the gateway's replicas and outlive their restarts, such as redis, for each link to be used once and the request
+// limit to hold across all of them.
+func NewGuestBookings(secret []byte, linkURL string, mailer Mailer, shared cache.Cache, options ...func(*guestBookings)) (graph.GuestBookingService, error) {
+	if len(secret) < minSecretSize {
+		return nil, fmt.Errorf("guest booking secret must be at least %d bytes", minSecretSize)
+	}
+	u, err := url.Parse(linkURL)
+	if err != nil || !u.IsAbs() {
+		return nil, fmt.Errorf("guest booking link url '%s' must be absolute", linkURL)
+	}
+
+	gb := &guestBookings{
+		secret:  secret,
+		linkURL: u,
+		ttl:     defaultLinkTTL,
+		mailer:  mailer,
+		shared:  shared,
+		limit:   defaultRequestLimit,
+	}
+	for _, option := range options {
+		option(gb)
+	}
+
+	return gb, nil
+}
+
+type claims struct {
+	jwt.Claims
+	Booking models.BookingInput `json:"booking"`
+}
+
+func (gb *guestBookings) Request(ctx context.Context, input models.BookingInput) (*models.GuestBooking, error) {
+	// links are limited by email so a guest's inbox cannot be flooded, and by address so one caller cannot email many
+	keys := []string{"email:" + strings.ToLower(input.Email)}
+	if ip := models.GetClientIPFromContext(ctx); ip != "" {
+		keys = append(keys, "ip:"+ip)
+	}
+	for _, key := range keys {
+		allowed, err := gb.allow(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, models.ErrTooManyRequests
+		}
+	}
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("could not generate link id : %w", err)
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: gb.secret}, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		return nil, fmt.Errorf("could not create signer : %w", err)
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(gb.ttl).Truncate(time.Second)
+	token, err := jwt.Signed(signer).Claims(claims{
+		Claims: jwt.Claims{
+			ID:       hex.EncodeToString(nonce),
+			Subject:  input.Email,
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(expiresAt),
+		},
+		Booking: input,
+	}).CompactSerialize()
+	if err != nil {
+		return nil, fmt.Errorf("could not sign link : %w", err)
+	}
+
+	link := *gb.linkURL
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	if err := gb.mailer.SendConfirmation(ctx, input.Email, link.String()); err != nil {
+		return nil, fmt.Errorf("could not send confirmation : %w", err)
+	}
+
+	return &models.GuestBooking{Email: input.Email, ExpiresAt: expiresAt}, nil
+}
+
+func (gb *guestBookings) Confirm(ctx context.Context, token string, book func(input models.BookingInput) (*models.Booking, error)) (*models.Booking, error) {
+	parsed, err := jwt.ParseSigned(token)
+	if err != nil || len(parsed.Headers) != 1 || parsed.Headers[0].Algorithm != string(jose.HS256) {
+		return nil, models.ErrInvalidBookingLink
+	}
+
+	c := claims{}
+	if err := parsed.Claims(gb.secret, &c); err != nil {
+		return nil, models.ErrInvalidBookingLink
+	}
+	if c.ID == "" || c.Expiry == nil || c.ValidateWithLeeway(jwt.Expected{Time: time.Now()}, 0) != nil {
+		return nil, models.ErrInvalidBookingLink
+	}
+
+	// claiming the link before booking stops two requests with the same link from both making a booking
+	key := usedKey(c.ID)
+	claimed, err := gb.shared.Add(ctx, key, []byte{1}, time.Until(c.Expiry.Time())+time.Minute)
+	if err != nil {
+		return nil, fmt.Errorf("could not claim booking link : %w", err)
+	}
+	if !claimed {
+		return nil, models.ErrBookingLinkUsed
+	}
+
+	booking, err := book(c.Booking)
+	if err != nil {
+		// the link is released so a guest can try again, for example when the booking service was unavailable. a link
+		// that cannot be released stays used, which is safer than booking twice
+		_ = gb.shared.Delete(ctx, key)
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+func usedKey(id string) string {
+	return "guest:link:" + id
+}
+
+// allow counts a request for the key, reporting whether it is within the limit of the current window.
+func (gb *guestBookings) allow(ctx context.Context, key string) (bool, error) {
+	n, err := gb.shared.Increment(ctx, "guest:requests:"+key, requestWindow)
+	if err != nil {
+		return false, fmt.Errorf("could not count requests for booking links : %w", err)
+	}
+
+	return n <= int64(gb.limit), nil
+}
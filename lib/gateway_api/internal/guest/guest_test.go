@@ -0,0 +1,265 @@
+package guest_test
+
+import (
+	"context"
+	"errors"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/graph"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/cache"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/guest"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"go.uber.org/zap"
+	"net/url"
+	"testing"
+	"time"
+)
+
+var secret = []byte("a guest booking secret of 32 bytes")
+
+func Test_GuestBookings(t *testing.T) {
+	mailer := &recorder{}
+	gb, err := guest.NewGuestBookings(secret, "https://booking.example.com/bookings/confirm", mailer, cache.NewMemory(time.Minute))
+	if err != nil {
+		t.Fatalf("could not create guest bookings : %s", err)
+	}
+
+	input := bookingInput()
+	requested, err := gb.Request(context.Background(), input)
+	if err != nil {
+		t.Fatalf("did not expect error, got '%s'", err)
+	}
+	if requested.Email != input.Email || mailer.email != input.Email {
+		t.Errorf("email = '%s', sent to = '%s', expected = '%s'", requested.Email, mailer.email, input.Email)
+	}
+	if until := time.Until(requested.ExpiresAt); until <= 29*time.Minute || until > 30*time.Minute {
+		t.Errorf("link expires in '%s', expected about 30m", until)
+	}
+
+	token := mailer.token(t)
+	booked := 0
+	book := func(got models.BookingInput) (*models.Booking, error) {
+		booked++
+		if got.VenueID != input.VenueID || got.People != input.People || !got.StartsAt.Equal(input.StartsAt) {
+			t.Errorf("booking input = '%+v', expected = '%+v'", got, input)
+		}
+		return &models.Booking{ID: "cca3c988-9e11-4b81-9a98-c960fb4a3d97", Email: got.Email}, nil
+	}
+
+	booking, err := gb.Confirm(context.Background(), token, book)
+	if err != nil {
+		t.Fatalf("did not expect error, got '%s'", err)
+	}
+	if booking.Email != input.Email {
+		t.Errorf("email = '%s', expected = '%s'", booking.Email, input.Email)
+	}
+
+	if _, err := gb.Confirm(context.Background(), token, book); !errors.Is(err, models.ErrBookingLinkUsed) {
+		t.Errorf("error = '%v', expected = '%v'", err, models.ErrBookingLinkUsed)
+	}
+	if booked != 1 {
+		t.Errorf("booked = '%d', expected the link to book once", booked)
+	}
+}
+
+func Test_GuestBookingsFailedBookingReleasesLink(t *testing.T) {
+	mailer := &recorder{}
+	gb, err := guest.NewGuestBookings(secret, "https://booking.example.com/bookings/confirm", mailer, cache.NewMemory(time.Minute))
+	if err != nil {
+		t.Fatalf("could not create guest bookings : %s", err)
+	}
+	if _, err := gb.Request(context.Background(), bookingInput()); err != nil {
+		t.Fatalf("did not expect error, got '%s'", err)
+	}
+
+	unavailable := errors.New("booking service unavailable")
+	if _, err := gb.Confirm(context.Background(), mailer.token(t), func(models.BookingInput) (*models.Booking, error) {
+		return nil, unavailable
+	}); !errors.Is(err, unavailable) {
+		t.Fatalf("error = '%v', expected = '%v'", err, unavailable)
+	}
+
+	if _, err := gb.Confirm(context.Background(), mailer.token(t), func(models.BookingInput) (*models.Booking, error) {
+		return &models.Booking{}, nil
+	}); err != nil {
+		t.Errorf("did not expect error, got '%s'", err)
+	}
+}
+
+// Test_GuestBookingsReplayedOnAnotherReplica runs two replicas of the gateway, each with its own connections to one
+// redis, as they are deployed.
+func Test_GuestBookingsReplayedOnAnotherReplica(t *testing.T) {
+	server := miniredis.RunT(t)
+	mailer := &recorder{}
+	first, err := guest.NewGuestBookings(secret, "https://booking.example.com/bookings/confirm", mailer, newRedis(t, server))
+	if err != nil {
+		t.Fatalf("could not create guest bookings : %s", err)
+	}
+	second, err := guest.NewGuestBookings(secret, "https://booking.example.com/bookings/confirm", &recorder{}, newRedis(t, server))
+	if err != nil {
+		t.Fatalf("could not create guest bookings : %s", err)
+	}
+	if _, err := first.Request(context.Background(), bookingInput()); err != nil {
+		t.Fatalf("did not expect error, got '%s'", err)
+	}
+
+	booked := 0
+	book := func(got models.BookingInput) (*models.Booking, error) {
+		booked++
+		return &models.Booking{Email: got.Email}, nil
+	}
+
+	if _, err := first.Confirm(context.Background(), mailer.token(t), book); err != nil {
+		t.Fatalf("did not expect error, got '%s'", err)
+	}
+	if _, err := second.Confirm(context.Background(), mailer.token(t), book); !errors.Is(err, models.ErrBookingLinkUsed) {
+		t.Errorf("error = '%v', expected = '%v'", err, models.ErrBookingLinkUsed)
+	}
+	if booked != 1 {
+		t.Errorf("booked = '%d', expected the link to book once across replicas", booked)
+	}
+}
+
+func Test_GuestBookingsRequestLimit(t *testing.T) {
+	gb, err := guest.NewGuestBookings(secret, "https://booking.example.com/bookings/confirm", &recorder{}, cache.NewMemory(time.Minute),
+		guest.WithRequestLimit(2))
+	if err != nil {
+		t.Fatalf("could not create guest bookings : %s", err)
+	}
+
+	request := func(ip string, email string) error {
+		input := bookingInput()
+		input.Email = email
+		_, err := gb.Request(models.AddClientIPToContext(context.Background(), ip), input)
+		return err
+	}
+
+	tests := []struct {
+		name  string
+		ip    string
+		email string
+		err   error
+	}{
+		{name: "first request", ip: "192.0.2.1", email: "guest@test.com"},
+		{name: "second request", ip: "192.0.2.1", email: "guest@test.com"},
+		{name: "email over the limit", ip: "192.0.2.2", email: "GUEST@test.com", err: models.ErrTooManyRequests},
+		{name: "address over the limit", ip: "192.0.2.1", email: "another@test.com", err: models.ErrTooManyRequests},
+		{name: "another guest", ip: "192.0.2.3", email: "another@test.com"},
+	}
+	for _, test := range tests {
+		if err := request(test.ip, test.email); !errors.Is(err, test.err) {
+			t.Errorf("%s: error = '%v', expected = '%v'", test.name, err, test.err)
+		}
+	}
+}
+
+func Test_GuestBookingsRequestLimitAcrossReplicas(t *testing.T) {
+	server := miniredis.RunT(t)
+	replicas := make([]graph.GuestBookingService, 2)
+	for i := range replicas {
+		gb, err := guest.NewGuestBookings(secret, "https://booking.example.com/bookings/confirm", &recorder{}, newRedis(t, server),
+			guest.WithRequestLimit(1))
+		if err != nil {
+			t.Fatalf("could not create guest bookings : %s", err)
+		}
+		replicas[i] = gb
+	}
+
+	if _, err := replicas[0].Request(context.Background(), bookingInput()); err != nil {
+		t.Fatalf("did not expect error, got '%s'", err)
+	}
+	if _, err := replicas[1].Request(context.Background(), bookingInput()); !errors.Is(err, models.ErrTooManyRequests) {
+		t.Errorf("error = '%v', expected = '%v'", err, models.ErrTooManyRequests)
+	}
+}
+
+func Test_GuestBookingsInvalidLink(t *testing.T) {
+	mailer := &recorder{}
+	gb, err := guest.NewGuestBookings(secret, "https://booking.example.com/bookings/confirm", mailer, cache.NewMemory(time.Minute),
+		guest.WithLinkTTL(time.Second))
+	if err != nil {
+		t.Fatalf("could not create guest bookings : %s", err)
+	}
+	if _, err := gb.Request(context.Background(), bookingInput()); err != nil {
+		t.Fatalf("did not expect error, got '%s'", err)
+	}
+	token := mailer.token(t)
+
+	other, err := guest.NewGuestBookings([]byte("another guest booking secret of 32 bytes"), "https://booking.example.com/", &recorder{}, cache.NewMemory(time.Minute))
+	if err != nil {
+		t.Fatalf("could not create guest bookings : %s", err)
+	}
+
+	book := func(models.BookingInput) (*models.Booking, error) {
+		t.Errorf("did not expect a booking to be made")
+		return nil, nil
+	}
+
+	tests := []struct {
+		name  string
+		gb    graph.GuestBookingService
+		token string
+	}{
+		{name: "signed with another secret", gb: other, token: token},
+		{name: "tampered", gb: gb, token: token[:len(token)-4] + "AAAA"},
+		{name: "malformed", gb: gb, token: "not.a.token"},
+	}
+	for _, test := range tests {
+		if _, err := test.gb.Confirm(context.Background(), test.token, book); !errors.Is(err, models.ErrInvalidBookingLink) {
+			t.Errorf("%s: error = '%v', expected = '%v'", test.name, err, models.ErrInvalidBookingLink)
+		}
+	}
+
+	time.Sleep(1100 * time.Millisecond)
+	if _, err := gb.Confirm(context.Background(), token, book); !errors.Is(err, models.ErrInvalidBookingLink) {
+		t.Errorf("expired: error = '%v', expected = '%v'", err, models.ErrInvalidBookingLink)
+	}
+}
+
+func Test_NewGuestBookings(t *testing.T) {
+	if _, err := guest.NewGuestBookings([]byte("short"), "https://booking.example.com/", &recorder{}, cache.NewMemory(time.Minute)); err == nil {
+		t.Errorf("expected error for a short secret")
+	}
+	if _, err := guest.NewGuestBookings(secret, "/bookings/confirm", &recorder{}, cache.NewMemory(time.Minute)); err == nil {
+		t.Errorf("expected error for a relative link url")
+	}
+}
+
+// newRedis returns a cache held by the redis server, closed when the test ends.
+func newRedis(t *testing.T, server *miniredis.Miniredis) cache.Cache {
+	c, closeCache, err := cache.NewRedis("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("could not create cache : %s", err)
+	}
+	t.Cleanup(func() { closeCache(zap.NewNop().Sugar()) })
+
+	return c
+}
+
+type recorder struct {
+	email string
+	link  string
+}
+
+func (m *recorder) SendConfirmation(ctx context.Context, email string, link string) error {
+	m.email, m.link = email, link
+	return nil
+}
+
+func (m *recorder) token(t *testing.T) string {
+	u, err := url.Parse(m.link)
+	if err != nil {
+		t.Fatalf("could not parse link : %s", err)
+	}
+
+	return u.Query().Get("token")
+}
+
+func bookingInput() models.BookingInput {
+	return models.BookingInput{
+		VenueID:  "8a18e89b-339b-4e51-ab53-825aae59a070",
+		Email:    "guest@test.com",
+		People:   2,
+		StartsAt: time.Date(3000, 6, 20, 12, 0, 0, 0, time.UTC),
+		Duration: 60,
+	}
+}
@@ -0,0 +1,135 @@
+package guest
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"go.uber.org/zap"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Mailer sends a guest the link that confirms their booking.
+type Mailer interface {
+	SendConfirmation(ctx context.Context, email string, link string) error
+}
+
+type logMailer struct {
+	log *zap.SugaredLogger
+}
+
+// NewLogMailer logs confirmation links instead of emailing them, for local development without a mail server. The links
+// are logged whole so they can be followed, which lets anyone reading the logs book as the guest, so it must not be used
+// in production.
+func NewLogMailer(log *zap.SugaredLogger) Mailer {
+	return &logMailer{log: log}
+}
+
+func (m *logMailer) SendConfirmation(ctx context.Context, email string, link string) error {
+	m.log.Infow("guest booking confirmation", "email", email, "link", link)
+	return nil
+}
+
+type smtpMailer struct {
+	addr string
+	host string
+	from *mail.Address
+	auth smtp.Auth
+}
+
+// NewSMTPMailer emails confirmation links from the address through the mail server at addr, given as host:port. The
+// connection is upgraded with STARTTLS when the server offers it, and signed in to when a username is given.
+func NewSMTPMailer(addr string, from string, username string, password string) (Mailer, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("mail server address '%s' must be a host and port : %w", addr, err)
+	}
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("mail sender '%s' must be an email address : %w", from, err)
+	}
+
+	m := &smtpMailer{addr: addr, host: host, from: sender}
+	if username != "" {
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return m, nil
+}
+
+func (m *smtpMailer) SendConfirmation(ctx context.Context, email string, link string) error {
+	to, err := mail.ParseAddress(email)
+	if err != nil {
+		return fmt.Errorf("could not parse email : %w", err)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return fmt.Errorf("could not connect to mail server : %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			_ = conn.Close()
+			return fmt.Errorf("could not set mail server deadline : %w", err)
+		}
+	}
+
+	c, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("could not greet mail server : %w", err)
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: m.host}); err != nil {
+			return fmt.Errorf("could not start tls with mail server : %w", err)
+		}
+	}
+	if m.auth != nil {
+		if err := c.Auth(m.auth); err != nil {
+			return fmt.Errorf("could not sign in to mail server : %w", err)
+		}
+	}
+	if err := c.Mail(m.from.Address); err != nil {
+		return fmt.Errorf("could not set mail sender : %w", err)
+	}
+	if err := c.Rcpt(to.Address); err != nil {
+		return fmt.Errorf("could not set mail recipient : %w", err)
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("could not start mail : %w", err)
+	}
+	if _, err := w.Write(m.message(to, link)); err != nil {
+		return fmt.Errorf("could not write mail : %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("could not send mail : %w", err)
+	}
+
+	return c.Quit()
+}
+
+func (m *smtpMailer) message(to *mail.Address, link string) []byte {
+	lines := []string{
+		"From: " + m.from.String(),
+		"To: " + to.String(),
+		"Subject: Confirm your booking",
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		"Follow the link below to confirm your booking. It can only be used once.",
+		"",
+		link,
+		"",
+	}
+
+	return []byte(strings.Join(lines, "\r\n"))
+}
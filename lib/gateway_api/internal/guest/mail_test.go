@@ -0,0 +1,143 @@
+package guest_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/cache"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/guest"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"net"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_LogMailer(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	gb, err := guest.NewGuestBookings(secret, "https://booking.example.com/bookings/confirm", guest.NewLogMailer(zap.New(core).Sugar()),
+		cache.NewMemory(time.Minute))
+	if err != nil {
+		t.Fatalf("could not create guest bookings : %s", err)
+	}
+
+	if _, err := gb.Request(context.Background(), bookingInput()); err != nil {
+		t.Fatalf("did not expect error, got '%s'", err)
+	}
+	if logs.Len() != 1 {
+		t.Fatalf("logs = '%d', expected = '1'", logs.Len())
+	}
+	link, ok := logs.All()[0].ContextMap()["link"].(string)
+	if !ok {
+		t.Fatalf("expected the link to be logged, got '%v'", logs.All()[0].ContextMap())
+	}
+
+	// the logged link is followed to confirm the booking, as a developer would without a mail server
+	u, err := url.Parse(link)
+	if err != nil {
+		t.Fatalf("could not parse link : %s", err)
+	}
+	booking, err := gb.Confirm(context.Background(), u.Query().Get("token"), func(input models.BookingInput) (*models.Booking, error) {
+		return &models.Booking{Email: input.Email}, nil
+	})
+	if err != nil {
+		t.Fatalf("did not expect error, got '%s'", err)
+	}
+	if booking.Email != bookingInput().Email {
+		t.Errorf("email = '%s', expected = '%s'", booking.Email, bookingInput().Email)
+	}
+}
+
+func Test_SMTPMailer(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("could not listen : %s", err)
+	}
+	defer l.Close()
+
+	received := make(chan string, 1)
+	go serveSMTP(l, received)
+
+	mailer, err := guest.NewSMTPMailer(l.Addr().String(), "Bookings <bookings@example.com>", "", "")
+	if err != nil {
+		t.Fatalf("could not create mailer : %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	link := "https://booking.example.com/bookings/confirm?token=a-token"
+	if err := mailer.SendConfirmation(ctx, "guest@test.com", link); err != nil {
+		t.Fatalf("did not expect error, got '%s'", err)
+	}
+
+	select {
+	case message := <-received:
+		for _, expected := range []string{"MAIL FROM:<bookings@example.com>", "RCPT TO:<guest@test.com>", "To: <guest@test.com>", link} {
+			if !strings.Contains(message, expected) {
+				t.Errorf("message = '%s', expected it to contain '%s'", message, expected)
+			}
+		}
+	case <-ctx.Done():
+		t.Fatal("mail server did not receive a message")
+	}
+}
+
+func Test_NewSMTPMailer(t *testing.T) {
+	tests := []struct {
+		name string
+		addr string
+		from string
+	}{
+		{name: "address without a port", addr: "mail.example.com", from: "bookings@example.com"},
+		{name: "sender is not an email address", addr: "mail.example.com:587", from: "bookings"},
+	}
+	for _, test := range tests {
+		if _, err := guest.NewSMTPMailer(test.addr, test.from, "", ""); err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+	}
+}
+
+// serveSMTP accepts one connection, answering as a mail server without extensions, and sends what it was told.
+func serveSMTP(l net.Listener, received chan<- string) {
+	conn, err := l.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	var transcript strings.Builder
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = fmt.Fprintf(conn, "%s\r\n", line) }
+
+	reply("220 localhost ready")
+	data := false
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		transcript.WriteString(line)
+		switch {
+		case data:
+			if line == ".\r\n" {
+				data = false
+				reply("250 queued")
+			}
+		case strings.HasPrefix(line, "EHLO"), strings.HasPrefix(line, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(line, "DATA"):
+			data = true
+			reply("354 go ahead")
+		case strings.HasPrefix(line, "QUIT"):
+			reply("221 bye")
+			received <- transcript.String()
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	StatusOK          = "ok"
+	StatusUnavailable = "unavailable"
+)
+
+// Check reports whether the gateway can reach a dependency, returning why not if it cannot.
+type Check func(ctx context.Context) error
+
+// Readiness reports whether the gateway can serve requests, by checking each of the dependencies it needs to.
+type Readiness struct {
+	timeout time.Duration
+
+	mu     sync.Mutex
+	checks map[string]Check
+
+	shuttingDown int32
+}
+
+// Report is the readiness of the gateway and each of its dependencies.
+type Report struct {
+	Status       string                `json:"status"`
+	Dependencies map[string]Dependency `json:"dependencies"`
+}
+
+type Dependency struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+	// Latency is how long the dependency took to check.
+	Latency string `json:"latency"`
+}
+
+// NewReadiness gives each check the timeout to report the dependency can be reached.
+func NewReadiness(timeout time.Duration) *Readiness {
+	return &Readiness{timeout: timeout, checks: map[string]Check{}}
+}
+
+// Add checks the named dependency when readiness is reported.
+func (r *Readiness) Add(name string, check Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.checks[name] = check
+}
+
+// Shutdown reports the gateway as unavailable from now on, so it is taken out of service while it drains.
+func (r *Readiness) Shutdown() {
+	atomic.StoreInt32(&r.shuttingDown, 1)
+}
+
+// Check runs every check at once, reporting the gateway ready if all of them pass.
+func (r *Readiness) Check(ctx context.Context) Report {
+	r.mu.Lock()
+	checks := make(map[string]Check, len(r.checks))
+	for name, check := range r.checks {
+		checks[name] = check
+	}
+	r.mu.Unlock()
+
+	report := Report{Status: StatusOK, Dependencies: make(map[string]Dependency, len(checks))}
+	if atomic.LoadInt32(&r.shuttingDown) == 1 {
+		report.Status = StatusUnavailable
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check Check) {
+			defer wg.Done()
+
+			dependency := r.check(ctx, check)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Dependencies[name] = dependency
+			if dependency.Status != StatusOK {
+				report.Status = StatusUnavailable
+			}
+		}(name, check)
+	}
+	wg.Wait()
+
+	return report
+}
+
+func (r *Readiness) check(ctx context.Context, check Check) Dependency {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check(ctx)
+	dependency := Dependency{Status: StatusOK, Latency: time.Since(start).String()}
+	if err != nil {
+		dependency.Status = StatusUnavailable
+		dependency.Error = err.Error()
+	}
+
+	return dependency
+}
+
+// ServeHTTP responds with the readiness report, with a service unavailable status code unless the gateway is ready.
+func (r *Readiness) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	report := r.Check(req.Context())
+
+	code := http.StatusOK
+	if report.Status != StatusOK {
+		code = http.StatusServiceUnavailable
+	}
+
+	respond(w, code, report)
+}
+
+// Live responds while the process is running, whatever the state of its dependencies.
+func Live(w http.ResponseWriter, req *http.Request) {
+	respond(w, http.StatusOK, map[string]string{"status": StatusOK})
+}
+
+func respond(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// Conn checks a gRPC connection has a replica ready to take calls, waiting for it to connect. Where the connection
+// checks the health of replicas, only those serving are ready.
+func Conn(conn *grpc.ClientConn) Check {
+	return func(ctx context.Context) error {
+		for {
+			state := conn.GetState()
+			switch state {
+			case connectivity.Ready:
+				return nil
+			case connectivity.TransientFailure, connectivity.Shutdown:
+				return fmt.Errorf("connection is %s", state)
+			}
+
+			if !conn.WaitForStateChange(ctx, state) {
+				return fmt.Errorf("connection is %s : %w", state, ctx.Err())
+			}
+		}
+	}
+}
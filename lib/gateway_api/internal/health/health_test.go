@@ -0,0 +1,125 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func Test_Live(t *testing.T) {
+	rec := httptest.NewRecorder()
+	health.Live(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"status": "ok"}`, rec.Body.String())
+}
+
+func Test_Readiness(t *testing.T) {
+	pass := func(ctx context.Context) error { return nil }
+	fail := func(ctx context.Context) error { return errors.New("connection refused") }
+	hang := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	tests := []struct {
+		name         string
+		checks       map[string]health.Check
+		code         int
+		dependencies map[string]health.Dependency
+	}{
+		{
+			name:   "every dependency reachable",
+			checks: map[string]health.Check{"venue": pass, "booking": pass},
+			code:   http.StatusOK,
+			dependencies: map[string]health.Dependency{
+				"venue":   {Status: health.StatusOK},
+				"booking": {Status: health.StatusOK},
+			},
+		},
+		{
+			name:   "dependency unreachable",
+			checks: map[string]health.Check{"venue": pass, "booking": fail},
+			code:   http.StatusServiceUnavailable,
+			dependencies: map[string]health.Dependency{
+				"venue":   {Status: health.StatusOK},
+				"booking": {Status: health.StatusUnavailable, Error: "connection refused"},
+			},
+		},
+		{
+			name:   "dependency timed out",
+			checks: map[string]health.Check{"identityProvider": hang},
+			code:   http.StatusServiceUnavailable,
+			dependencies: map[string]health.Dependency{
+				"identityProvider": {Status: health.StatusUnavailable, Error: "context deadline exceeded"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			readiness := health.NewReadiness(10 * time.Millisecond)
+			for name, check := range test.checks {
+				readiness.Add(name, check)
+			}
+
+			rec := httptest.NewRecorder()
+			readiness.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+			assert.Equal(t, test.code, rec.Code)
+
+			report := health.Report{}
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&report))
+			for name := range report.Dependencies {
+				dependency := report.Dependencies[name]
+				assert.NotEmpty(t, dependency.Latency)
+				dependency.Latency = ""
+				report.Dependencies[name] = dependency
+			}
+			assert.Equal(t, test.dependencies, report.Dependencies)
+		})
+	}
+}
+
+func Test_ReadinessShutdown(t *testing.T) {
+	readiness := health.NewReadiness(time.Second)
+	readiness.Add("venue", func(ctx context.Context) error { return nil })
+	assert.Equal(t, health.StatusOK, readiness.Check(context.Background()).Status)
+
+	readiness.Shutdown()
+	assert.Equal(t, health.StatusUnavailable, readiness.Check(context.Background()).Status,
+		"gateway should not be ready once it is shutting down")
+}
+
+func Test_Conn(t *testing.T) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	defer s.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	assert.NoError(t, health.Conn(conn)(ctx))
+
+	s.Stop()
+	unreachable, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer unreachable.Close()
+	assert.Error(t, health.Conn(unreachable)(ctx))
+}
@@ -0,0 +1,101 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/oidc"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// standIn is a local OpenID Connect provider serving a discovery document and key set, with further endpoints added by
+// tests on its mux.
+type standIn struct {
+	*httptest.Server
+	mux         *http.ServeMux
+	issuer      string
+	jwksFetches int32
+
+	mu   sync.Mutex
+	keys jose.JSONWebKeySet
+}
+
+func newStandIn(t *testing.T) *standIn {
+	s := &standIn{mux: http.NewServeMux()}
+	s.Server = httptest.NewServer(s.mux)
+	t.Cleanup(s.Close)
+	s.issuer = s.URL + "/"
+
+	s.mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":            s.issuer,
+			"jwks_uri":          s.URL + "/.well-known/jwks.json",
+			"userinfo_endpoint": s.URL + "/userinfo",
+			"token_endpoint":    s.URL + "/oauth/token",
+		})
+	})
+	s.mux.HandleFunc("/.well-known/jwks.json", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&s.jwksFetches, 1)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(s.keys)
+	})
+
+	return s
+}
+
+// rotate replaces the published keys with a new key, returning it.
+func (s *standIn) rotate(t *testing.T, kid string) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("could not generate key : %s", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: &key.PublicKey, KeyID: kid, Algorithm: string(jose.RS256), Use: "sig"},
+	}}
+
+	return key
+}
+
+func (s *standIn) provider(t *testing.T) *oidc.Provider {
+	provider, err := oidc.Discover(context.Background(), s.URL)
+	if err != nil {
+		t.Fatalf("could not discover provider : %s", err)
+	}
+
+	return provider
+}
+
+func mint(t *testing.T, key interface{}, alg jose.SignatureAlgorithm, kid string, claims jwt.Claims, custom map[string]interface{}) string {
+	opts := (&jose.SignerOptions{}).WithType("JWT")
+	if kid != "" {
+		opts = opts.WithHeader("kid", kid)
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: key}, opts)
+	if err != nil {
+		t.Fatalf("could not create signer : %s", err)
+	}
+
+	builder := jwt.Signed(signer).Claims(claims)
+	if custom != nil {
+		builder = builder.Claims(custom)
+	}
+
+	raw, err := builder.CompactSerialize()
+	if err != nil {
+		t.Fatalf("could not sign token : %s", err)
+	}
+
+	return raw
+}
@@ -0,0 +1,80 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Provider holds the endpoints an OpenID Connect provider publishes in its discovery document.
+type Provider struct {
+	Issuer           string `json:"issuer"`
+	JWKSURI          string `json:"jwks_uri"`
+	UserInfoEndpoint string `json:"userinfo_endpoint"`
+	TokenEndpoint    string `json:"token_endpoint"`
+}
+
+// Discover fetches the discovery document of the issuer. The document must name the same issuer, ignoring a trailing
+// slash, so tokens are only trusted from the provider that was configured.
+func Discover(ctx context.Context, issuer string) (*Provider, error) {
+	url := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not construct discovery request : %w", err)
+	}
+
+	resp, err := newHTTPClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch discovery document : %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status code '%v' received fetching discovery document", resp.StatusCode)
+	}
+
+	provider := &Provider{}
+	if err := json.NewDecoder(resp.Body).Decode(provider); err != nil {
+		return nil, fmt.Errorf("could not decode discovery document : %w", err)
+	}
+
+	if strings.TrimSuffix(provider.Issuer, "/") != strings.TrimSuffix(issuer, "/") {
+		return nil, fmt.Errorf("discovery document issuer '%s' does not match '%s'", provider.Issuer, issuer)
+	}
+	if provider.JWKSURI == "" {
+		return nil, fmt.Errorf("discovery document has no jwks_uri")
+	}
+
+	return provider, nil
+}
+
+// Ready checks the provider's key set can be fetched, without which tokens cannot be verified.
+func (p *Provider) Ready(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.JWKSURI, nil)
+	if err != nil {
+		return fmt.Errorf("could not construct key set request : %w", err)
+	}
+
+	resp, err := newHTTPClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("could not fetch key set : %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status code '%v' received fetching key set", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func newHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: otelhttp.NewTransport(http.DefaultTransport),
+	}
+}
@@ -0,0 +1,66 @@
+package oidc_test
+
+import (
+	"context"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/oidc"
+	"testing"
+)
+
+func Test_Discover(t *testing.T) {
+	s := newStandIn(t)
+
+	for _, issuer := range []string{s.URL, s.URL + "/"} {
+		provider, err := oidc.Discover(context.Background(), issuer)
+		if err != nil {
+			t.Fatalf("did not expect error, got '%s'", err)
+		}
+		expected := oidc.Provider{
+			Issuer:           s.URL + "/",
+			JWKSURI:          s.URL + "/.well-known/jwks.json",
+			UserInfoEndpoint: s.URL + "/userinfo",
+			TokenEndpoint:    s.URL + "/oauth/token",
+		}
+		if *provider != expected {
+			t.Errorf("provider = '%+v', expected = '%+v'", *provider, expected)
+		}
+	}
+}
+
+func Test_DiscoverIssuerMismatch(t *testing.T) {
+	s := newStandIn(t)
+	s.issuer = "https://evil.example.com/"
+
+	if _, err := oidc.Discover(context.Background(), s.URL); err == nil {
+		t.Errorf("expected error for a document naming another issuer")
+	}
+}
+
+func Test_DiscoverUnavailable(t *testing.T) {
+	s := newStandIn(t)
+
+	if _, err := oidc.Discover(context.Background(), s.URL+"/missing"); err == nil {
+		t.Errorf("expected error")
+	}
+
+	s.Close()
+	if _, err := oidc.Discover(context.Background(), s.URL); err == nil {
+		t.Errorf("expected error")
+	}
+}
+
+func Test_ProviderReady(t *testing.T) {
+	s := newStandIn(t)
+
+	provider, err := oidc.Discover(context.Background(), s.URL)
+	if err != nil {
+		t.Fatalf("did not expect error, got '%s'", err)
+	}
+	if err := provider.Ready(context.Background()); err != nil {
+		t.Errorf("did not expect error, got '%s'", err)
+	}
+
+	s.Close()
+	if err := provider.Ready(context.Background()); err == nil {
+		t.Errorf("expected error once the provider cannot be reached")
+	}
+}
@@ -0,0 +1,202 @@
+package oidc
+
+import (
+	"encoding/json"
+	"fmt"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"golang.org/x/sync/singleflight"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// refreshAhead is how long before expiry a token is replaced, so calls in flight never carry an expired token
+	refreshAhead     = time.Minute
+	maxTokenAttempts = 3
+	tokenRetryDelay  = 200 * time.Millisecond
+)
+
+// TokenClient requests machine tokens from the provider's token endpoint with the client credentials grant.
+type TokenClient struct {
+	tokenURL string
+	clientID string
+	secret   string
+	log      *zap.SugaredLogger
+	client   *http.Client
+}
+
+func (p *Provider) TokenClient(log *zap.SugaredLogger, clientID, secret string) (*TokenClient, error) {
+	if p.TokenEndpoint == "" {
+		return nil, fmt.Errorf("provider has no token endpoint")
+	}
+	if clientID == "" {
+		return nil, fmt.Errorf("client id missing")
+	}
+	if secret == "" {
+		return nil, fmt.Errorf("client secret missing")
+	}
+
+	return &TokenClient{
+		tokenURL: p.TokenEndpoint,
+		clientID: clientID,
+		secret:   secret,
+		log:      log,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// TokenSource returns a source of machine tokens for the audience, granted the given scopes. The audience is sent as the
+// non standard audience parameter Auth0 and several other providers use to pick the API a token is for. Tokens are cached and
+// replaced shortly before they expire, and the source is safe for concurrent use. Callers are handed the current token
+// while it is replaced, and only wait on the provider when there is no token left to use.
+func (tc *TokenClient) TokenSource(audience string, scopes ...string) oauth2.TokenSource {
+	return &tokenSource{client: tc, audience: audience, scopes: scopes}
+}
+
+type tokenSource struct {
+	client   *TokenClient
+	audience string
+	scopes   []string
+	// refreshes coalesces concurrent token requests into one, so the provider is asked once however many callers need it
+	refreshes singleflight.Group
+
+	mu        sync.Mutex
+	token     *oauth2.Token
+	refreshAt time.Time
+}
+
+func (ts *tokenSource) Token() (*oauth2.Token, error) {
+	ts.mu.Lock()
+	token, refreshAt := ts.token, ts.refreshAt
+	ts.mu.Unlock()
+
+	if token != nil && (token.Expiry.IsZero() || time.Now().Before(refreshAt)) {
+		return token, nil
+	}
+
+	// a token due to be replaced is still usable until it expires, so it is refreshed without making the caller wait
+	if token != nil && time.Now().Before(token.Expiry) {
+		ts.refreshes.DoChan("token", ts.refresh)
+		return token, nil
+	}
+
+	v, err, _ := ts.refreshes.Do("token", ts.refresh)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*oauth2.Token), nil
+}
+
+// refresh requests a new token and caches it. The lock is only held to swap the token, never across the request.
+func (ts *tokenSource) refresh() (interface{}, error) {
+	token, err := ts.client.requestToken(ts.audience, ts.scopes)
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if err != nil {
+		// the current token is still usable until it expires, so a failed early refresh is retried on the next call
+		if ts.token != nil && time.Now().Before(ts.token.Expiry) {
+			ts.client.log.Errorw("could not refresh token", "audience", ts.audience, "error", err)
+			return ts.token, nil
+		}
+		return nil, err
+	}
+	ts.token = token
+
+	// short lived tokens are refreshed half way through their lifetime instead
+	ahead := refreshAhead
+	if lifetime := time.Until(token.Expiry); ahead > lifetime/2 {
+		ahead = lifetime / 2
+	}
+	ts.refreshAt = token.Expiry.Add(-ahead)
+
+	return token, nil
+}
+
+// requestToken requests a token, retrying failures that are likely to be transient.
+func (tc *TokenClient) requestToken(audience string, scopes []string) (*oauth2.Token, error) {
+	var err error
+	for attempt := 0; attempt < maxTokenAttempts; attempt++ {
+		if attempt > 0 {
+			time.Sleep(tokenRetryDelay << (attempt - 1))
+		}
+
+		var token *oauth2.Token
+		var retry bool
+		token, retry, err = tc.getToken(audience, scopes)
+		if err == nil {
+			return token, nil
+		}
+		if !retry {
+			break
+		}
+	}
+
+	return nil, err
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// getToken makes a single token request, reporting whether a failure is worth retrying.
+func (tc *TokenClient) getToken(audience string, scopes []string) (*oauth2.Token, bool, error) {
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {tc.clientID},
+		"client_secret": {tc.secret},
+		"audience":      {audience},
+	}
+	if len(scopes) > 0 {
+		form.Set("scope", strings.Join(scopes, " "))
+	}
+
+	req, err := http.NewRequest("POST", tc.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, false, fmt.Errorf("could not create request : %w", err)
+	}
+
+	req.Header.Add("content-type", "application/x-www-form-urlencoded")
+	requested := time.Now()
+	res, err := tc.client.Do(req)
+	if err != nil {
+		return nil, true, fmt.Errorf("could not do request : %w", err)
+	}
+	defer func(log *zap.SugaredLogger) {
+		if err := res.Body.Close(); err != nil {
+			log.Errorf("could not close token request body : %s", err)
+		}
+	}(tc.log)
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, true, fmt.Errorf("could not read request : %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		tc.log.Errorw("unexpected status code", "status code", res.StatusCode, "body", string(body))
+		retry := res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError
+		return nil, retry, fmt.Errorf("unexpected status code '%v'", res.StatusCode)
+	}
+
+	resp := &tokenResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, false, fmt.Errorf("could not unmarshall : %w", err)
+	}
+
+	token := &oauth2.Token{AccessToken: resp.AccessToken, TokenType: resp.TokenType}
+	if resp.ExpiresIn > 0 {
+		token.Expiry = requested.Add(time.Duration(resp.ExpiresIn) * time.Second)
+	}
+
+	return token, false, nil
+}
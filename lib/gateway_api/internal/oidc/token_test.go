@@ -0,0 +1,203 @@
+package oidc_test
+
+import (
+	"fmt"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/oidc"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_TokenClient_TokenSource(t *testing.T) {
+	var requests int32
+	authServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/oauth/token" {
+			t.Fatalf("path = '%s', expected = '/oauth/token'", r.URL.Path)
+		}
+
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("could not parse token request : %s", err)
+		}
+		expected := map[string]string{
+			"client_id":     "client-id",
+			"client_secret": "client-secret",
+			"audience":      "http://venue",
+			"scope":         "venue:read venue:write",
+			"grant_type":    "client_credentials",
+		}
+		for k, v := range expected {
+			if got := r.PostForm.Get(k); got != v {
+				t.Errorf("%s = '%s', expected = '%s'", k, got, v)
+			}
+		}
+
+		n := atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"access_token":"machine-token-%d","token_type":"Bearer","expires_in":86400}`, n)))
+	}))
+	defer authServer.Close()
+
+	ts := tokenClient(t, authServer.URL).TokenSource("http://venue", "venue:read", "venue:write")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := ts.Token()
+			if err != nil {
+				t.Errorf("did not expect error, got '%s'", err)
+				return
+			}
+			if got.AccessToken != "machine-token-1" {
+				t.Errorf("access token = '%s', expected = 'machine-token-1'", got.AccessToken)
+			}
+			if until := time.Until(got.Expiry); until < 23*time.Hour || until > 24*time.Hour {
+				t.Errorf("token expires in '%s', expected about 24h", until)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if requests != 1 {
+		t.Errorf("requests = '%d', expected the token to be cached", requests)
+	}
+}
+
+func Test_TokenClient_TokenSourceRefreshesBeforeExpiry(t *testing.T) {
+	var requests int32
+	authServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"access_token":"machine-token-%d","token_type":"Bearer","expires_in":1}`, n)))
+	}))
+	defer authServer.Close()
+
+	ts := tokenClient(t, authServer.URL).TokenSource("http://venue")
+
+	first, err := ts.Token()
+	if err != nil {
+		t.Fatalf("did not expect error, got '%s'", err)
+	}
+
+	time.Sleep(600 * time.Millisecond)
+
+	// the first token is handed out while its replacement is requested
+	for time.Now().Before(first.Expiry) {
+		second, err := ts.Token()
+		if err != nil {
+			t.Fatalf("did not expect error, got '%s'", err)
+		}
+		if first.AccessToken != second.AccessToken {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Errorf("expected token to be refreshed before it expires")
+}
+
+func Test_TokenClient_TokenSourceSlowRefresh(t *testing.T) {
+	var requests int32
+	release := make(chan struct{})
+	authServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		if n > 1 {
+			<-release
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"access_token":"machine-token-%d","token_type":"Bearer","expires_in":2}`, n)))
+	}))
+	defer authServer.Close()
+	defer close(release)
+
+	ts := tokenClient(t, authServer.URL).TokenSource("http://venue")
+
+	first, err := ts.Token()
+	if err != nil {
+		t.Fatalf("did not expect error, got '%s'", err)
+	}
+
+	time.Sleep(1100 * time.Millisecond)
+
+	// the refresh is held by the provider, so callers must be given the token they already have
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			started := time.Now()
+			got, err := ts.Token()
+			if err != nil {
+				t.Errorf("did not expect error, got '%s'", err)
+				return
+			}
+			if got.AccessToken != first.AccessToken {
+				t.Errorf("access token = '%s', expected = '%s'", got.AccessToken, first.AccessToken)
+			}
+			if waited := time.Since(started); waited > 100*time.Millisecond {
+				t.Errorf("waited '%s' for a token, expected the current token without waiting", waited)
+			}
+		}()
+	}
+	wg.Wait()
+
+	deadline := time.Now().Add(time.Second)
+	for atomic.LoadInt32(&requests) < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if n := atomic.LoadInt32(&requests); n != 2 {
+		t.Errorf("requests = '%d', expected one refresh", n)
+	}
+}
+
+func Test_TokenClient_TokenSourceRetries(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []int
+		requests int32
+		err      bool
+	}{
+		{name: "transient failure", statuses: []int{http.StatusServiceUnavailable, http.StatusOK}, requests: 2},
+		{name: "rate limited", statuses: []int{http.StatusTooManyRequests, http.StatusOK}, requests: 2},
+		{name: "persistent failure", statuses: []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway}, requests: 3, err: true},
+		{name: "rejected credentials", statuses: []int{http.StatusUnauthorized, http.StatusOK}, requests: 1, err: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var requests int32
+			authServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := atomic.AddInt32(&requests, 1)
+				status := test.statuses[n-1]
+				w.WriteHeader(status)
+				if status == http.StatusOK {
+					_, _ = w.Write([]byte(`{"access_token":"machine-token","token_type":"Bearer","expires_in":86400}`))
+				}
+			}))
+			defer authServer.Close()
+
+			_, err := tokenClient(t, authServer.URL).TokenSource("http://venue").Token()
+			if (err != nil) != test.err {
+				t.Errorf("error = '%v', expected error = '%v'", err, test.err)
+			}
+			if requests != test.requests {
+				t.Errorf("requests = '%d', expected = '%d'", requests, test.requests)
+			}
+		})
+	}
+}
+
+func tokenClient(t *testing.T, domain string) *oidc.TokenClient {
+	provider := &oidc.Provider{TokenEndpoint: domain + "/oauth/token"}
+	tc, err := provider.TokenClient(zap.NewNop().Sugar(), "client-id", "client-secret")
+	if err != nil {
+		t.Fatalf("could not create token client : %s", err)
+	}
+
+	return tc
+}
@@ -0,0 +1,119 @@
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"github.com/labstack/echo/v4"
+	"github.com/patrickmn/go-cache"
+	"golang.org/x/sync/singleflight"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+const defaultUserCacheTTL = 5 * time.Minute
+
+type userService struct {
+	endpoint string
+	mapping  models.ClaimMapping
+	ttl      time.Duration
+	client   *http.Client
+	cache    *cache.Cache
+	group    singleflight.Group
+}
+
+// WithUserCacheTTL sets how long a user is cached for the token it was looked up with.
+func WithUserCacheTTL(ttl time.Duration) func(*userService) {
+	return func(us *userService) {
+		if ttl > 0 {
+			us.ttl = ttl
+		}
+	}
+}
+
+// UserService looks users up with the provider's userinfo endpoint, reading them from the claims named by the mapping.
+// Users are cached against the token they were looked up with, and concurrent lookups for the same token share a single
+// request.
+func (p *Provider) UserService(mapping models.ClaimMapping, options ...func(*userService)) (models.UserService, error) {
+	if p.UserInfoEndpoint == "" {
+		return nil, fmt.Errorf("provider has no userinfo endpoint")
+	}
+	us := &userService{
+		endpoint: p.UserInfoEndpoint,
+		mapping:  mapping,
+		ttl:      defaultUserCacheTTL,
+		client:   newHTTPClient(),
+	}
+	for _, option := range options {
+		option(us)
+	}
+	us.cache = cache.New(us.ttl, 2*us.ttl)
+
+	return us, nil
+}
+
+func (us *userService) GetUser(ctx context.Context) (*models.User, error) {
+	token, err := models.GetTokenFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// tokens are bearer credentials, so only their hash is kept as a key
+	sum := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(sum[:])
+
+	if cached, ok := us.cache.Get(key); ok {
+		user := cached.(models.User)
+		return &user, nil
+	}
+
+	result, err, _ := us.group.Do(key, func() (interface{}, error) {
+		user, err := us.userInfo(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		us.cache.SetDefault(key, user)
+
+		return user, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	user := result.(models.User)
+	return &user, nil
+}
+
+func (us *userService) userInfo(ctx context.Context, token string) (models.User, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", us.endpoint, nil)
+	if err != nil {
+		return models.User{}, fmt.Errorf("could not construct request : %w", err)
+	}
+	req.Header.Add(echo.HeaderAuthorization, token)
+
+	resp, err := us.client.Do(req)
+	if err != nil {
+		return models.User{}, fmt.Errorf("could not make request ; %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return models.User{}, fmt.Errorf("status code '%v' received", resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return models.User{}, fmt.Errorf("could not read response ; %w", err)
+	}
+
+	claims := map[string]interface{}{}
+	if err := json.Unmarshal(body, &claims); err != nil {
+		return models.User{}, fmt.Errorf("could not unmarshall : %w", err)
+	}
+
+	return us.mapping.User(claims), nil
+}
@@ -0,0 +1,147 @@
+package oidc_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/bradleyjkemp/cupaloy"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"net/http"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	token      = "test-token"
+	email      = "test@test.com"
+	familyName = "Test"
+	givenName  = "Test"
+)
+
+func Test_userService_GetUser(t *testing.T) {
+	s := newStandIn(t)
+	s.mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		bearer := r.Header.Get("Authorization")
+		if bearer != token {
+			t.Fatalf("bearer token = '%s', expected = '%s'", bearer, token)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"sub":"auth0|123","email":"%s","email_verified":true,"given_name":"%s","family_name":"%s","https://booking-platform/roles":["admin"]}`, email, givenName, familyName)))
+	})
+	ctx := models.AddTokenToCtx(context.Background(), token)
+	us := userService(t, s, models.NewClaimMapping(models.DefaultClaimsNamespace))
+	got, err := us.GetUser(ctx)
+	if err != nil {
+		t.Errorf("did not expect error, got '%s'", err)
+		return
+	}
+
+	cupaloy.SnapshotT(t, got)
+}
+
+func Test_userService_GetUserCached(t *testing.T) {
+	var requests int32
+	s := newStandIn(t)
+	s.mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"sub":"auth0|123","email":"%s"}`, r.Header.Get("Authorization"))))
+	})
+	us := userService(t, s, models.NewClaimMapping(models.DefaultClaimsNamespace))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := us.GetUser(models.AddTokenToCtx(context.Background(), token))
+			if err != nil {
+				t.Errorf("did not expect error, got '%s'", err)
+				return
+			}
+			if got.Email != token {
+				t.Errorf("email = '%s', expected = '%s'", got.Email, token)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if _, err := us.GetUser(models.AddTokenToCtx(context.Background(), token)); err != nil {
+		t.Fatalf("did not expect error, got '%s'", err)
+	}
+	if requests != 1 {
+		t.Errorf("requests = '%d', expected lookups for the same token to share one request", requests)
+	}
+
+	got, err := us.GetUser(models.AddTokenToCtx(context.Background(), "another-token"))
+	if err != nil {
+		t.Fatalf("did not expect error, got '%s'", err)
+	}
+	if got.Email != "another-token" {
+		t.Errorf("email = '%s', expected the user of the other token", got.Email)
+	}
+	if requests != 2 {
+		t.Errorf("requests = '%d', expected a new token to be looked up", requests)
+	}
+}
+
+func Test_userService_GetUserErrorNotCached(t *testing.T) {
+	var requests int32
+	s := newStandIn(t)
+	s.mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"email":"%s"}`, email)))
+	})
+	us := userService(t, s, models.NewClaimMapping(models.DefaultClaimsNamespace))
+	ctx := models.AddTokenToCtx(context.Background(), token)
+
+	if _, err := us.GetUser(ctx); err == nil {
+		t.Fatalf("expected error")
+	}
+	got, err := us.GetUser(ctx)
+	if err != nil {
+		t.Fatalf("did not expect error, got '%s'", err)
+	}
+	if got.Email != email {
+		t.Errorf("email = '%s', expected = '%s'", got.Email, email)
+	}
+}
+
+func Test_userService_GetUserClaimMapping(t *testing.T) {
+	s := newStandIn(t)
+	s.mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"sub":"f7a3","mail":"%s","first_name":"%s","groups":["staff"]}`, email, givenName)))
+	})
+	us := userService(t, s, models.ClaimMapping{
+		Subject:   []string{"sub"},
+		Email:     []string{"mail"},
+		GivenName: []string{"first_name"},
+		Roles:     []string{"groups"},
+	})
+
+	got, err := us.GetUser(models.AddTokenToCtx(context.Background(), token))
+	if err != nil {
+		t.Fatalf("did not expect error, got '%s'", err)
+	}
+	expected := models.User{Subject: "f7a3", Email: email, GivenName: givenName, Roles: []string{"staff"}}
+	if !reflect.DeepEqual(*got, expected) {
+		t.Errorf("user = '%+v', expected = '%+v'", *got, expected)
+	}
+}
+
+func userService(t *testing.T, s *standIn, mapping models.ClaimMapping) models.UserService {
+	us, err := s.provider(t).UserService(mapping)
+	if err != nil {
+		t.Fatalf("could not create user service : %s", err)
+	}
+
+	return us
+}
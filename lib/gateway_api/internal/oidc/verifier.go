@@ -0,0 +1,199 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"golang.org/x/sync/singleflight"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultJWKSCacheTTL = time.Hour
+	// minJWKSRefresh limits how often the key set is fetched, so tokens with made up key ids cannot turn every request
+	// into a request for keys
+	minJWKSRefresh = time.Minute
+)
+
+var algorithms = []jose.SignatureAlgorithm{jose.RS256, jose.ES256}
+
+// Verifier validates access tokens issued by a provider for an audience.
+type Verifier struct {
+	keys     *remoteKeys
+	issuer   string
+	audience string
+}
+
+// Verifier returns a verifier of access tokens for the audience, signed with the keys the provider publishes. The key
+// set is cached, and fetched again early when a token is signed with a key it does not contain.
+func (p *Provider) Verifier(audience string) *Verifier {
+	return &Verifier{
+		keys: &remoteKeys{
+			url:        p.JWKSURI,
+			ttl:        defaultJWKSCacheTTL,
+			minRefresh: minJWKSRefresh,
+			client:     newHTTPClient(),
+		},
+		issuer:   p.Issuer,
+		audience: audience,
+	}
+}
+
+// Verify checks the token's signature, expiry, issuer and audience, returning all of its claims.
+func (v *Verifier) Verify(ctx context.Context, raw string) (map[string]interface{}, error) {
+	token, err := jwt.ParseSigned(raw)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse token : %w", err)
+	}
+	if len(token.Headers) != 1 {
+		return nil, fmt.Errorf("token must have exactly one signature")
+	}
+
+	header := token.Headers[0]
+	if !allowed(header.Algorithm) {
+		return nil, fmt.Errorf("token algorithm '%s' is not allowed", header.Algorithm)
+	}
+
+	keys, err := v.keys.keys(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	registered := jwt.Claims{}
+	claims := map[string]interface{}{}
+	verified := false
+	for i := range keys {
+		if err := token.Claims(keys[i].Key, &registered, &claims); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, fmt.Errorf("could not verify token signature")
+	}
+
+	if registered.Expiry == nil {
+		return nil, fmt.Errorf("token has no expiry")
+	}
+	if err := registered.Validate(jwt.Expected{
+		Issuer:   v.issuer,
+		Audience: jwt.Audience{v.audience},
+		Time:     time.Now(),
+	}); err != nil {
+		return nil, fmt.Errorf("invalid token claims : %w", err)
+	}
+
+	return claims, nil
+}
+
+func allowed(alg string) bool {
+	for i := range algorithms {
+		if string(algorithms[i]) == alg {
+			return true
+		}
+	}
+
+	return false
+}
+
+type remoteKeys struct {
+	url        string
+	ttl        time.Duration
+	minRefresh time.Duration
+	client     *http.Client
+	// fetches coalesces concurrent fetches of the key set into one, which is made without holding the lock
+	fetches singleflight.Group
+
+	mu        sync.Mutex
+	set       jose.JSONWebKeySet
+	fetched   time.Time
+	attempted time.Time
+}
+
+func (r *remoteKeys) keys(ctx context.Context, kid string) ([]jose.JSONWebKey, error) {
+	r.mu.Lock()
+	keys := r.set.Key(kid)
+	stale := time.Since(r.fetched) > r.ttl
+	r.mu.Unlock()
+
+	// a stale key set is refreshed in the background while its keys are still used, calls only wait on a fetch when they
+	// need a key the set does not have
+	if len(keys) > 0 {
+		if stale {
+			r.fetches.DoChan("keys", r.refresh)
+		}
+		return keys, nil
+	}
+
+	if _, err, _ := r.fetches.Do("keys", r.refresh); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	keys = r.set.Key(kid)
+	r.mu.Unlock()
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no key with id '%s'", kid)
+	}
+
+	return keys, nil
+}
+
+// refresh fetches the key set, unless it was attempted too recently. A failed fetch keeps the previous keys, so a
+// provider outage does not reject tokens signed with keys already known.
+func (r *remoteKeys) refresh() (interface{}, error) {
+	r.mu.Lock()
+	if time.Since(r.attempted) <= r.minRefresh {
+		r.mu.Unlock()
+		return nil, nil
+	}
+	r.attempted = time.Now()
+	r.mu.Unlock()
+
+	set, err := r.fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.set = set
+	r.fetched = time.Now()
+	r.mu.Unlock()
+
+	return nil, nil
+}
+
+// fetch requests the key set. The request is shared by every call waiting on it, so it is not tied to any one call's
+// context, and is bounded by the client's timeout instead.
+func (r *remoteKeys) fetch() (jose.JSONWebKeySet, error) {
+	req, err := http.NewRequest(http.MethodGet, r.url, nil)
+	if err != nil {
+		return jose.JSONWebKeySet{}, fmt.Errorf("could not construct key set request : %w", err)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return jose.JSONWebKeySet{}, fmt.Errorf("could not fetch key set : %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return jose.JSONWebKeySet{}, fmt.Errorf("status code '%v' received fetching key set", resp.StatusCode)
+	}
+
+	set := jose.JSONWebKeySet{}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return jose.JSONWebKeySet{}, fmt.Errorf("could not decode key set : %w", err)
+	}
+	if len(set.Keys) == 0 {
+		return jose.JSONWebKeySet{}, errors.New("key set has no keys")
+	}
+
+	return set, nil
+}
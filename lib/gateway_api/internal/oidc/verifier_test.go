@@ -0,0 +1,136 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const audience = "http://gateway"
+
+func Test_Verifier(t *testing.T) {
+	s := newStandIn(t)
+	key := s.rotate(t, "first")
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifier := s.provider(t).Verifier(audience)
+
+	valid := func() jwt.Claims {
+		return jwt.Claims{
+			Issuer:   s.issuer,
+			Audience: jwt.Audience{audience, s.URL + "/userinfo"},
+			Subject:  "auth0|123",
+			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		}
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		err   bool
+	}{
+		{name: "valid token", token: mint(t, key, jose.RS256, "first", valid(), map[string]interface{}{"email": email})},
+		{name: "expired token", token: mint(t, key, jose.RS256, "first", func() jwt.Claims {
+			c := valid()
+			c.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+			return c
+		}(), nil), err: true},
+		{name: "token without expiry", token: mint(t, key, jose.RS256, "first", func() jwt.Claims {
+			c := valid()
+			c.Expiry = nil
+			return c
+		}(), nil), err: true},
+		{name: "wrong audience", token: mint(t, key, jose.RS256, "first", func() jwt.Claims {
+			c := valid()
+			c.Audience = jwt.Audience{"http://venue"}
+			return c
+		}(), nil), err: true},
+		{name: "wrong issuer", token: mint(t, key, jose.RS256, "first", func() jwt.Claims {
+			c := valid()
+			c.Issuer = "https://evil.example.com/"
+			return c
+		}(), nil), err: true},
+		{name: "wrong key", token: mint(t, other, jose.RS256, "first", valid(), nil), err: true},
+		{name: "disallowed algorithm", token: mint(t, []byte("secret"), jose.HS256, "first", valid(), nil), err: true},
+		{name: "malformed token", token: "not.a.token", err: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			claims, err := verifier.Verify(context.Background(), test.token)
+			if (err != nil) != test.err {
+				t.Fatalf("error = '%v', expected error = '%v'", err, test.err)
+			}
+			if !test.err && (claims["sub"] != "auth0|123" || claims["email"] != email) {
+				t.Errorf("claims = '%v', expected the subject and custom claims", claims)
+			}
+		})
+	}
+}
+
+func Test_VerifierKeyRotation(t *testing.T) {
+	s := newStandIn(t)
+	first := s.rotate(t, "first")
+	verifier := s.provider(t).Verifier(audience)
+
+	claims := jwt.Claims{
+		Issuer:   s.issuer,
+		Audience: jwt.Audience{audience},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := verifier.Verify(context.Background(), mint(t, first, jose.RS256, "first", claims, nil)); err != nil {
+			t.Fatalf("did not expect error, got '%s'", err)
+		}
+	}
+	if s.jwksFetches != 1 {
+		t.Errorf("key set fetches = '%d', expected the key set to be cached", s.jwksFetches)
+	}
+
+	// a token signed with an unknown key within a minute of the last fetch does not fetch the key set again
+	second := s.rotate(t, "second")
+	if _, err := verifier.Verify(context.Background(), mint(t, second, jose.RS256, "second", claims, nil)); err == nil {
+		t.Errorf("expected error while key set refreshes are limited")
+	}
+	if s.jwksFetches != 1 {
+		t.Errorf("key set fetches = '%d', expected = '1'", s.jwksFetches)
+	}
+}
+
+func Test_VerifierConcurrentFetch(t *testing.T) {
+	s := newStandIn(t)
+	key := s.rotate(t, "first")
+	verifier := s.provider(t).Verifier(audience)
+
+	token := mint(t, key, jose.RS256, "first", jwt.Claims{
+		Issuer:   s.issuer,
+		Audience: jwt.Audience{audience},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := verifier.Verify(context.Background(), token); err != nil {
+				t.Errorf("did not expect error, got '%s'", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&s.jwksFetches); n != 1 {
+		t.Errorf("key set fetches = '%d', expected concurrent calls to share one fetch", n)
+	}
+}
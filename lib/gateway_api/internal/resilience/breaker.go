@@ -0,0 +1,89 @@
+package resilience
+
+import (
+	"sync"
+	"time"
+)
+
+type state int
+
+const (
+	closed state = iota
+	open
+	halfOpen
+)
+
+// breaker counts consecutive failures of a service. Once there are enough it opens, failing calls without making them
+// until it has been open long enough to let a single trial call through. The trial closes it again if it succeeds.
+type breaker struct {
+	service   string
+	threshold int
+	openFor   time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    state
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+func newBreaker(service string, threshold int, openFor time.Duration, now func() time.Time) *breaker {
+	return &breaker{service: service, threshold: threshold, openFor: openFor, now: now}
+}
+
+// allow reports whether a call may be made. A breaker without a threshold never opens.
+func (b *breaker) allow() bool {
+	if b.threshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case open:
+		if b.now().Sub(b.openedAt) < b.openFor {
+			return false
+		}
+		b.state = halfOpen
+		b.trial = true
+		return true
+	case halfOpen:
+		if b.trial {
+			return false
+		}
+		b.trial = true
+		return true
+	}
+
+	return true
+}
+
+// record counts the outcome of a call allowed by the breaker.
+func (b *breaker) record(failed bool) {
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !failed {
+		b.failures = 0
+		b.trial = false
+		if b.state != closed {
+			b.state = closed
+			circuitOpen.WithLabelValues(b.service).Set(0)
+		}
+		return
+	}
+
+	b.failures++
+	if b.state == halfOpen || b.failures >= b.threshold {
+		b.state = open
+		b.openedAt = b.now()
+		b.trial = false
+		circuitOpen.WithLabelValues(b.service).Set(1)
+	}
+}
@@ -0,0 +1,21 @@
+package resilience
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	retries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gateway",
+		Subsystem: "downstream",
+		Name:      "retries_total",
+		Help:      "Total number of calls to a downstream service that were retried, by service and method.",
+	}, []string{"service", "method"})
+	circuitOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "gateway",
+		Subsystem: "downstream",
+		Name:      "circuit_open",
+		Help:      "Whether the circuit breaker for a downstream service is open, failing calls without making them.",
+	}, []string{"service"})
+)
@@ -0,0 +1,151 @@
+package resilience
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Policy says how calls to a downstream service are bounded, retried and cut off when the service is failing.
+type Policy struct {
+	// Timeout is the deadline given to calls made without a sooner one.
+	Timeout time.Duration
+	// Timeouts overrides Timeout for individual methods, keyed by full method name.
+	Timeouts map[string]time.Duration
+	// Idempotent lists the methods that are safe to retry, keyed by full method name. Other methods are never retried,
+	// as the service may have acted on a call it failed to answer.
+	Idempotent map[string]bool
+	// MaxRetries is how many times an idempotent call that found the service unavailable is tried again.
+	MaxRetries int
+	// Backoff is the wait before the first retry, doubled for each retry after up to MaxBackoff. Waits are jittered so
+	// retrying callers do not arrive together.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// FailureThreshold is how many calls in a row must fail before the circuit opens, failing calls without making them.
+	FailureThreshold int
+	// OpenFor is how long the circuit stays open before a single call is let through to see if the service is back.
+	OpenFor time.Duration
+}
+
+// DefaultPolicy gives calls five seconds, retries idempotent calls twice and opens the circuit after five failures.
+func DefaultPolicy() Policy {
+	return Policy{
+		Timeout:          5 * time.Second,
+		Timeouts:         map[string]time.Duration{},
+		Idempotent:       map[string]bool{},
+		MaxRetries:       2,
+		Backoff:          50 * time.Millisecond,
+		MaxBackoff:       time.Second,
+		FailureThreshold: 5,
+		OpenFor:          10 * time.Second,
+	}
+}
+
+// UnaryClientInterceptor applies the policy to the calls made to the named service.
+func UnaryClientInterceptor(service string, policy Policy) grpc.UnaryClientInterceptor {
+	c := &caller{
+		service: service,
+		policy:  policy,
+		breaker: newBreaker(service, policy.FailureThreshold, policy.OpenFor, time.Now),
+		sleep:   sleep,
+		random:  rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+
+	return c.intercept
+}
+
+type caller struct {
+	service string
+	policy  Policy
+	breaker *breaker
+	sleep   func(ctx context.Context, d time.Duration) error
+
+	mu     sync.Mutex
+	random *rand.Rand
+}
+
+func (c *caller) intercept(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if timeout := c.timeout(method); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	attempts := 1
+	if c.policy.Idempotent[method] {
+		attempts += c.policy.MaxRetries
+	}
+
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			retries.WithLabelValues(c.service, method).Inc()
+			if sleepErr := c.sleep(ctx, c.backoff(attempt)); sleepErr != nil {
+				return err
+			}
+		}
+
+		if !c.breaker.allow() {
+			return status.Errorf(codes.Unavailable, "%s is unavailable : circuit breaker is open", c.service)
+		}
+
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		c.breaker.record(isFailure(err))
+
+		if status.Code(err) != codes.Unavailable || ctx.Err() != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// timeout is how long the call may take. The context library keeps a caller's sooner deadline.
+func (c *caller) timeout(method string) time.Duration {
+	if timeout, ok := c.policy.Timeouts[method]; ok {
+		return timeout
+	}
+
+	return c.policy.Timeout
+}
+
+// backoff returns a wait between half and all of the exponential backoff for the retry.
+func (c *caller) backoff(retry int) time.Duration {
+	d := c.policy.Backoff << uint(retry-1)
+	if d > c.policy.MaxBackoff || d <= 0 {
+		d = c.policy.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return d/2 + time.Duration(c.random.Int63n(int64(d/2)+1))
+}
+
+// isFailure reports whether an error means the service is failing, rather than it rejecting the call.
+func isFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+
+	return false
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,192 @@
+package resilience_test
+
+import (
+	"context"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/resilience"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+const (
+	read  = "/venue.api.VenueAPI/GetVenue"
+	write = "/venue.api.VenueAPI/AddTable"
+)
+
+func policy() resilience.Policy {
+	p := resilience.DefaultPolicy()
+	p.Timeout = time.Second
+	p.Idempotent = map[string]bool{read: true}
+	p.Backoff = time.Millisecond
+	p.MaxBackoff = 2 * time.Millisecond
+	p.FailureThreshold = 0
+
+	return p
+}
+
+// invoker fails with the given errors in turn, then succeeds.
+type invoker struct {
+	errs  []error
+	calls int
+	ctx   context.Context
+}
+
+func (i *invoker) invoke(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+	i.ctx = ctx
+	i.calls++
+	if i.calls <= len(i.errs) {
+		return i.errs[i.calls-1]
+	}
+
+	return nil
+}
+
+func Test_Retry(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+
+	tests := []struct {
+		name   string
+		method string
+		errs   []error
+		calls  int
+		code   codes.Code
+	}{
+		{
+			name:   "idempotent call retried until it succeeds",
+			method: read,
+			errs:   []error{unavailable, unavailable},
+			calls:  3,
+			code:   codes.OK,
+		},
+		{
+			name:   "idempotent call retried until retries run out",
+			method: read,
+			errs:   []error{unavailable, unavailable, unavailable, unavailable},
+			calls:  3,
+			code:   codes.Unavailable,
+		},
+		{
+			name:   "call that changes the service is not retried",
+			method: write,
+			errs:   []error{unavailable},
+			calls:  1,
+			code:   codes.Unavailable,
+		},
+		{
+			name:   "rejected call is not retried",
+			method: read,
+			errs:   []error{status.Error(codes.InvalidArgument, "invalid venue id")},
+			calls:  1,
+			code:   codes.InvalidArgument,
+		},
+		{
+			name:   "call that timed out is not retried",
+			method: read,
+			errs:   []error{status.Error(codes.DeadlineExceeded, "deadline exceeded")},
+			calls:  1,
+			code:   codes.DeadlineExceeded,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			i := &invoker{errs: test.errs}
+			err := resilience.UnaryClientInterceptor("venue", policy())(context.Background(), test.method, nil, nil, nil, i.invoke)
+
+			assert.Equal(t, test.code, status.Code(err))
+			assert.Equal(t, test.calls, i.calls)
+		})
+	}
+}
+
+func Test_RetryStopsWhenContextIsDone(t *testing.T) {
+	p := policy()
+	p.Backoff = time.Hour
+	p.MaxBackoff = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	i := &invoker{errs: []error{status.Error(codes.Unavailable, "connection refused")}}
+	err := resilience.UnaryClientInterceptor("venue", p)(ctx, read, nil, nil, nil, i.invoke)
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 1, i.calls)
+}
+
+func Test_Deadline(t *testing.T) {
+	p := policy()
+	p.Timeouts = map[string]time.Duration{write: time.Minute}
+	interceptor := resilience.UnaryClientInterceptor("venue", p)
+
+	i := &invoker{}
+	assert.NoError(t, interceptor(context.Background(), read, nil, nil, nil, i.invoke))
+	deadline, ok := i.ctx.Deadline()
+	assert.True(t, ok, "call should be given a deadline")
+	assert.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+
+	assert.NoError(t, interceptor(context.Background(), write, nil, nil, nil, i.invoke))
+	deadline, _ = i.ctx.Deadline()
+	assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, 100*time.Millisecond, "method should have its own deadline")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.NoError(t, interceptor(ctx, read, nil, nil, nil, i.invoke))
+	deadline, _ = i.ctx.Deadline()
+	assert.WithinDuration(t, time.Now().Add(10*time.Millisecond), deadline, 10*time.Millisecond, "sooner deadline should be kept")
+}
+
+func Test_CircuitBreaker(t *testing.T) {
+	p := policy()
+	p.MaxRetries = 0
+	p.FailureThreshold = 2
+	p.OpenFor = 50 * time.Millisecond
+	interceptor := resilience.UnaryClientInterceptor("booking", p)
+
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+	i := &invoker{errs: []error{unavailable, unavailable, unavailable}}
+	call := func() error {
+		return interceptor(context.Background(), read, nil, nil, nil, i.invoke)
+	}
+
+	assert.Error(t, call())
+	assert.Error(t, call())
+	assert.Equal(t, 2, i.calls)
+
+	err := call()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Contains(t, err.Error(), "circuit breaker is open")
+	assert.Equal(t, 2, i.calls, "open circuit should fail calls without making them")
+
+	time.Sleep(p.OpenFor)
+	assert.Error(t, call(), "trial call fails")
+	assert.Equal(t, 3, i.calls)
+	assert.Contains(t, call().Error(), "circuit breaker is open", "failed trial should open the circuit again")
+
+	time.Sleep(p.OpenFor)
+	assert.NoError(t, call(), "trial call succeeds")
+	assert.NoError(t, call(), "successful trial should close the circuit")
+	assert.Equal(t, 5, i.calls)
+}
+
+func Test_CircuitBreakerIgnoresRejectedCalls(t *testing.T) {
+	p := policy()
+	p.FailureThreshold = 1
+	p.OpenFor = time.Hour
+	interceptor := resilience.UnaryClientInterceptor("venue", p)
+
+	i := &invoker{errs: []error{
+		status.Error(codes.NotFound, "venue not found"),
+		status.Error(codes.Aborted, "venue has been modified"),
+	}}
+	for range i.errs {
+		assert.Error(t, interceptor(context.Background(), write, nil, nil, nil, i.invoke))
+	}
+
+	assert.NoError(t, interceptor(context.Background(), write, nil, nil, nil, i.invoke))
+	assert.Equal(t, 3, i.calls)
+}
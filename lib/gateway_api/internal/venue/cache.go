@@ -0,0 +1,211 @@
+package venue
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/cache"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"time"
+)
+
+var cacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "gateway",
+	Subsystem: "venue_cache",
+	Name:      "requests_total",
+	Help:      "Total number of venue cache lookups by data and result.",
+}, []string{"data", "result"})
+
+// CacheTTL is how long each kind of venue data is cached for. Data without a ttl is not cached.
+type CacheTTL struct {
+	Venue        time.Duration
+	Tables       time.Duration
+	OpeningHours time.Duration
+	APIKey       time.Duration
+}
+
+// WithCache reads venues, their tables and their opening hours through the cache, and remembers api keys that have been
+// verified. A venue's cached data is dropped as soon as the gateway changes it, and a key's verification once the
+// gateway revokes it, while changes made elsewhere are only seen once the ttl has passed. Failing to use the cache is
+// not an error, the service is called instead.
+func WithCache(c cache.Cache, ttl CacheTTL) func(*venueClient) {
+	return func(vc *venueClient) {
+		vc.cache = c
+		vc.cacheTTL = ttl
+	}
+}
+
+const (
+	venueData        = "venue"
+	slugData         = "slug"
+	tablesData       = "tables"
+	openingHoursData = "openingHours"
+	apiKeyData       = "apiKey"
+	revokedData      = "revokedApiKey"
+)
+
+func venueKey(id string) string {
+	return "venue:" + id
+}
+
+// slugKey holds the id of the venue with the slug. Slugs do not change, so it is kept as long as the venue.
+func slugKey(slug string) string {
+	return "venue:slug:" + slug
+}
+
+func tablesKey(venueID string) string {
+	return "venue:tables:" + venueID
+}
+
+// openingHoursKey holds the venue's opening hours by date, so they can all be dropped when the venue's hours change.
+func openingHoursKey(venueID string) string {
+	return "venue:openingHours:" + venueID
+}
+
+// apiKeyKey holds a verified api key by a hash of the key, so the cache never holds keys themselves.
+func apiKeyKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return "venue:apiKey:" + hex.EncodeToString(sum[:])
+}
+
+// revokedKey marks an api key revoked, so its cached verification is not used. Verifications are cached by the key
+// rather than its id, so cannot be dropped when it is revoked.
+func revokedKey(id string) string {
+	return "venue:apiKey:revoked:" + id
+}
+
+// openingHours are the hours a venue is open on each date, with a nil specification for dates it is closed.
+type openingHours map[string]openingHoursEntry
+
+type openingHoursEntry struct {
+	Specification *models.OpeningHoursSpecification `json:"specification"`
+	// Expires is kept for each date, as the venue's entry lives on while other dates are added to it
+	Expires time.Time `json:"expires"`
+}
+
+func (v venueClient) ttl(data string) time.Duration {
+	switch data {
+	case venueData, slugData:
+		return v.cacheTTL.Venue
+	case tablesData:
+		return v.cacheTTL.Tables
+	case openingHoursData:
+		return v.cacheTTL.OpeningHours
+	case apiKeyData:
+		return v.cacheTTL.APIKey
+	case revokedData:
+		// outlives verifications cached while the key was being revoked
+		return 2 * v.cacheTTL.APIKey
+	}
+
+	return 0
+}
+
+// cached decodes the value cached for the key into value, returning whether there was one.
+func (v venueClient) cached(ctx context.Context, data string, key string, value interface{}) bool {
+	found, err := v.get(ctx, data, key, value)
+	record(data, found, err)
+
+	return found && err == nil
+}
+
+func (v venueClient) get(ctx context.Context, data string, key string, value interface{}) (bool, error) {
+	if v.cache == nil || v.ttl(data) <= 0 {
+		return false, nil
+	}
+
+	b, found, err := v.cache.Get(ctx, key)
+	if err != nil || !found {
+		return false, err
+	}
+
+	return true, json.Unmarshal(b, value)
+}
+
+func record(data string, found bool, err error) {
+	switch {
+	case err != nil:
+		cacheRequests.WithLabelValues(data, "error").Inc()
+	case found:
+		cacheRequests.WithLabelValues(data, "hit").Inc()
+	default:
+		cacheRequests.WithLabelValues(data, "miss").Inc()
+	}
+}
+
+func (v venueClient) store(ctx context.Context, data string, key string, value interface{}) {
+	ttl := v.ttl(data)
+	if v.cache == nil || ttl <= 0 {
+		return
+	}
+
+	b, err := json.Marshal(value)
+	if err == nil {
+		err = v.cache.Set(ctx, key, b, ttl)
+	}
+	if err != nil {
+		cacheRequests.WithLabelValues(data, "error").Inc()
+	}
+}
+
+// invalidate drops the cached data of the venue. It is called whether or not a change succeeds, as a change failing
+// because the venue's version has moved on means the cached venue is stale.
+func (v venueClient) invalidate(ctx context.Context, keys ...string) {
+	if v.cache == nil {
+		return
+	}
+
+	if err := v.cache.Delete(ctx, keys...); err != nil {
+		cacheRequests.WithLabelValues(venueData, "error").Inc()
+	}
+}
+
+// cachedOpeningHours returns the venue's cached opening hours on the date, and whether they were cached, along with the
+// dates cached for the venue so the date can be added to them.
+func (v venueClient) cachedOpeningHours(ctx context.Context, venueID string, date string) (*models.OpeningHoursSpecification, bool, openingHours) {
+	hours := openingHours{}
+	found, err := v.get(ctx, openingHoursData, openingHoursKey(venueID), &hours)
+	if err != nil || !found {
+		hours = openingHours{}
+	}
+
+	entry, ok := hours[date]
+	if ok && time.Now().After(entry.Expires) {
+		ok = false
+	}
+	record(openingHoursData, ok, err)
+
+	return entry.Specification, ok, hours
+}
+
+func (v venueClient) cacheOpeningHours(ctx context.Context, venueID string, date string, specification *models.OpeningHoursSpecification, hours openingHours) {
+	now := time.Now()
+	for cached, entry := range hours {
+		if now.After(entry.Expires) {
+			delete(hours, cached)
+		}
+	}
+	hours[date] = openingHoursEntry{Specification: specification, Expires: now.Add(v.cacheTTL.OpeningHours)}
+
+	v.store(ctx, openingHoursData, openingHoursKey(venueID), hours)
+}
+
+// cachedAPIKey returns the api key verified for the key, unless it has since been revoked.
+func (v venueClient) cachedAPIKey(ctx context.Context, key string) (*models.APIKey, bool) {
+	var cached models.APIKey
+	if !v.cached(ctx, apiKeyData, apiKeyKey(key), &cached) {
+		return nil, false
+	}
+
+	// a marker that cannot be read is taken as a revocation, so the key is verified with the service
+	var revoked bool
+	found, err := v.get(ctx, revokedData, revokedKey(cached.ID), &revoked)
+	if found || err != nil {
+		return nil, false
+	}
+
+	return &cached, true
+}
@@ -0,0 +1,213 @@
+package venue_test
+
+import (
+	"context"
+	mock_resolver "github.com/cobbinma/booking-platform/lib/gateway_api/graph/mock"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/cache"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/venue"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	venuemodels "github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/models"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+const (
+	hopAndVine = "2b7b9d4e-5f0c-4b6a-8b0e-1c0d9f3e8a11"
+	theCrown   = "9f4c2a1e-7d3b-4e5f-a6b7-c8d9e0f1a2b3"
+)
+
+var ttl = venue.CacheTTL{Venue: time.Minute, Tables: time.Minute, OpeningHours: time.Minute, APIKey: time.Minute}
+
+func Test_CacheGetVenue(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock_resolver.NewMockVenueAPIClient(ctrl)
+	slug := "hop-and-vine"
+
+	client.EXPECT().GetVenue(gomock.Any(), &api.GetVenueRequest{Slug: slug}).
+		Return(&venuemodels.Venue{Id: hopAndVine, Name: "hop and vine", Slug: slug, Version: 1}, nil)
+	client.EXPECT().UpdateOpeningHours(gomock.Any(), &api.UpdateOpeningHoursRequest{
+		VenueId:      hopAndVine,
+		OpeningHours: []*venuemodels.OpeningHoursSpecification{},
+		Version:      1,
+	}).Return(&api.UpdateOpeningHoursResponse{Version: 2}, nil)
+	client.EXPECT().BatchGetVenues(gomock.Any(), &api.BatchGetVenuesRequest{Ids: []string{hopAndVine}}).
+		Return(&api.BatchGetVenuesResponse{Venues: []*venuemodels.Venue{{Id: hopAndVine, Name: "hop and vine", Slug: slug, Version: 2}}}, nil)
+
+	vc, _, err := venue.NewVenueClient("", nil, nil, venue.WithClient(client), venue.WithCache(cache.NewMemory(time.Minute), ttl))
+	require.NoError(t, err)
+
+	first, err := vc.GetVenue(ctx, models.VenueFilter{Slug: &slug})
+	require.NoError(t, err)
+	cached, err := vc.GetVenue(ctx, models.VenueFilter{Slug: &slug})
+	require.NoError(t, err)
+	assert.Equal(t, first, cached, "venue should be read from the cache")
+
+	venues, err := vc.GetVenues(ctx, []string{hopAndVine})
+	require.NoError(t, err)
+	assert.Equal(t, first, venues[hopAndVine], "venue looked up by slug should be cached by id too")
+
+	_, err = vc.UpdateOpeningHours(ctx, models.UpdateOpeningHoursInput{VenueID: hopAndVine, Version: 1})
+	require.NoError(t, err)
+
+	venues, err = vc.GetVenues(ctx, []string{hopAndVine})
+	require.NoError(t, err)
+	assert.Equal(t, 2, venues[hopAndVine].Version, "changed venue should be fetched again")
+}
+
+func Test_CacheTablesBatch(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock_resolver.NewMockVenueAPIClient(ctrl)
+	extra := "0d4b7e6a-3c2f-4a1b-9e8d-7f6c5b4a3e2d"
+
+	gomock.InOrder(
+		client.EXPECT().GetTablesBatch(gomock.Any(), &api.GetTablesBatchRequest{VenueIds: []string{hopAndVine, theCrown}}).
+			Return(&api.GetTablesBatchResponse{Venues: []*api.VenueTables{
+				{VenueId: hopAndVine, Tables: []*venuemodels.Table{{Id: "table one", Name: "one", Capacity: 4}}},
+				{VenueId: theCrown},
+			}}, nil),
+		client.EXPECT().GetTablesBatch(gomock.Any(), &api.GetTablesBatchRequest{VenueIds: []string{extra}}).
+			Return(&api.GetTablesBatchResponse{}, nil),
+		client.EXPECT().AddTable(gomock.Any(), &api.AddTableRequest{VenueId: hopAndVine, Name: "two", Capacity: 2, Version: 1}).
+			Return(&venuemodels.Table{Id: "table two", Name: "two", Capacity: 2}, nil),
+		client.EXPECT().GetTablesBatch(gomock.Any(), &api.GetTablesBatchRequest{VenueIds: []string{hopAndVine}}).
+			Return(&api.GetTablesBatchResponse{Venues: []*api.VenueTables{
+				{VenueId: hopAndVine, Tables: []*venuemodels.Table{{Id: "table one", Name: "one", Capacity: 4}, {Id: "table two", Name: "two", Capacity: 2}}},
+			}}, nil),
+	)
+
+	vc, _, err := venue.NewVenueClient("", nil, nil, venue.WithClient(client), venue.WithCache(cache.NewMemory(time.Minute), ttl))
+	require.NoError(t, err)
+
+	_, err = vc.GetTablesBatch(ctx, []string{hopAndVine, theCrown})
+	require.NoError(t, err)
+
+	tables, err := vc.GetTablesBatch(ctx, []string{hopAndVine, theCrown, extra})
+	require.NoError(t, err)
+	assert.Equal(t, []*models.Table{{ID: "table one", Name: "one", Capacity: 4}}, tables[hopAndVine])
+	assert.Equal(t, []*models.Table{}, tables[theCrown], "venue without tables should be cached")
+	assert.NotContains(t, tables, extra)
+
+	_, err = vc.AddTable(ctx, models.TableInput{VenueID: hopAndVine, Name: "two", Capacity: 2, Version: 1})
+	require.NoError(t, err)
+
+	tables, err = vc.GetTablesBatch(ctx, []string{hopAndVine, theCrown})
+	require.NoError(t, err)
+	assert.Len(t, tables[hopAndVine], 2, "tables of the changed venue should be fetched again")
+}
+
+func Test_CacheOpeningHours(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock_resolver.NewMockVenueAPIClient(ctrl)
+	monday := time.Date(3000, 1, 6, 0, 0, 0, 0, time.UTC)
+	tuesday := monday.AddDate(0, 0, 1)
+
+	gomock.InOrder(
+		client.EXPECT().GetOpeningHoursSpecificationBatch(gomock.Any(), &api.GetOpeningHoursSpecificationBatchRequest{
+			VenueIds: []string{hopAndVine, theCrown},
+			Date:     monday.Format(time.RFC3339),
+		}).Return(&api.GetOpeningHoursSpecificationBatchResponse{Venues: []*api.VenueOpeningHoursSpecification{
+			{VenueId: hopAndVine, Specification: &venuemodels.OpeningHoursSpecification{DayOfWeek: 1, Opens: "10:00", Closes: "22:00"}},
+		}}, nil),
+		client.EXPECT().GetOpeningHoursSpecificationBatch(gomock.Any(), &api.GetOpeningHoursSpecificationBatchRequest{
+			VenueIds: []string{hopAndVine},
+			Date:     tuesday.Format(time.RFC3339),
+		}).Return(&api.GetOpeningHoursSpecificationBatchResponse{}, nil),
+		client.EXPECT().UpdateSpecialOpeningHours(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.Aborted, "venue has been modified since version 1")),
+		client.EXPECT().GetOpeningHoursSpecificationBatch(gomock.Any(), &api.GetOpeningHoursSpecificationBatchRequest{
+			VenueIds: []string{hopAndVine},
+			Date:     monday.Format(time.RFC3339),
+		}).Return(&api.GetOpeningHoursSpecificationBatchResponse{}, nil),
+	)
+
+	vc, _, err := venue.NewVenueClient("", nil, nil, venue.WithClient(client), venue.WithCache(cache.NewMemory(time.Minute), ttl))
+	require.NoError(t, err)
+
+	_, err = vc.OpeningHoursSpecificationBatch(ctx, []string{hopAndVine, theCrown}, monday)
+	require.NoError(t, err)
+
+	hours, err := vc.OpeningHoursSpecificationBatch(ctx, []string{hopAndVine, theCrown}, monday)
+	require.NoError(t, err)
+	assert.Equal(t, "10:00", string(*hours[hopAndVine].Opens))
+	assert.NotContains(t, hours, theCrown, "venue closed on the date should be cached as closed")
+
+	hours, err = vc.OpeningHoursSpecificationBatch(ctx, []string{hopAndVine}, tuesday)
+	require.NoError(t, err)
+	assert.Empty(t, hours)
+
+	_, err = vc.UpdateSpecialOpeningHours(ctx, models.UpdateSpecialOpeningHoursInput{VenueID: hopAndVine, Version: 1})
+	require.ErrorIs(t, err, models.ErrVersionConflict)
+
+	hours, err = vc.OpeningHoursSpecificationBatch(ctx, []string{hopAndVine, theCrown}, monday)
+	require.NoError(t, err)
+	assert.Empty(t, hours, "opening hours should be fetched again after a failed change")
+}
+
+func Test_CacheAPIKey(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock_resolver.NewMockVenueAPIClient(ctrl)
+	key := "bk_live_abc"
+	verified := &venuemodels.ApiKey{Id: "key one", VenueId: hopAndVine, Name: "till", Role: venuemodels.ApiKeyRole_MANAGE_BOOKINGS,
+		Prefix: "bk_live", CreatedAt: "2021-01-01T00:00:00Z"}
+
+	gomock.InOrder(
+		client.EXPECT().VerifyApiKey(gomock.Any(), &api.VerifyApiKeyRequest{Key: key}).Return(verified, nil),
+		client.EXPECT().RevokeApiKey(gomock.Any(), &api.RevokeApiKeyRequest{VenueId: hopAndVine, Id: "key one"}).
+			Return(&venuemodels.ApiKey{Id: "key one", VenueId: hopAndVine, Name: "till", Role: venuemodels.ApiKeyRole_MANAGE_BOOKINGS,
+				Prefix: "bk_live", CreatedAt: "2021-01-01T00:00:00Z", RevokedAt: "2021-01-02T00:00:00Z"}, nil),
+		client.EXPECT().VerifyApiKey(gomock.Any(), &api.VerifyApiKeyRequest{Key: key}).
+			Return(nil, status.Error(codes.NotFound, "could not find api key")),
+		client.EXPECT().VerifyApiKey(gomock.Any(), &api.VerifyApiKeyRequest{Key: key}).
+			Return(nil, status.Error(codes.NotFound, "could not find api key")),
+	)
+
+	vc, _, err := venue.NewVenueClient("", nil, nil, venue.WithClient(client), venue.WithCache(cache.NewMemory(time.Minute), ttl))
+	require.NoError(t, err)
+
+	first, err := vc.VerifyAPIKey(ctx, key)
+	require.NoError(t, err)
+	cached, err := vc.VerifyAPIKey(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, first, cached, "verified key should be read from the cache")
+
+	_, err = vc.RevokeAPIKey(ctx, models.RevokeAPIKeyInput{VenueID: hopAndVine, ID: "key one"})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = vc.VerifyAPIKey(ctx, key)
+		assert.ErrorIs(t, err, models.ErrInvalidAPIKey, "revoked and unknown keys should not be cached")
+	}
+}
+
+func Test_CacheDisabled(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock_resolver.NewMockVenueAPIClient(ctrl)
+
+	client.EXPECT().GetTablesBatch(gomock.Any(), &api.GetTablesBatchRequest{VenueIds: []string{hopAndVine}}).
+		Return(&api.GetTablesBatchResponse{}, nil).Times(2)
+
+	vc, _, err := venue.NewVenueClient("", nil, nil, venue.WithClient(client),
+		venue.WithCache(cache.NewMemory(time.Minute), venue.CacheTTL{Venue: time.Minute}))
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err := vc.GetTablesBatch(ctx, []string{hopAndVine})
+		require.NoError(t, err)
+	}
+}
@@ -4,9 +4,15 @@ import (
 	"context"
 	"fmt"
 	"github.com/cobbinma/booking-platform/lib/gateway_api/graph"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/balancing"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/cache"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/correlation"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/health"
+	"github.com/cobbinma/booking-platform/lib/gateway_api/internal/resilience"
 	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
 	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
 	venue "github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/models"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"golang.org/x/oauth2"
 	"google.golang.org/grpc"
@@ -17,10 +23,14 @@ import (
 	"time"
 )
 
-func NewVenueClient(url string, log *zap.SugaredLogger, token *oauth2.Token, options ...func(*venueClient)) (graph.VenueService, func(log *zap.SugaredLogger), error) {
+func NewVenueClient(url string, log *zap.SugaredLogger, tokens oauth2.TokenSource, options ...func(*venueClient)) (graph.VenueService, func(log *zap.SugaredLogger), error) {
 	vc := &venueClient{
-		client: nil,
-		log:    log,
+		client:     nil,
+		log:        log,
+		certFile:   "localhost.crt",
+		serverName: "localhost",
+		policy:     DefaultPolicy(),
+		balancing:  DefaultBalancing(),
 	}
 	cl := func(log *zap.SugaredLogger) {}
 
@@ -29,20 +39,37 @@ func NewVenueClient(url string, log *zap.SugaredLogger, token *oauth2.Token, opt
 	}
 
 	if vc.client == nil {
-		c, err := credentials.NewClientTLSFromFile("localhost.crt", "localhost")
+		c, err := credentials.NewClientTLSFromFile(vc.certFile, vc.serverName)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to load credentials : %w", err)
 		}
 
 		opts := []grpc.DialOption{
-			grpc.WithPerRPCCredentials(oauth.NewOauthAccess(token)),
+			grpc.WithPerRPCCredentials(oauth.TokenSource{TokenSource: tokens}),
 			grpc.WithTransportCredentials(c),
+			grpc.WithChainUnaryInterceptor(
+				correlation.UnaryClientInterceptor(),
+				resilience.UnaryClientInterceptor("venue", vc.policy),
+				otelgrpc.UnaryClientInterceptor(),
+			),
+			grpc.WithChainStreamInterceptor(correlation.StreamClientInterceptor(), otelgrpc.StreamClientInterceptor()),
 		}
-		conn, err := grpc.Dial(url, opts...)
+		if len(vc.balancing.Endpoints) == 0 {
+			vc.balancing.Endpoints = []string{url}
+		}
+		target, balancingOpts, err := balancing.Dial("venue", vc.balancing)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not balance calls : %w", err)
+		}
+		conn, err := grpc.Dial(target, append(opts, balancingOpts...)...)
 		if err != nil {
 			return nil, nil, fmt.Errorf("could not connect : %s", err)
 		}
 
+		if vc.readiness != nil {
+			vc.readiness.Add("venue", health.Conn(conn))
+		}
+
 		cl = func(log *zap.SugaredLogger) {
 			if err := conn.Close(); err != nil {
 				log.Error("could not close connection : %s", err)
@@ -60,19 +87,95 @@ func WithClient(client api.VenueAPIClient) func(*venueClient) {
 	}
 }
 
+// WithTLS verifies the service with the certificate in the file, expecting it to be issued for the server name.
+func WithTLS(certFile string, serverName string) func(*venueClient) {
+	return func(c *venueClient) {
+		c.certFile = certFile
+		c.serverName = serverName
+	}
+}
+
+// WithPolicy sets the deadlines, retries and circuit breaking of calls to the service.
+func WithPolicy(policy resilience.Policy) func(*venueClient) {
+	return func(c *venueClient) {
+		c.policy = policy
+	}
+}
+
+// WithReadiness reports the gateway ready only while the service can be reached.
+func WithReadiness(readiness *health.Readiness) func(*venueClient) {
+	return func(c *venueClient) {
+		c.readiness = readiness
+	}
+}
+
+// WithBalancing spreads calls across the replicas of the service. The url the client is created with is dialled when no
+// endpoints are given.
+func WithBalancing(options balancing.Options) func(*venueClient) {
+	return func(c *venueClient) {
+		c.balancing = options
+	}
+}
+
+// DefaultBalancing only calls replicas that report themselves serving, and pings idle connections so broken ones are
+// noticed before a call is made on them.
+func DefaultBalancing() balancing.Options {
+	return balancing.Options{
+		HealthCheck:      true,
+		ServiceName:      "venue.api.VenueAPI",
+		KeepaliveTime:    30 * time.Second,
+		KeepaliveTimeout: 10 * time.Second,
+	}
+}
+
+const serviceName = "/venue.api.VenueAPI"
+
+// DefaultPolicy retries the calls that only read from the service.
+func DefaultPolicy() resilience.Policy {
+	policy := resilience.DefaultPolicy()
+	policy.Timeout = 3 * time.Second
+	policy.Idempotent = map[string]bool{
+		serviceName + "/GetVenue":                          true,
+		serviceName + "/BatchGetVenues":                    true,
+		serviceName + "/GetOpeningHoursSpecification":      true,
+		serviceName + "/GetOpeningHoursSpecificationBatch": true,
+		serviceName + "/GetTables":                         true,
+		serviceName + "/GetTablesBatch":                    true,
+		serviceName + "/IsAdmin":                           true,
+		serviceName + "/IsAdminBatch":                      true,
+		serviceName + "/GetAdmins":                         true,
+		serviceName + "/GetAdminsBatch":                    true,
+		serviceName + "/GetApiKeys":                        true,
+		// only records the key's use, and at most once a minute, so is safe to retry
+		serviceName + "/VerifyApiKey": true,
+	}
+
+	return policy
+}
+
 type venueClient struct {
-	client api.VenueAPIClient
-	log    *zap.SugaredLogger
+	client     api.VenueAPIClient
+	log        *zap.SugaredLogger
+	certFile   string
+	serverName string
+	policy     resilience.Policy
+	balancing  balancing.Options
+	readiness  *health.Readiness
+	cache      cache.Cache
+	cacheTTL   CacheTTL
 }
 
 func (v venueClient) AddTable(ctx context.Context, input models.TableInput) (*models.Table, error) {
+	defer v.invalidate(ctx, venueKey(input.VenueID), tablesKey(input.VenueID))
+
 	table, err := v.client.AddTable(ctx, &api.AddTableRequest{
 		VenueId:  input.VenueID,
 		Name:     input.Name,
 		Capacity: uint32(input.Capacity),
+		Version:  int64(input.Version),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("could not add table using venue service : %w", err)
+		return nil, fmt.Errorf("could not add table using venue service : %w", versionError(err))
 	}
 
 	return &models.Table{
@@ -83,12 +186,15 @@ func (v venueClient) AddTable(ctx context.Context, input models.TableInput) (*mo
 }
 
 func (v venueClient) RemoveTable(ctx context.Context, input models.RemoveTableInput) (*models.Table, error) {
+	defer v.invalidate(ctx, venueKey(input.VenueID), tablesKey(input.VenueID))
+
 	table, err := v.client.RemoveTable(ctx, &api.RemoveTableRequest{
 		VenueId: input.VenueID,
 		TableId: input.TableID,
+		Version: int64(input.Version),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("could not add table using venue service : %w", err)
+		return nil, fmt.Errorf("could not add table using venue service : %w", versionError(err))
 	}
 
 	return &models.Table{
@@ -98,19 +204,37 @@ func (v venueClient) RemoveTable(ctx context.Context, input models.RemoveTableIn
 	}, nil
 }
 
-func (v venueClient) GetTables(ctx context.Context, venueID string) ([]*models.Table, error) {
-	resp, err := v.client.GetTables(ctx, &api.GetTablesRequest{VenueId: venueID})
+func (v venueClient) GetTablesBatch(ctx context.Context, venueIDs []string) (map[string][]*models.Table, error) {
+	tables := make(map[string][]*models.Table, len(venueIDs))
+	missing := []string{}
+	for _, id := range venueIDs {
+		var cached []*models.Table
+		if v.cached(ctx, tablesData, tablesKey(id), &cached) {
+			tables[id] = cached
+			continue
+		}
+		missing = append(missing, id)
+	}
+	if len(missing) == 0 {
+		return tables, nil
+	}
+
+	resp, err := v.client.GetTablesBatch(ctx, &api.GetTablesBatchRequest{VenueIds: missing})
 	if err != nil {
 		return nil, fmt.Errorf("could not get tables from venue service : %w", err)
 	}
 
-	tables := []*models.Table{}
-	for _, table := range resp.Tables {
-		tables = append(tables, &models.Table{
-			ID:       table.Id,
-			Name:     table.Name,
-			Capacity: int(table.Capacity),
-		})
+	for _, venue := range resp.Venues {
+		venueTables := []*models.Table{}
+		for _, table := range venue.Tables {
+			venueTables = append(venueTables, &models.Table{
+				ID:       table.Id,
+				Name:     table.Name,
+				Capacity: int(table.Capacity),
+			})
+		}
+		tables[venue.VenueId] = venueTables
+		v.store(ctx, tablesData, tablesKey(venue.VenueId), venueTables)
 	}
 
 	return tables, nil
@@ -124,11 +248,72 @@ func (v venueClient) GetVenue(ctx context.Context, filter models.VenueFilter) (*
 	if filter.Slug != nil {
 		slug = *filter.Slug
 	}
+
+	// a venue asked for by id and slug is only cached when the slug is its own
+	cachedID := id
+	if slug != "" {
+		var slugID string
+		if !v.cached(ctx, slugData, slugKey(slug), &slugID) || (id != "" && id != slugID) {
+			slugID = ""
+		}
+		cachedID = slugID
+	}
+	if cachedID != "" {
+		var cached models.Venue
+		if v.cached(ctx, venueData, venueKey(cachedID), &cached) {
+			return &cached, nil
+		}
+	}
+
 	venue, err := v.client.GetVenue(ctx, &api.GetVenueRequest{Id: id, Slug: slug})
 	if err != nil {
 		return nil, fmt.Errorf("could not get venue from venue service : %w", err)
 	}
 
+	model, err := venueModel(venue)
+	if err != nil {
+		return nil, err
+	}
+	v.store(ctx, venueData, venueKey(model.ID), model)
+	v.store(ctx, slugData, slugKey(model.Slug), model.ID)
+
+	return model, nil
+}
+
+func (v venueClient) GetVenues(ctx context.Context, ids []string) (map[string]*models.Venue, error) {
+	venues := make(map[string]*models.Venue, len(ids))
+	missing := []string{}
+	for _, id := range ids {
+		var cached models.Venue
+		if v.cached(ctx, venueData, venueKey(id), &cached) {
+			venues[id] = &cached
+			continue
+		}
+		missing = append(missing, id)
+	}
+	if len(missing) == 0 {
+		return venues, nil
+	}
+
+	resp, err := v.client.BatchGetVenues(ctx, &api.BatchGetVenuesRequest{Ids: missing})
+	if err != nil {
+		return nil, fmt.Errorf("could not get venues from venue service : %w", err)
+	}
+
+	for _, venue := range resp.Venues {
+		model, err := venueModel(venue)
+		if err != nil {
+			return nil, err
+		}
+		venues[venue.Id] = model
+		v.store(ctx, venueData, venueKey(model.ID), model)
+		v.store(ctx, slugData, slugKey(model.Slug), model.ID)
+	}
+
+	return venues, nil
+}
+
+func venueModel(venue *venue.Venue) (*models.Venue, error) {
 	openingHours := []*models.OpeningHoursSpecification{}
 	for _, hours := range venue.OpeningHours {
 		openingHours = append(openingHours, &models.OpeningHoursSpecification{
@@ -172,38 +357,73 @@ func (v venueClient) GetVenue(ctx context.Context, filter models.VenueFilter) (*
 		OpeningHours:        openingHours,
 		SpecialOpeningHours: specialHours,
 		Slug:                venue.Slug,
+		Version:             int(venue.Version),
 	}, nil
 }
 
-func (v venueClient) OpeningHoursSpecification(ctx context.Context, venueID string, date time.Time) (*models.OpeningHoursSpecification, error) {
-	resp, err := v.client.GetOpeningHoursSpecification(ctx, &api.GetOpeningHoursSpecificationRequest{
-		VenueId: venueID,
-		Date:    date.Format(time.RFC3339),
+func (v venueClient) OpeningHoursSpecificationBatch(ctx context.Context, venueIDs []string, date time.Time) (map[string]*models.OpeningHoursSpecification, error) {
+	day := date.Format(time.RFC3339)
+	specifications := make(map[string]*models.OpeningHoursSpecification, len(venueIDs))
+	cachedHours := make(map[string]openingHours, len(venueIDs))
+	missing := []string{}
+	for _, id := range venueIDs {
+		specification, found, hours := v.cachedOpeningHours(ctx, id, day)
+		if found {
+			// venues closed on the date are left out, as they are by the service
+			if specification != nil {
+				specifications[id] = specification
+			}
+			continue
+		}
+		cachedHours[id] = hours
+		missing = append(missing, id)
+	}
+	if len(missing) == 0 {
+		return specifications, nil
+	}
+
+	resp, err := v.client.GetOpeningHoursSpecificationBatch(ctx, &api.GetOpeningHoursSpecificationBatchRequest{
+		VenueIds: missing,
+		Date:     day,
 	})
 	if err != nil {
-		if status.Code(err) == codes.NotFound {
-			return nil, nil
+		return nil, fmt.Errorf("could not get specifications from client : %w", err)
+	}
+
+	for _, venue := range resp.Venues {
+		specification, err := specificationModel(venue.Specification)
+		if err != nil {
+			return nil, err
+		}
+		specifications[venue.VenueId] = specification
+	}
+	if v.cache != nil && v.cacheTTL.OpeningHours > 0 {
+		for _, id := range missing {
+			v.cacheOpeningHours(ctx, id, day, specifications[id], cachedHours[id])
 		}
-		return nil, fmt.Errorf("could not get specification from client : %w", err)
 	}
 
+	return specifications, nil
+}
+
+func specificationModel(specification *venue.OpeningHoursSpecification) (*models.OpeningHoursSpecification, error) {
 	var opens, closes *models.TimeOfDay
-	if resp.Specification.Opens != "" {
-		opens = (*models.TimeOfDay)(&resp.Specification.Opens)
+	if specification.Opens != "" {
+		opens = (*models.TimeOfDay)(&specification.Opens)
 	}
-	if resp.Specification.Closes != "" {
-		closes = (*models.TimeOfDay)(&resp.Specification.Closes)
+	if specification.Closes != "" {
+		closes = (*models.TimeOfDay)(&specification.Closes)
 	}
 
 	var validFrom, validThrough *time.Time
-	if resp.Specification.ValidFrom != "" && resp.Specification.ValidThrough != "" {
-		f, err := time.Parse(time.RFC3339, resp.Specification.ValidFrom)
+	if specification.ValidFrom != "" && specification.ValidThrough != "" {
+		f, err := time.Parse(time.RFC3339, specification.ValidFrom)
 		if err != nil {
 			return nil, fmt.Errorf("could not parse valid from time : %w", err)
 		}
 		validFrom = &f
 
-		t, err := time.Parse(time.RFC3339, resp.Specification.ValidThrough)
+		t, err := time.Parse(time.RFC3339, specification.ValidThrough)
 		if err != nil {
 			return nil, fmt.Errorf("could not parse valid through time : %w", err)
 		}
@@ -211,7 +431,7 @@ func (v venueClient) OpeningHoursSpecification(ctx context.Context, venueID stri
 	}
 
 	return &models.OpeningHoursSpecification{
-		DayOfWeek:    models.DayOfWeek(resp.Specification.DayOfWeek),
+		DayOfWeek:    models.DayOfWeek(specification.DayOfWeek),
 		Opens:        opens,
 		Closes:       closes,
 		ValidFrom:    validFrom,
@@ -220,6 +440,8 @@ func (v venueClient) OpeningHoursSpecification(ctx context.Context, venueID stri
 }
 
 func (v venueClient) UpdateOpeningHours(ctx context.Context, input models.UpdateOpeningHoursInput) ([]*models.OpeningHoursSpecification, error) {
+	defer v.invalidate(ctx, venueKey(input.VenueID), openingHoursKey(input.VenueID))
+
 	hours := make([]*venue.OpeningHoursSpecification, len(input.OpeningHours))
 	for i := range input.OpeningHours {
 		hours[i] = &venue.OpeningHoursSpecification{
@@ -232,9 +454,10 @@ func (v venueClient) UpdateOpeningHours(ctx context.Context, input models.Update
 	resp, err := v.client.UpdateOpeningHours(ctx, &api.UpdateOpeningHoursRequest{
 		VenueId:      input.VenueID,
 		OpeningHours: hours,
+		Version:      int64(input.Version),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("could not update opening hours in client : %w", err)
+		return nil, fmt.Errorf("could not update opening hours in client : %w", versionError(err))
 	}
 
 	updated := make([]*models.OpeningHoursSpecification, len(resp.OpeningHours))
@@ -252,6 +475,8 @@ func (v venueClient) UpdateOpeningHours(ctx context.Context, input models.Update
 }
 
 func (v venueClient) UpdateSpecialOpeningHours(ctx context.Context, input models.UpdateSpecialOpeningHoursInput) ([]*models.OpeningHoursSpecification, error) {
+	defer v.invalidate(ctx, venueKey(input.VenueID), openingHoursKey(input.VenueID))
+
 	hours := make([]*venue.OpeningHoursSpecification, len(input.SpecialOpeningHours))
 	for i := range input.SpecialOpeningHours {
 		var opens, closes string
@@ -273,9 +498,10 @@ func (v venueClient) UpdateSpecialOpeningHours(ctx context.Context, input models
 	resp, err := v.client.UpdateSpecialOpeningHours(ctx, &api.UpdateOpeningHoursRequest{
 		VenueId:      input.VenueID,
 		OpeningHours: hours,
+		Version:      int64(input.Version),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("could not update special opening hours in client : %w", err)
+		return nil, fmt.Errorf("could not update special opening hours in client : %w", versionError(err))
 	}
 
 	updated := make([]*models.OpeningHoursSpecification, len(resp.OpeningHours))
@@ -327,13 +553,32 @@ func (v venueClient) IsAdmin(ctx context.Context, input models.IsAdminInput, ema
 	return resp.IsAdmin, nil
 }
 
-func (v venueClient) GetAdmins(ctx context.Context, venueID string) ([]string, error) {
-	resp, err := v.client.GetAdmins(ctx, &api.GetAdminsRequest{VenueId: venueID})
+func (v venueClient) IsAdminBatch(ctx context.Context, venueIDs []string, email string) (map[string]bool, error) {
+	resp, err := v.client.IsAdminBatch(ctx, &api.IsAdminBatchRequest{VenueIds: venueIDs, Email: email})
+	if err != nil {
+		return nil, fmt.Errorf("could not get is admin from client : %w", err)
+	}
+
+	administered := make(map[string]bool, len(resp.VenueIds))
+	for _, id := range resp.VenueIds {
+		administered[id] = true
+	}
+
+	return administered, nil
+}
+
+func (v venueClient) GetAdminsBatch(ctx context.Context, venueIDs []string) (map[string][]string, error) {
+	resp, err := v.client.GetAdminsBatch(ctx, &api.GetAdminsBatchRequest{VenueIds: venueIDs})
 	if err != nil {
 		return nil, fmt.Errorf("could not get admins from client : %w", err)
 	}
 
-	return resp.Admins, nil
+	admins := make(map[string][]string, len(resp.Venues))
+	for _, venue := range resp.Venues {
+		admins[venue.VenueId] = venue.Admins
+	}
+
+	return admins, nil
 }
 
 func (v venueClient) AddAdmin(ctx context.Context, input models.AdminInput) (string, error) {
@@ -359,3 +604,118 @@ func (v venueClient) RemoveAdmin(ctx context.Context, input models.RemoveAdminIn
 
 	return resp.Email, nil
 }
+
+func (v venueClient) CreateAPIKey(ctx context.Context, input models.APIKeyInput) (*models.CreatedAPIKey, error) {
+	resp, err := v.client.CreateApiKey(ctx, &api.CreateApiKeyRequest{
+		VenueId: input.VenueID,
+		Name:    input.Name,
+		Role:    venue.ApiKeyRole(venue.ApiKeyRole_value[input.Role.String()]),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create api key using client : %w", err)
+	}
+
+	key, err := apiKeyModel(resp.ApiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.CreatedAPIKey{Key: resp.Key, APIKey: key}, nil
+}
+
+func (v venueClient) GetAPIKeys(ctx context.Context, venueID string) ([]*models.APIKey, error) {
+	resp, err := v.client.GetApiKeys(ctx, &api.GetApiKeysRequest{VenueId: venueID})
+	if err != nil {
+		return nil, fmt.Errorf("could not get api keys from client : %w", err)
+	}
+
+	keys := make([]*models.APIKey, len(resp.ApiKeys))
+	for i := range resp.ApiKeys {
+		key, err := apiKeyModel(resp.ApiKeys[i])
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+
+	return keys, nil
+}
+
+func (v venueClient) RevokeAPIKey(ctx context.Context, input models.RevokeAPIKeyInput) (*models.APIKey, error) {
+	resp, err := v.client.RevokeApiKey(ctx, &api.RevokeApiKeyRequest{
+		VenueId: input.VenueID,
+		Id:      input.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not revoke api key using client : %w", err)
+	}
+	v.store(ctx, revokedData, revokedKey(resp.Id), true)
+
+	return apiKeyModel(resp)
+}
+
+// VerifyAPIKey returns the api key, which is cached briefly as every call made with it is verified.
+func (v venueClient) VerifyAPIKey(ctx context.Context, key string) (*models.APIKey, error) {
+	if cached, ok := v.cachedAPIKey(ctx, key); ok {
+		return cached, nil
+	}
+
+	resp, err := v.client.VerifyApiKey(ctx, &api.VerifyApiKeyRequest{Key: key})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, models.ErrInvalidAPIKey
+		}
+		return nil, fmt.Errorf("could not verify api key using client : %w", err)
+	}
+
+	model, err := apiKeyModel(resp)
+	if err != nil {
+		return nil, err
+	}
+	v.store(ctx, apiKeyData, apiKeyKey(key), model)
+
+	return model, nil
+}
+
+func apiKeyModel(key *venue.ApiKey) (*models.APIKey, error) {
+	createdAt, err := time.Parse(time.RFC3339, key.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse api key created at : %w", err)
+	}
+
+	model := &models.APIKey{
+		ID:        key.Id,
+		VenueID:   key.VenueId,
+		Name:      key.Name,
+		Role:      models.APIKeyRole(key.Role.String()),
+		Prefix:    key.Prefix,
+		CreatedAt: createdAt,
+	}
+
+	if key.LastUsedAt != "" {
+		lastUsedAt, err := time.Parse(time.RFC3339, key.LastUsedAt)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse api key last used at : %w", err)
+		}
+		model.LastUsedAt = &lastUsedAt
+	}
+
+	if key.RevokedAt != "" {
+		revokedAt, err := time.Parse(time.RFC3339, key.RevokedAt)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse api key revoked at : %w", err)
+		}
+		model.RevokedAt = &revokedAt
+	}
+
+	return model, nil
+}
+
+// versionError replaces the aborted status returned for a stale venue version with models.ErrVersionConflict.
+func versionError(err error) error {
+	if status.Code(err) == codes.Aborted {
+		return models.ErrVersionConflict
+	}
+
+	return err
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"context"
+	"fmt"
+)
+
+const apiKeyCtxKey ctxKey = "api-key-ctx-key"
+
+// GetAPIKeyFromContext returns the venue api key a request was made with.
+func GetAPIKeyFromContext(ctx context.Context) (*APIKey, error) {
+	if key, ok := ctx.Value(apiKeyCtxKey).(APIKey); ok {
+		return &key, nil
+	}
+
+	return nil, fmt.Errorf("api key not found in context")
+}
+
+func AddAPIKeyToContext(ctx context.Context, key APIKey) context.Context {
+	return context.WithValue(ctx, apiKeyCtxKey, key)
+}
+
+// APIKeyVerifier checks a raw venue api key, returning the key if it has been issued and not revoked. Keys that are not
+// valid give ErrInvalidAPIKey.
+type APIKeyVerifier interface {
+	VerifyAPIKey(ctx context.Context, key string) (*APIKey, error)
+}
+
+// Allows reports whether a key with the role may do what the given role may. Managing bookings includes reading them.
+func (e APIKeyRole) Allows(role APIKeyRole) bool {
+	switch e {
+	case APIKeyRoleManageBookings:
+		return role == APIKeyRoleManageBookings || role == APIKeyRoleReadBookings
+	case APIKeyRoleReadBookings:
+		return role == APIKeyRoleReadBookings
+	}
+
+	return false
+}
@@ -0,0 +1,26 @@
+package models_test
+
+import (
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"testing"
+)
+
+func TestAPIKeyRole_Allows(t *testing.T) {
+	tests := []struct {
+		key      models.APIKeyRole
+		requires models.APIKeyRole
+		expect   bool
+	}{
+		{key: models.APIKeyRoleReadBookings, requires: models.APIKeyRoleReadBookings, expect: true},
+		{key: models.APIKeyRoleReadBookings, requires: models.APIKeyRoleManageBookings, expect: false},
+		{key: models.APIKeyRoleManageBookings, requires: models.APIKeyRoleReadBookings, expect: true},
+		{key: models.APIKeyRoleManageBookings, requires: models.APIKeyRoleManageBookings, expect: true},
+		{key: models.APIKeyRole("OWNER"), requires: models.APIKeyRoleReadBookings, expect: false},
+	}
+
+	for _, test := range tests {
+		if got := test.key.Allows(test.requires); got != test.expect {
+			t.Errorf("%s allows %s = %v, expected %v", test.key, test.requires, got, test.expect)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package models
+
+import "errors"
+
+// ErrVersionConflict is returned when a venue has been changed since the version a mutation was based on.
+var ErrVersionConflict = errors.New("venue has been modified, refresh and try again")
+
+// ErrUnauthenticated is returned when a field that needs a signed in user is asked for by a guest.
+var ErrUnauthenticated = errors.New("sign in required")
+
+// ErrInvalidBookingLink is returned when a guest booking link has been tampered with or has expired.
+var ErrInvalidBookingLink = errors.New("booking link is invalid or has expired")
+
+// ErrBookingLinkUsed is returned when a guest booking link has already confirmed a booking.
+var ErrBookingLinkUsed = errors.New("booking link has already been used")
+
+// ErrTooManyRequests is returned when a guest has asked for more booking links than they are allowed for now.
+var ErrTooManyRequests = errors.New("too many requests, try again later")
+
+// ErrForbidden is returned when a field is asked for with a venue api key that does not permit it.
+var ErrForbidden = errors.New("api key does not permit this")
+
+// ErrInvalidAPIKey is returned when a venue api key is unknown or has been revoked.
+var ErrInvalidAPIKey = errors.New("api key is invalid or has been revoked")
+
+// ErrServiceUnavailable is returned when a service the gateway depends on could not be reached or did not answer in time.
+var ErrServiceUnavailable = errors.New("service unavailable, try again later")
+
+// ErrNotAdmin is returned when a signed in user asks for something only the administrators of a venue may.
+var ErrNotAdmin = errors.New("only administrators of the venue may do this")
+
+// ErrSlotUnavailable is returned when the slot asked for has been taken or is outside of opening hours.
+var ErrSlotUnavailable = errors.New("slot is not available")
+
+// ErrGuestBookingsDisabled is returned when a guest tries to book and guest bookings have not been enabled.
+var ErrGuestBookingsDisabled = errors.New("guest bookings are not enabled")
+
+// ErrVenueNotFound is returned when a venue asked for by id does not exist.
+var ErrVenueNotFound = errors.New("venue not found")
+
+// InvalidInputError is returned when a request cannot be carried out as given. Its message is shown to clients, so
+// must say what is wrong with the input without revealing anything internal.
+type InvalidInputError string
+
+func (e InvalidInputError) Error() string {
+	return string(e)
+}
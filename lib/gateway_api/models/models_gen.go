@@ -3,6 +3,9 @@
 package models
 
 import (
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 )
 
@@ -14,6 +17,37 @@ type AdminInput struct {
 	Email string `json:"email"`
 }
 
+// Key an integration, such as a till, can use to call the api on behalf of a venue.
+// The key itself is only shown once, when it is created.
+type APIKey struct {
+	// unique identifier of the api key
+	ID string `json:"id"`
+	// unique identifier of the venue the key was issued for
+	VenueID string `json:"venueId"`
+	// name given to the key to say what it is used by
+	Name string `json:"name"`
+	// what the key may do
+	Role APIKeyRole `json:"role"`
+	// start of the key, to tell keys apart
+	Prefix string `json:"prefix"`
+	// time the key was created (YYYY-MM-DDThh:mm:ssZ)
+	CreatedAt time.Time `json:"createdAt"`
+	// time the key was last used (YYYY-MM-DDThh:mm:ssZ)
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	// time the key was revoked (YYYY-MM-DDThh:mm:ssZ)
+	RevokedAt *time.Time `json:"revokedAt"`
+}
+
+// Input to issue an api key for a venue.
+type APIKeyInput struct {
+	// unique identifier of the venue
+	VenueID string `json:"venueId"`
+	// name to say what the key is used by
+	Name string `json:"name"`
+	// what the key may do
+	Role APIKeyRole `json:"role"`
+}
+
 // Booking has now been confirmed.
 type Booking struct {
 	// unique identifier of the booking
@@ -38,7 +72,15 @@ type Booking struct {
 	TableID string `json:"tableId"`
 }
 
-// Slot is a possible booking that has yet to be confirmed.
+// A change to one of a venue's bookings.
+type BookingChange struct {
+	// how the booking changed
+	Type BookingChangeType `json:"type"`
+	// the booking as it is after the change
+	Booking *Booking `json:"booking"`
+}
+
+// Booking input is a possible booking that has yet to be confirmed.
 type BookingInput struct {
 	// unique identifier of the venue
 	VenueID string `json:"venueId"`
@@ -82,6 +124,14 @@ type CancelBookingInput struct {
 	ID string `json:"id"`
 }
 
+// A newly issued api key.
+type CreatedAPIKey struct {
+	// the key to send in the X-Api-Key header. it cannot be shown again
+	Key string `json:"key"`
+	// the issued api key
+	APIKey *APIKey `json:"apiKey"`
+}
+
 // Booking Enquiry Response.
 type GetSlotResponse struct {
 	// slot matching the given enquiy
@@ -90,6 +140,14 @@ type GetSlotResponse struct {
 	OtherAvailableSlots []*Slot `json:"otherAvailableSlots"`
 }
 
+// Guest booking waiting for the customer to follow the confirmation link sent to their email.
+type GuestBooking struct {
+	// email the confirmation link was sent to
+	Email string `json:"email"`
+	// time the confirmation link expires (YYYY-MM-DDThh:mm:ssZ)
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
 // Input to query if the user is an admin. Fields AND together.
 type IsAdminInput struct {
 	// unique identifier of the venue
@@ -144,6 +202,16 @@ type RemoveTableInput struct {
 	VenueID string `json:"venueId"`
 	// unique identifier of the table to be removed
 	TableID string `json:"tableId"`
+	// version of the venue the change is based on
+	Version int `json:"version"`
+}
+
+// Input to revoke a venue's api key.
+type RevokeAPIKeyInput struct {
+	// unique identifier of the venue
+	VenueID string `json:"venueId"`
+	// unique identifier of the api key
+	ID string `json:"id"`
 }
 
 // Slot is a possible booking that has yet to be confirmed.
@@ -208,6 +276,8 @@ type TableInput struct {
 	Name string `json:"name"`
 	// maximum amount of people that can sit at table
 	Capacity int `json:"capacity"`
+	// version of the venue the change is based on
+	Version int `json:"version"`
 }
 
 // Input to update a venue's operating hours.
@@ -216,6 +286,8 @@ type UpdateOpeningHoursInput struct {
 	VenueID string `json:"venueId"`
 	// operating hours of the venue
 	OpeningHours []*OpeningHoursSpecificationInput `json:"openingHours"`
+	// version of the venue the change is based on
+	Version int `json:"version"`
 }
 
 // Input to update a venue's special operating hours.
@@ -224,6 +296,8 @@ type UpdateSpecialOpeningHoursInput struct {
 	VenueID string `json:"venueId"`
 	// special operating hours of the venue
 	SpecialOpeningHours []*SpecialOpeningHoursSpecificationInput `json:"specialOpeningHours"`
+	// version of the venue the change is based on
+	Version int `json:"version"`
 }
 
 // Venue where a booking can take place.
@@ -246,6 +320,10 @@ type Venue struct {
 	Slug string `json:"slug"`
 	// paginated list of bookings for a venue
 	Bookings *BookingsPage `json:"bookings"`
+	// version of the venue's configuration. must be given when changing opening hours or tables
+	Version int `json:"version"`
+	// api keys issued for the venue, including revoked keys
+	APIKeys []*APIKey `json:"apiKeys"`
 }
 
 // Filter get venue queries. Fields AND together.
@@ -255,3 +333,135 @@ type VenueFilter struct {
 	// human readable identifier of the venue
 	Slug *string `json:"slug"`
 }
+
+// What a venue's api key may do. Keys are limited to the venue they were issued for.
+type APIKeyRole string
+
+const (
+	// read the venue's tables and bookings
+	APIKeyRoleReadBookings APIKeyRole = "READ_BOOKINGS"
+	// read, create and cancel the venue's bookings
+	APIKeyRoleManageBookings APIKeyRole = "MANAGE_BOOKINGS"
+)
+
+var AllAPIKeyRole = []APIKeyRole{
+	APIKeyRoleReadBookings,
+	APIKeyRoleManageBookings,
+}
+
+func (e APIKeyRole) IsValid() bool {
+	switch e {
+	case APIKeyRoleReadBookings, APIKeyRoleManageBookings:
+		return true
+	}
+	return false
+}
+
+func (e APIKeyRole) String() string {
+	return string(e)
+}
+
+func (e *APIKeyRole) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = APIKeyRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ApiKeyRole", str)
+	}
+	return nil
+}
+
+func (e APIKeyRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Who may call a field.
+type Auth string
+
+const (
+	// anyone, including guests without an account
+	AuthPublic Auth = "PUBLIC"
+	// signed in users
+	AuthUser Auth = "USER"
+)
+
+var AllAuth = []Auth{
+	AuthPublic,
+	AuthUser,
+}
+
+func (e Auth) IsValid() bool {
+	switch e {
+	case AuthPublic, AuthUser:
+		return true
+	}
+	return false
+}
+
+func (e Auth) String() string {
+	return string(e)
+}
+
+func (e *Auth) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Auth(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Auth", str)
+	}
+	return nil
+}
+
+func (e Auth) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// How a booking changed.
+type BookingChangeType string
+
+const (
+	// the booking was made
+	BookingChangeTypeCreated BookingChangeType = "CREATED"
+	// the booking was cancelled
+	BookingChangeTypeCancelled BookingChangeType = "CANCELLED"
+)
+
+var AllBookingChangeType = []BookingChangeType{
+	BookingChangeTypeCreated,
+	BookingChangeTypeCancelled,
+}
+
+func (e BookingChangeType) IsValid() bool {
+	switch e {
+	case BookingChangeTypeCreated, BookingChangeTypeCancelled:
+		return true
+	}
+	return false
+}
+
+func (e BookingChangeType) String() string {
+	return string(e)
+}
+
+func (e *BookingChangeType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BookingChangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BookingChangeType", str)
+	}
+	return nil
+}
+
+func (e BookingChangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -0,0 +1,29 @@
+package models
+
+import "context"
+
+const (
+	requestIDCtxKey ctxKey = "request-id-ctx-key"
+	clientIPCtxKey  ctxKey = "client-ip-ctx-key"
+)
+
+// GetRequestIDFromContext returns the id correlating the logs and calls made for a request, or an empty string if the
+// request was not given one.
+func GetRequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDCtxKey).(string)
+	return id
+}
+
+func AddRequestIDToContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDCtxKey, id)
+}
+
+// GetClientIPFromContext returns the address the request came from, or an empty string if it is not known.
+func GetClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPCtxKey).(string)
+	return ip
+}
+
+func AddClientIPToContext(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPCtxKey, ip)
+}
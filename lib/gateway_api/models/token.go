@@ -2,21 +2,29 @@ package models
 
 import (
 	"context"
-	"fmt"
+	"errors"
 )
 
 type ctxKey string
 
 const tokenCtxKey ctxKey = "token-ctx-key"
 
+// ErrNoToken is returned when a request has no access token, such as one made by a guest.
+var ErrNoToken = errors.New("could not get token from context")
+
 func GetTokenFromCtx(ctx context.Context) (string, error) {
 	if token, ok := ctx.Value(tokenCtxKey).(string); ok {
 		return token, nil
 	}
 
-	return "", fmt.Errorf("could not get token from context")
+	return "", ErrNoToken
 }
 
 func AddTokenToCtx(ctx context.Context, token string) context.Context {
 	return context.WithValue(ctx, tokenCtxKey, token)
 }
+
+// TokenVerifier checks a raw access token, returning its claims if it is valid.
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (map[string]interface{}, error)
+}
@@ -3,6 +3,7 @@ package models
 import (
 	"context"
 	"fmt"
+	"strings"
 )
 
 const userCtxKey ctxKey = "userKey"
@@ -24,7 +25,77 @@ type UserService interface {
 }
 
 type User struct {
-	Email      string `json:"email"`
-	GivenName  string `json:"given_name"`
-	FamilyName string `json:"family_name"`
+	Subject       string   `json:"sub"`
+	Email         string   `json:"email"`
+	EmailVerified bool     `json:"email_verified"`
+	GivenName     string   `json:"given_name"`
+	FamilyName    string   `json:"family_name"`
+	Roles         []string `json:"roles"`
+}
+
+// DefaultClaimsNamespace prefixes the custom claims our identity provider adds to access tokens. Auth0 drops custom
+// claims that are not namespaced with a url.
+const DefaultClaimsNamespace = "https://booking-platform/"
+
+// ClaimMapping names the claims a user is read from, each field listing claim names tried in order. It lets providers
+// that put profile details in custom claims be used without code changes.
+type ClaimMapping struct {
+	Subject       []string
+	Email         []string
+	EmailVerified []string
+	GivenName     []string
+	FamilyName    []string
+	Roles         []string
+}
+
+// NewClaimMapping reads profile claims by their standard OpenID Connect name, falling back to the namespaced name they are
+// given when added to an access token. Roles are only read from the namespace.
+func NewClaimMapping(namespace string) ClaimMapping {
+	names := func(name string) []string {
+		if namespace == "" {
+			return []string{name}
+		}
+		return []string{name, namespace + name}
+	}
+
+	return ClaimMapping{
+		Subject:       []string{"sub"},
+		Email:         names("email"),
+		EmailVerified: names("email_verified"),
+		GivenName:     names("given_name"),
+		FamilyName:    names("family_name"),
+		Roles:         []string{namespace + "roles"},
+	}
+}
+
+// User builds a user from token or userinfo claims.
+func (m ClaimMapping) User(claims map[string]interface{}) User {
+	claim := func(names []string) interface{} {
+		for i := range names {
+			if v, ok := claims[names[i]]; ok {
+				return v
+			}
+		}
+		return nil
+	}
+
+	user := User{}
+	user.Subject, _ = claim(m.Subject).(string)
+	user.Email, _ = claim(m.Email).(string)
+	user.EmailVerified, _ = claim(m.EmailVerified).(bool)
+	user.GivenName, _ = claim(m.GivenName).(string)
+	user.FamilyName, _ = claim(m.FamilyName).(string)
+
+	switch roles := claim(m.Roles).(type) {
+	case []interface{}:
+		for i := range roles {
+			if role, ok := roles[i].(string); ok {
+				user.Roles = append(user.Roles, role)
+			}
+		}
+	case string:
+		user.Roles = strings.Fields(roles)
+	}
+
+	return user
 }
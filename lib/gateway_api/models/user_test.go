@@ -0,0 +1,74 @@
+package models_test
+
+import (
+	"github.com/cobbinma/booking-platform/lib/gateway_api/models"
+	"reflect"
+	"testing"
+)
+
+func TestClaimMapping_User(t *testing.T) {
+	const namespace = "https://booking-platform/"
+	tests := []struct {
+		name    string
+		mapping models.ClaimMapping
+		claims  map[string]interface{}
+		expect  models.User
+	}{
+		{
+			name: "standard claims",
+			claims: map[string]interface{}{
+				"sub":            "auth0|123",
+				"email":          "test@test.com",
+				"email_verified": true,
+				"given_name":     "Test",
+				"family_name":    "User",
+			},
+			expect: models.User{Subject: "auth0|123", Email: "test@test.com", EmailVerified: true, GivenName: "Test", FamilyName: "User"},
+		},
+		{
+			name: "namespaced claims",
+			claims: map[string]interface{}{
+				"sub":                        "auth0|123",
+				namespace + "email":          "test@test.com",
+				namespace + "given_name":     "Test",
+				namespace + "roles":          []interface{}{"admin", 1, "staff"},
+				namespace + "family_name":    "User",
+				namespace + "email_verified": true,
+			},
+			expect: models.User{Subject: "auth0|123", Email: "test@test.com", EmailVerified: true, GivenName: "Test", FamilyName: "User", Roles: []string{"admin", "staff"}},
+		},
+		{
+			name: "custom mapping",
+			mapping: models.ClaimMapping{
+				Subject:   []string{"sub"},
+				Email:     []string{"upn", "mail"},
+				GivenName: []string{"first_name"},
+				Roles:     []string{"groups"},
+			},
+			claims: map[string]interface{}{
+				"sub":        "f7a3",
+				"mail":       "test@test.com",
+				"email":      "ignored@test.com",
+				"first_name": "Test",
+				"groups":     "admin staff",
+			},
+			expect: models.User{Subject: "f7a3", Email: "test@test.com", GivenName: "Test", Roles: []string{"admin", "staff"}},
+		},
+		{
+			name:   "machine token",
+			claims: map[string]interface{}{"sub": "gateway@clients", "scope": "venue:read"},
+			expect: models.User{Subject: "gateway@clients"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapping := tt.mapping
+			if mapping.Subject == nil {
+				mapping = models.NewClaimMapping(namespace)
+			}
+			if got := mapping.User(tt.claims); !reflect.DeepEqual(got, tt.expect) {
+				t.Errorf("User() = %+v, want %+v", got, tt.expect)
+			}
+		})
+	}
+}
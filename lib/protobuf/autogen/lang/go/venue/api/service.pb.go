@@ -30,6 +30,70 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type VenueEventType int32
+
+const (
+	VenueEventType_UNKNOWN                       VenueEventType = 0
+	VenueEventType_VENUE_CREATED                 VenueEventType = 1
+	VenueEventType_OPENING_HOURS_UPDATED         VenueEventType = 2
+	VenueEventType_SPECIAL_OPENING_HOURS_UPDATED VenueEventType = 3
+	VenueEventType_TABLE_ADDED                   VenueEventType = 4
+	VenueEventType_TABLE_REMOVED                 VenueEventType = 5
+	VenueEventType_ADMIN_ADDED                   VenueEventType = 6
+	VenueEventType_ADMIN_REMOVED                 VenueEventType = 7
+)
+
+// Enum value maps for VenueEventType.
+var (
+	VenueEventType_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "VENUE_CREATED",
+		2: "OPENING_HOURS_UPDATED",
+		3: "SPECIAL_OPENING_HOURS_UPDATED",
+		4: "TABLE_ADDED",
+		5: "TABLE_REMOVED",
+		6: "ADMIN_ADDED",
+		7: "ADMIN_REMOVED",
+	}
+	VenueEventType_value = map[string]int32{
+		"UNKNOWN":                       0,
+		"VENUE_CREATED":                 1,
+		"OPENING_HOURS_UPDATED":         2,
+		"SPECIAL_OPENING_HOURS_UPDATED": 3,
+		"TABLE_ADDED":                   4,
+		"TABLE_REMOVED":                 5,
+		"ADMIN_ADDED":                   6,
+		"ADMIN_REMOVED":                 7,
+	}
+)
+
+func (x VenueEventType) Enum() *VenueEventType {
+	p := new(VenueEventType)
+	*p = x
+	return p
+}
+
+func (x VenueEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VenueEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_venue_api_service_proto_enumTypes[0].Descriptor()
+}
+
+func (VenueEventType) Type() protoreflect.EnumType {
+	return &file_src_venue_api_service_proto_enumTypes[0]
+}
+
+func (x VenueEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VenueEventType.Descriptor instead.
+func (VenueEventType) EnumDescriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{0}
+}
+
 type GetVenueRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -148,16 +212,18 @@ func (x *CreateVenueRequest) GetSlug() string {
 	return ""
 }
 
-type GetTablesRequest struct {
+// batch requests are limited to 100 venue ids. ids that are given more than once are only looked up once, and venues
+// that cannot be found are left out of the response.
+type BatchGetVenuesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VenueId string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *GetTablesRequest) Reset() {
-	*x = GetTablesRequest{}
+func (x *BatchGetVenuesRequest) Reset() {
+	*x = BatchGetVenuesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_src_venue_api_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -165,13 +231,13 @@ func (x *GetTablesRequest) Reset() {
 	}
 }
 
-func (x *GetTablesRequest) String() string {
+func (x *BatchGetVenuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTablesRequest) ProtoMessage() {}
+func (*BatchGetVenuesRequest) ProtoMessage() {}
 
-func (x *GetTablesRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchGetVenuesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_venue_api_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -183,28 +249,28 @@ func (x *GetTablesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTablesRequest.ProtoReflect.Descriptor instead.
-func (*GetTablesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetVenuesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetVenuesRequest) Descriptor() ([]byte, []int) {
 	return file_src_venue_api_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetTablesRequest) GetVenueId() string {
+func (x *BatchGetVenuesRequest) GetIds() []string {
 	if x != nil {
-		return x.VenueId
+		return x.Ids
 	}
-	return ""
+	return nil
 }
 
-type GetTablesResponse struct {
+type BatchGetVenuesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tables []*models.Table `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	Venues []*models.Venue `protobuf:"bytes,1,rep,name=venues,proto3" json:"venues,omitempty"`
 }
 
-func (x *GetTablesResponse) Reset() {
-	*x = GetTablesResponse{}
+func (x *BatchGetVenuesResponse) Reset() {
+	*x = BatchGetVenuesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_src_venue_api_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -212,13 +278,13 @@ func (x *GetTablesResponse) Reset() {
 	}
 }
 
-func (x *GetTablesResponse) String() string {
+func (x *BatchGetVenuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTablesResponse) ProtoMessage() {}
+func (*BatchGetVenuesResponse) ProtoMessage() {}
 
-func (x *GetTablesResponse) ProtoReflect() protoreflect.Message {
+func (x *BatchGetVenuesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_venue_api_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -230,29 +296,28 @@ func (x *GetTablesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTablesResponse.ProtoReflect.Descriptor instead.
-func (*GetTablesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetVenuesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetVenuesResponse) Descriptor() ([]byte, []int) {
 	return file_src_venue_api_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetTablesResponse) GetTables() []*models.Table {
+func (x *BatchGetVenuesResponse) GetVenues() []*models.Venue {
 	if x != nil {
-		return x.Tables
+		return x.Venues
 	}
 	return nil
 }
 
-type GetOpeningHoursSpecificationRequest struct {
+type GetTablesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	VenueId string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
-	Date    string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
 }
 
-func (x *GetOpeningHoursSpecificationRequest) Reset() {
-	*x = GetOpeningHoursSpecificationRequest{}
+func (x *GetTablesRequest) Reset() {
+	*x = GetTablesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_src_venue_api_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -260,13 +325,13 @@ func (x *GetOpeningHoursSpecificationRequest) Reset() {
 	}
 }
 
-func (x *GetOpeningHoursSpecificationRequest) String() string {
+func (x *GetTablesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOpeningHoursSpecificationRequest) ProtoMessage() {}
+func (*GetTablesRequest) ProtoMessage() {}
 
-func (x *GetOpeningHoursSpecificationRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTablesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_venue_api_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -278,35 +343,29 @@ func (x *GetOpeningHoursSpecificationRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOpeningHoursSpecificationRequest.ProtoReflect.Descriptor instead.
-func (*GetOpeningHoursSpecificationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTablesRequest.ProtoReflect.Descriptor instead.
+func (*GetTablesRequest) Descriptor() ([]byte, []int) {
 	return file_src_venue_api_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetOpeningHoursSpecificationRequest) GetVenueId() string {
+func (x *GetTablesRequest) GetVenueId() string {
 	if x != nil {
 		return x.VenueId
 	}
 	return ""
 }
 
-func (x *GetOpeningHoursSpecificationRequest) GetDate() string {
-	if x != nil {
-		return x.Date
-	}
-	return ""
-}
-
-type GetOpeningHoursSpecificationResponse struct {
+type GetTablesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Specification *models.OpeningHoursSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification,omitempty"`
+	Tables  []*models.Table `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	Version int64           `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *GetOpeningHoursSpecificationResponse) Reset() {
-	*x = GetOpeningHoursSpecificationResponse{}
+func (x *GetTablesResponse) Reset() {
+	*x = GetTablesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_src_venue_api_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -314,13 +373,13 @@ func (x *GetOpeningHoursSpecificationResponse) Reset() {
 	}
 }
 
-func (x *GetOpeningHoursSpecificationResponse) String() string {
+func (x *GetTablesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOpeningHoursSpecificationResponse) ProtoMessage() {}
+func (*GetTablesResponse) ProtoMessage() {}
 
-func (x *GetOpeningHoursSpecificationResponse) ProtoReflect() protoreflect.Message {
+func (x *GetTablesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_venue_api_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -332,30 +391,35 @@ func (x *GetOpeningHoursSpecificationResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOpeningHoursSpecificationResponse.ProtoReflect.Descriptor instead.
-func (*GetOpeningHoursSpecificationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTablesResponse.ProtoReflect.Descriptor instead.
+func (*GetTablesResponse) Descriptor() ([]byte, []int) {
 	return file_src_venue_api_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetOpeningHoursSpecificationResponse) GetSpecification() *models.OpeningHoursSpecification {
+func (x *GetTablesResponse) GetTables() []*models.Table {
 	if x != nil {
-		return x.Specification
+		return x.Tables
 	}
 	return nil
 }
 
-type AddTableRequest struct {
+func (x *GetTablesResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetTablesBatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VenueId  string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Capacity uint32 `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	VenueIds []string `protobuf:"bytes,1,rep,name=venueIds,proto3" json:"venueIds,omitempty"`
 }
 
-func (x *AddTableRequest) Reset() {
-	*x = AddTableRequest{}
+func (x *GetTablesBatchRequest) Reset() {
+	*x = GetTablesBatchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_src_venue_api_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -363,13 +427,13 @@ func (x *AddTableRequest) Reset() {
 	}
 }
 
-func (x *AddTableRequest) String() string {
+func (x *GetTablesBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTableRequest) ProtoMessage() {}
+func (*GetTablesBatchRequest) ProtoMessage() {}
 
-func (x *AddTableRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTablesBatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_venue_api_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -381,43 +445,30 @@ func (x *AddTableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTableRequest.ProtoReflect.Descriptor instead.
-func (*AddTableRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTablesBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetTablesBatchRequest) Descriptor() ([]byte, []int) {
 	return file_src_venue_api_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *AddTableRequest) GetVenueId() string {
-	if x != nil {
-		return x.VenueId
-	}
-	return ""
-}
-
-func (x *AddTableRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *AddTableRequest) GetCapacity() uint32 {
+func (x *GetTablesBatchRequest) GetVenueIds() []string {
 	if x != nil {
-		return x.Capacity
+		return x.VenueIds
 	}
-	return 0
+	return nil
 }
 
-type RemoveTableRequest struct {
+type VenueTables struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VenueId string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
-	TableId string `protobuf:"bytes,2,opt,name=tableId,proto3" json:"tableId,omitempty"`
+	VenueId string          `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
+	Tables  []*models.Table `protobuf:"bytes,2,rep,name=tables,proto3" json:"tables,omitempty"`
+	Version int64           `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *RemoveTableRequest) Reset() {
-	*x = RemoveTableRequest{}
+func (x *VenueTables) Reset() {
+	*x = VenueTables{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_src_venue_api_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -425,13 +476,13 @@ func (x *RemoveTableRequest) Reset() {
 	}
 }
 
-func (x *RemoveTableRequest) String() string {
+func (x *VenueTables) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTableRequest) ProtoMessage() {}
+func (*VenueTables) ProtoMessage() {}
 
-func (x *RemoveTableRequest) ProtoReflect() protoreflect.Message {
+func (x *VenueTables) ProtoReflect() protoreflect.Message {
 	mi := &file_src_venue_api_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -443,37 +494,42 @@ func (x *RemoveTableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTableRequest.ProtoReflect.Descriptor instead.
-func (*RemoveTableRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VenueTables.ProtoReflect.Descriptor instead.
+func (*VenueTables) Descriptor() ([]byte, []int) {
 	return file_src_venue_api_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *RemoveTableRequest) GetVenueId() string {
+func (x *VenueTables) GetVenueId() string {
 	if x != nil {
 		return x.VenueId
 	}
 	return ""
 }
 
-func (x *RemoveTableRequest) GetTableId() string {
+func (x *VenueTables) GetTables() []*models.Table {
 	if x != nil {
-		return x.TableId
+		return x.Tables
 	}
-	return ""
+	return nil
 }
 
-type IsAdminRequest struct {
+func (x *VenueTables) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetTablesBatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VenueId string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
-	Email   string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Slug    string `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Venues []*VenueTables `protobuf:"bytes,1,rep,name=venues,proto3" json:"venues,omitempty"`
 }
 
-func (x *IsAdminRequest) Reset() {
-	*x = IsAdminRequest{}
+func (x *GetTablesBatchResponse) Reset() {
+	*x = GetTablesBatchResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_src_venue_api_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -481,13 +537,13 @@ func (x *IsAdminRequest) Reset() {
 	}
 }
 
-func (x *IsAdminRequest) String() string {
+func (x *GetTablesBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsAdminRequest) ProtoMessage() {}
+func (*GetTablesBatchResponse) ProtoMessage() {}
 
-func (x *IsAdminRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTablesBatchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_venue_api_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -499,42 +555,29 @@ func (x *IsAdminRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsAdminRequest.ProtoReflect.Descriptor instead.
-func (*IsAdminRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTablesBatchResponse.ProtoReflect.Descriptor instead.
+func (*GetTablesBatchResponse) Descriptor() ([]byte, []int) {
 	return file_src_venue_api_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *IsAdminRequest) GetVenueId() string {
-	if x != nil {
-		return x.VenueId
-	}
-	return ""
-}
-
-func (x *IsAdminRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *IsAdminRequest) GetSlug() string {
+func (x *GetTablesBatchResponse) GetVenues() []*VenueTables {
 	if x != nil {
-		return x.Slug
+		return x.Venues
 	}
-	return ""
+	return nil
 }
 
-type IsAdminResponse struct {
+type GetOpeningHoursSpecificationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsAdmin bool `protobuf:"varint,1,opt,name=isAdmin,proto3" json:"isAdmin,omitempty"`
+	VenueId string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
+	Date    string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
 }
 
-func (x *IsAdminResponse) Reset() {
-	*x = IsAdminResponse{}
+func (x *GetOpeningHoursSpecificationRequest) Reset() {
+	*x = GetOpeningHoursSpecificationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_src_venue_api_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -542,13 +585,13 @@ func (x *IsAdminResponse) Reset() {
 	}
 }
 
-func (x *IsAdminResponse) String() string {
+func (x *GetOpeningHoursSpecificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsAdminResponse) ProtoMessage() {}
+func (*GetOpeningHoursSpecificationRequest) ProtoMessage() {}
 
-func (x *IsAdminResponse) ProtoReflect() protoreflect.Message {
+func (x *GetOpeningHoursSpecificationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_venue_api_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -560,28 +603,35 @@ func (x *IsAdminResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsAdminResponse.ProtoReflect.Descriptor instead.
-func (*IsAdminResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOpeningHoursSpecificationRequest.ProtoReflect.Descriptor instead.
+func (*GetOpeningHoursSpecificationRequest) Descriptor() ([]byte, []int) {
 	return file_src_venue_api_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *IsAdminResponse) GetIsAdmin() bool {
+func (x *GetOpeningHoursSpecificationRequest) GetVenueId() string {
 	if x != nil {
-		return x.IsAdmin
+		return x.VenueId
 	}
-	return false
+	return ""
 }
 
-type GetAdminsRequest struct {
+func (x *GetOpeningHoursSpecificationRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type GetOpeningHoursSpecificationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VenueId string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
+	Specification *models.OpeningHoursSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification,omitempty"`
 }
 
-func (x *GetAdminsRequest) Reset() {
-	*x = GetAdminsRequest{}
+func (x *GetOpeningHoursSpecificationResponse) Reset() {
+	*x = GetOpeningHoursSpecificationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_src_venue_api_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -589,13 +639,13 @@ func (x *GetAdminsRequest) Reset() {
 	}
 }
 
-func (x *GetAdminsRequest) String() string {
+func (x *GetOpeningHoursSpecificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAdminsRequest) ProtoMessage() {}
+func (*GetOpeningHoursSpecificationResponse) ProtoMessage() {}
 
-func (x *GetAdminsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetOpeningHoursSpecificationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_venue_api_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -607,28 +657,29 @@ func (x *GetAdminsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAdminsRequest.ProtoReflect.Descriptor instead.
-func (*GetAdminsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOpeningHoursSpecificationResponse.ProtoReflect.Descriptor instead.
+func (*GetOpeningHoursSpecificationResponse) Descriptor() ([]byte, []int) {
 	return file_src_venue_api_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetAdminsRequest) GetVenueId() string {
+func (x *GetOpeningHoursSpecificationResponse) GetSpecification() *models.OpeningHoursSpecification {
 	if x != nil {
-		return x.VenueId
+		return x.Specification
 	}
-	return ""
+	return nil
 }
 
-type GetAdminsResponse struct {
+type GetOpeningHoursSpecificationBatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Admins []string `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	VenueIds []string `protobuf:"bytes,1,rep,name=venueIds,proto3" json:"venueIds,omitempty"`
+	Date     string   `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
 }
 
-func (x *GetAdminsResponse) Reset() {
-	*x = GetAdminsResponse{}
+func (x *GetOpeningHoursSpecificationBatchRequest) Reset() {
+	*x = GetOpeningHoursSpecificationBatchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_src_venue_api_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -636,13 +687,13 @@ func (x *GetAdminsResponse) Reset() {
 	}
 }
 
-func (x *GetAdminsResponse) String() string {
+func (x *GetOpeningHoursSpecificationBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAdminsResponse) ProtoMessage() {}
+func (*GetOpeningHoursSpecificationBatchRequest) ProtoMessage() {}
 
-func (x *GetAdminsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetOpeningHoursSpecificationBatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_venue_api_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -654,29 +705,36 @@ func (x *GetAdminsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAdminsResponse.ProtoReflect.Descriptor instead.
-func (*GetAdminsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOpeningHoursSpecificationBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetOpeningHoursSpecificationBatchRequest) Descriptor() ([]byte, []int) {
 	return file_src_venue_api_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetAdminsResponse) GetAdmins() []string {
+func (x *GetOpeningHoursSpecificationBatchRequest) GetVenueIds() []string {
 	if x != nil {
-		return x.Admins
+		return x.VenueIds
 	}
 	return nil
 }
 
-type AddAdminRequest struct {
+func (x *GetOpeningHoursSpecificationBatchRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type VenueOpeningHoursSpecification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VenueId string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
-	Email   string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	VenueId       string                            `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
+	Specification *models.OpeningHoursSpecification `protobuf:"bytes,2,opt,name=specification,proto3" json:"specification,omitempty"`
 }
 
-func (x *AddAdminRequest) Reset() {
-	*x = AddAdminRequest{}
+func (x *VenueOpeningHoursSpecification) Reset() {
+	*x = VenueOpeningHoursSpecification{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_src_venue_api_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -684,13 +742,13 @@ func (x *AddAdminRequest) Reset() {
 	}
 }
 
-func (x *AddAdminRequest) String() string {
+func (x *VenueOpeningHoursSpecification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAdminRequest) ProtoMessage() {}
+func (*VenueOpeningHoursSpecification) ProtoMessage() {}
 
-func (x *AddAdminRequest) ProtoReflect() protoreflect.Message {
+func (x *VenueOpeningHoursSpecification) ProtoReflect() protoreflect.Message {
 	mi := &file_src_venue_api_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -702,51 +760,1066 @@ func (x *AddAdminRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAdminRequest.ProtoReflect.Descriptor instead.
-func (*AddAdminRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VenueOpeningHoursSpecification.ProtoReflect.Descriptor instead.
+func (*VenueOpeningHoursSpecification) Descriptor() ([]byte, []int) {
 	return file_src_venue_api_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *AddAdminRequest) GetVenueId() string {
+func (x *VenueOpeningHoursSpecification) GetVenueId() string {
 	if x != nil {
 		return x.VenueId
 	}
 	return ""
 }
 
-func (x *AddAdminRequest) GetEmail() string {
+func (x *VenueOpeningHoursSpecification) GetSpecification() *models.OpeningHoursSpecification {
+	if x != nil {
+		return x.Specification
+	}
+	return nil
+}
+
+type GetOpeningHoursSpecificationBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// venues that are not open for business on the date are left out
+	Venues []*VenueOpeningHoursSpecification `protobuf:"bytes,1,rep,name=venues,proto3" json:"venues,omitempty"`
+}
+
+func (x *GetOpeningHoursSpecificationBatchResponse) Reset() {
+	*x = GetOpeningHoursSpecificationBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOpeningHoursSpecificationBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpeningHoursSpecificationBatchResponse) ProtoMessage() {}
+
+func (x *GetOpeningHoursSpecificationBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpeningHoursSpecificationBatchResponse.ProtoReflect.Descriptor instead.
+func (*GetOpeningHoursSpecificationBatchResponse) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetOpeningHoursSpecificationBatchResponse) GetVenues() []*VenueOpeningHoursSpecification {
+	if x != nil {
+		return x.Venues
+	}
+	return nil
+}
+
+type AddTableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VenueId  string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity uint32 `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Version  int64  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *AddTableRequest) Reset() {
+	*x = AddTableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTableRequest) ProtoMessage() {}
+
+func (x *AddTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTableRequest.ProtoReflect.Descriptor instead.
+func (*AddTableRequest) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AddTableRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *AddTableRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddTableRequest) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *AddTableRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RemoveTableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VenueId string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
+	TableId string `protobuf:"bytes,2,opt,name=tableId,proto3" json:"tableId,omitempty"`
+	Version int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *RemoveTableRequest) Reset() {
+	*x = RemoveTableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTableRequest) ProtoMessage() {}
+
+func (x *RemoveTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTableRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTableRequest) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RemoveTableRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *RemoveTableRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *RemoveTableRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type IsAdminRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VenueId string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
+	Email   string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Slug    string `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+}
+
+func (x *IsAdminRequest) Reset() {
+	*x = IsAdminRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsAdminRequest) ProtoMessage() {}
+
+func (x *IsAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsAdminRequest.ProtoReflect.Descriptor instead.
+func (*IsAdminRequest) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *IsAdminRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *IsAdminRequest) GetEmail() string {
 	if x != nil {
 		return x.Email
 	}
 	return ""
 }
 
-type AddAdminResponse struct {
+func (x *IsAdminRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type IsAdminResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsAdmin bool `protobuf:"varint,1,opt,name=isAdmin,proto3" json:"isAdmin,omitempty"`
+}
+
+func (x *IsAdminResponse) Reset() {
+	*x = IsAdminResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsAdminResponse) ProtoMessage() {}
+
+func (x *IsAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsAdminResponse.ProtoReflect.Descriptor instead.
+func (*IsAdminResponse) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *IsAdminResponse) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+type IsAdminBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VenueIds []string `protobuf:"bytes,1,rep,name=venueIds,proto3" json:"venueIds,omitempty"`
+	Email    string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *IsAdminBatchRequest) Reset() {
+	*x = IsAdminBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsAdminBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsAdminBatchRequest) ProtoMessage() {}
+
+func (x *IsAdminBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsAdminBatchRequest.ProtoReflect.Descriptor instead.
+func (*IsAdminBatchRequest) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *IsAdminBatchRequest) GetVenueIds() []string {
+	if x != nil {
+		return x.VenueIds
+	}
+	return nil
+}
+
+func (x *IsAdminBatchRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type IsAdminBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// venueIds are the venues of the request the email is an administrator of
+	VenueIds []string `protobuf:"bytes,1,rep,name=venueIds,proto3" json:"venueIds,omitempty"`
+}
+
+func (x *IsAdminBatchResponse) Reset() {
+	*x = IsAdminBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsAdminBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsAdminBatchResponse) ProtoMessage() {}
+
+func (x *IsAdminBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsAdminBatchResponse.ProtoReflect.Descriptor instead.
+func (*IsAdminBatchResponse) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *IsAdminBatchResponse) GetVenueIds() []string {
+	if x != nil {
+		return x.VenueIds
+	}
+	return nil
+}
+
+type GetAdminsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VenueId string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
+}
+
+func (x *GetAdminsRequest) Reset() {
+	*x = GetAdminsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAdminsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminsRequest) ProtoMessage() {}
+
+func (x *GetAdminsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminsRequest.ProtoReflect.Descriptor instead.
+func (*GetAdminsRequest) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetAdminsRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+type GetAdminsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Admins []string `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+}
+
+func (x *GetAdminsResponse) Reset() {
+	*x = GetAdminsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAdminsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminsResponse) ProtoMessage() {}
+
+func (x *GetAdminsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminsResponse.ProtoReflect.Descriptor instead.
+func (*GetAdminsResponse) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetAdminsResponse) GetAdmins() []string {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+type GetAdminsBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VenueIds []string `protobuf:"bytes,1,rep,name=venueIds,proto3" json:"venueIds,omitempty"`
+}
+
+func (x *GetAdminsBatchRequest) Reset() {
+	*x = GetAdminsBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAdminsBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminsBatchRequest) ProtoMessage() {}
+
+func (x *GetAdminsBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminsBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetAdminsBatchRequest) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetAdminsBatchRequest) GetVenueIds() []string {
+	if x != nil {
+		return x.VenueIds
+	}
+	return nil
+}
+
+type VenueAdmins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VenueId string   `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
+	Admins  []string `protobuf:"bytes,2,rep,name=admins,proto3" json:"admins,omitempty"`
+}
+
+func (x *VenueAdmins) Reset() {
+	*x = VenueAdmins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VenueAdmins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VenueAdmins) ProtoMessage() {}
+
+func (x *VenueAdmins) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VenueAdmins.ProtoReflect.Descriptor instead.
+func (*VenueAdmins) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VenueAdmins) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *VenueAdmins) GetAdmins() []string {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+type GetAdminsBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Venues []*VenueAdmins `protobuf:"bytes,1,rep,name=venues,proto3" json:"venues,omitempty"`
+}
+
+func (x *GetAdminsBatchResponse) Reset() {
+	*x = GetAdminsBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAdminsBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminsBatchResponse) ProtoMessage() {}
+
+func (x *GetAdminsBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminsBatchResponse.ProtoReflect.Descriptor instead.
+func (*GetAdminsBatchResponse) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetAdminsBatchResponse) GetVenues() []*VenueAdmins {
+	if x != nil {
+		return x.Venues
+	}
+	return nil
+}
+
+type AddAdminRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VenueId string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
+	Email   string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *AddAdminRequest) Reset() {
+	*x = AddAdminRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAdminRequest) ProtoMessage() {}
+
+func (x *AddAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAdminRequest.ProtoReflect.Descriptor instead.
+func (*AddAdminRequest) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AddAdminRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *AddAdminRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type AddAdminResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VenueId string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
+	Email   string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *AddAdminResponse) Reset() {
+	*x = AddAdminResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAdminResponse) ProtoMessage() {}
+
+func (x *AddAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAdminResponse.ProtoReflect.Descriptor instead.
+func (*AddAdminResponse) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AddAdminResponse) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *AddAdminResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RemoveAdminRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VenueId string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
+	Email   string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *RemoveAdminRequest) Reset() {
+	*x = RemoveAdminRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAdminRequest) ProtoMessage() {}
+
+func (x *RemoveAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAdminRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAdminRequest) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RemoveAdminRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *RemoveAdminRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RemoveAdminResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *RemoveAdminResponse) Reset() {
+	*x = RemoveAdminResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAdminResponse) ProtoMessage() {}
+
+func (x *RemoveAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAdminResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAdminResponse) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RemoveAdminResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type CreateApiKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VenueId string            `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
+	Name    string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role    models.ApiKeyRole `protobuf:"varint,3,opt,name=role,proto3,enum=venue.models.ApiKeyRole" json:"role,omitempty"`
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateApiKeyRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetRole() models.ApiKeyRole {
+	if x != nil {
+		return x.Role
+	}
+	return models.ApiKeyRole_UNKNOWN_ROLE
+}
+
+type CreateApiKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKey *models.ApiKey `protobuf:"bytes,1,opt,name=apiKey,proto3" json:"apiKey,omitempty"`
+	// key is the secret itself. only its hash is stored, so it cannot be returned again
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *CreateApiKeyResponse) Reset() {
+	*x = CreateApiKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyResponse) ProtoMessage() {}
+
+func (x *CreateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateApiKeyResponse) GetApiKey() *models.ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateApiKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetApiKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VenueId string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
+}
+
+func (x *GetApiKeysRequest) Reset() {
+	*x = GetApiKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetApiKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApiKeysRequest) ProtoMessage() {}
+
+func (x *GetApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*GetApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetApiKeysRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+type GetApiKeysResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VenueId string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
-	Email   string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	ApiKeys []*models.ApiKey `protobuf:"bytes,1,rep,name=apiKeys,proto3" json:"apiKeys,omitempty"`
 }
 
-func (x *AddAdminResponse) Reset() {
-	*x = AddAdminResponse{}
+func (x *GetApiKeysResponse) Reset() {
+	*x = GetApiKeysResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_src_venue_api_service_proto_msgTypes[13]
+		mi := &file_src_venue_api_service_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddAdminResponse) String() string {
+func (x *GetApiKeysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAdminResponse) ProtoMessage() {}
+func (*GetApiKeysResponse) ProtoMessage() {}
 
-func (x *AddAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_venue_api_service_proto_msgTypes[13]
+func (x *GetApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -757,51 +1830,44 @@ func (x *AddAdminResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAdminResponse.ProtoReflect.Descriptor instead.
-func (*AddAdminResponse) Descriptor() ([]byte, []int) {
-	return file_src_venue_api_service_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *AddAdminResponse) GetVenueId() string {
-	if x != nil {
-		return x.VenueId
-	}
-	return ""
+// Deprecated: Use GetApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*GetApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *AddAdminResponse) GetEmail() string {
+func (x *GetApiKeysResponse) GetApiKeys() []*models.ApiKey {
 	if x != nil {
-		return x.Email
+		return x.ApiKeys
 	}
-	return ""
+	return nil
 }
 
-type RemoveAdminRequest struct {
+type RevokeApiKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	VenueId string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
-	Email   string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *RemoveAdminRequest) Reset() {
-	*x = RemoveAdminRequest{}
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_src_venue_api_service_proto_msgTypes[14]
+		mi := &file_src_venue_api_service_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveAdminRequest) String() string {
+func (x *RevokeApiKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveAdminRequest) ProtoMessage() {}
+func (*RevokeApiKeyRequest) ProtoMessage() {}
 
-func (x *RemoveAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_venue_api_service_proto_msgTypes[14]
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,50 +1878,50 @@ func (x *RemoveAdminRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveAdminRequest.ProtoReflect.Descriptor instead.
-func (*RemoveAdminRequest) Descriptor() ([]byte, []int) {
-	return file_src_venue_api_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *RemoveAdminRequest) GetVenueId() string {
+func (x *RevokeApiKeyRequest) GetVenueId() string {
 	if x != nil {
 		return x.VenueId
 	}
 	return ""
 }
 
-func (x *RemoveAdminRequest) GetEmail() string {
+func (x *RevokeApiKeyRequest) GetId() string {
 	if x != nil {
-		return x.Email
+		return x.Id
 	}
 	return ""
 }
 
-type RemoveAdminResponse struct {
+type VerifyApiKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *RemoveAdminResponse) Reset() {
-	*x = RemoveAdminResponse{}
+func (x *VerifyApiKeyRequest) Reset() {
+	*x = VerifyApiKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_src_venue_api_service_proto_msgTypes[15]
+		mi := &file_src_venue_api_service_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveAdminResponse) String() string {
+func (x *VerifyApiKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveAdminResponse) ProtoMessage() {}
+func (*VerifyApiKeyRequest) ProtoMessage() {}
 
-func (x *RemoveAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_venue_api_service_proto_msgTypes[15]
+func (x *VerifyApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -866,14 +1932,14 @@ func (x *RemoveAdminResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveAdminResponse.ProtoReflect.Descriptor instead.
-func (*RemoveAdminResponse) Descriptor() ([]byte, []int) {
-	return file_src_venue_api_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use VerifyApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*VerifyApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *RemoveAdminResponse) GetEmail() string {
+func (x *VerifyApiKeyRequest) GetKey() string {
 	if x != nil {
-		return x.Email
+		return x.Key
 	}
 	return ""
 }
@@ -885,12 +1951,13 @@ type UpdateOpeningHoursRequest struct {
 
 	VenueId      string                              `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
 	OpeningHours []*models.OpeningHoursSpecification `protobuf:"bytes,2,rep,name=openingHours,proto3" json:"openingHours,omitempty"`
+	Version      int64                               `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *UpdateOpeningHoursRequest) Reset() {
 	*x = UpdateOpeningHoursRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_src_venue_api_service_proto_msgTypes[16]
+		mi := &file_src_venue_api_service_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -903,7 +1970,7 @@ func (x *UpdateOpeningHoursRequest) String() string {
 func (*UpdateOpeningHoursRequest) ProtoMessage() {}
 
 func (x *UpdateOpeningHoursRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_venue_api_service_proto_msgTypes[16]
+	mi := &file_src_venue_api_service_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -916,7 +1983,7 @@ func (x *UpdateOpeningHoursRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOpeningHoursRequest.ProtoReflect.Descriptor instead.
 func (*UpdateOpeningHoursRequest) Descriptor() ([]byte, []int) {
-	return file_src_venue_api_service_proto_rawDescGZIP(), []int{16}
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *UpdateOpeningHoursRequest) GetVenueId() string {
@@ -933,18 +2000,26 @@ func (x *UpdateOpeningHoursRequest) GetOpeningHours() []*models.OpeningHoursSpec
 	return nil
 }
 
+func (x *UpdateOpeningHoursRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateOpeningHoursResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	OpeningHours []*models.OpeningHoursSpecification `protobuf:"bytes,1,rep,name=openingHours,proto3" json:"openingHours,omitempty"`
+	Version      int64                               `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *UpdateOpeningHoursResponse) Reset() {
 	*x = UpdateOpeningHoursResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_src_venue_api_service_proto_msgTypes[17]
+		mi := &file_src_venue_api_service_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -957,7 +2032,7 @@ func (x *UpdateOpeningHoursResponse) String() string {
 func (*UpdateOpeningHoursResponse) ProtoMessage() {}
 
 func (x *UpdateOpeningHoursResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_venue_api_service_proto_msgTypes[17]
+	mi := &file_src_venue_api_service_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -970,7 +2045,7 @@ func (x *UpdateOpeningHoursResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOpeningHoursResponse.ProtoReflect.Descriptor instead.
 func (*UpdateOpeningHoursResponse) Descriptor() ([]byte, []int) {
-	return file_src_venue_api_service_proto_rawDescGZIP(), []int{17}
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *UpdateOpeningHoursResponse) GetOpeningHours() []*models.OpeningHoursSpecification {
@@ -980,6 +2055,218 @@ func (x *UpdateOpeningHoursResponse) GetOpeningHours() []*models.OpeningHoursSpe
 	return nil
 }
 
+func (x *UpdateOpeningHoursResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type WatchVenueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VenueId string `protobuf:"bytes,1,opt,name=venueId,proto3" json:"venueId,omitempty"`
+	Cursor  string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *WatchVenueRequest) Reset() {
+	*x = WatchVenueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchVenueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchVenueRequest) ProtoMessage() {}
+
+func (x *WatchVenueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchVenueRequest.ProtoReflect.Descriptor instead.
+func (*WatchVenueRequest) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WatchVenueRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *WatchVenueRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type WatchVenuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *WatchVenuesRequest) Reset() {
+	*x = WatchVenuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchVenuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchVenuesRequest) ProtoMessage() {}
+
+func (x *WatchVenuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchVenuesRequest.ProtoReflect.Descriptor instead.
+func (*WatchVenuesRequest) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *WatchVenuesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type VenueEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor       string                              `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	VenueId      string                              `protobuf:"bytes,2,opt,name=venueId,proto3" json:"venueId,omitempty"`
+	Type         VenueEventType                      `protobuf:"varint,3,opt,name=type,proto3,enum=venue.api.VenueEventType" json:"type,omitempty"`
+	Version      int64                               `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	OccurredAt   string                              `protobuf:"bytes,5,opt,name=occurredAt,proto3" json:"occurredAt,omitempty"`
+	OpeningHours []*models.OpeningHoursSpecification `protobuf:"bytes,6,rep,name=openingHours,proto3" json:"openingHours,omitempty"`
+	Table        *models.Table                       `protobuf:"bytes,7,opt,name=table,proto3" json:"table,omitempty"`
+	Email        string                              `protobuf:"bytes,8,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *VenueEvent) Reset() {
+	*x = VenueEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_venue_api_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VenueEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VenueEvent) ProtoMessage() {}
+
+func (x *VenueEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_src_venue_api_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VenueEvent.ProtoReflect.Descriptor instead.
+func (*VenueEvent) Descriptor() ([]byte, []int) {
+	return file_src_venue_api_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VenueEvent) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *VenueEvent) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *VenueEvent) GetType() VenueEventType {
+	if x != nil {
+		return x.Type
+	}
+	return VenueEventType_UNKNOWN
+}
+
+func (x *VenueEvent) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *VenueEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *VenueEvent) GetOpeningHours() []*models.OpeningHoursSpecification {
+	if x != nil {
+		return x.OpeningHours
+	}
+	return nil
+}
+
+func (x *VenueEvent) GetTable() *models.Table {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *VenueEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
 var File_src_venue_api_service_proto protoreflect.FileDescriptor
 
 var file_src_venue_api_service_proto_rawDesc = []byte{
@@ -999,147 +2286,341 @@ var file_src_venue_api_service_proto_rawDesc = []byte{
 	0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69,
 	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e,
 	0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x2c, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
-	0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x23, 0x47, 0x65,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x29, 0x0a, 0x15, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x45, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
+	0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56,
+	0x65, 0x6e, 0x75, 0x65, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x33, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x73, 0x22, 0x6e, 0x0a, 0x0b, 0x56,
+	0x65, 0x6e, 0x75, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e,
+	0x75, 0x65, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x06, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x75, 0x0a, 0x24, 0x47, 0x65,
 	0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65,
-	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22,
-	0x75, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75,
-	0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x73, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
-	0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e,
-	0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75,
-	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63,
-	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63,
-	0x69, 0x74, 0x79, 0x22, 0x48, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e,
-	0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75,
-	0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x54, 0x0a,
-	0x0e, 0x49, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73,
-	0x6c, 0x75, 0x67, 0x22, 0x2b, 0x0a, 0x0f, 0x49, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x22, 0x2c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x22, 0x2b,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x22, 0x41, 0x0a, 0x0f, 0x41,
-	0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x42,
-	0x0a, 0x10, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x48, 0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x5a, 0x0a, 0x28, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48,
+	0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x89, 0x01,
+	0x0a, 0x1e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f,
+	0x75, 0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x0d, 0x73, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6e, 0x0a, 0x29, 0x47, 0x65, 0x74,
+	0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48,
+	0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x22, 0x75, 0x0a, 0x0f, 0x41, 0x64, 0x64,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x62, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x0e, 0x49, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x2b, 0x0a, 0x0f, 0x49, 0x73,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x47, 0x0a, 0x13, 0x49, 0x73, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x22, 0x32, 0x0a, 0x14, 0x49, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x49, 0x64, 0x73, 0x22, 0x2c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x49, 0x64, 0x22, 0x2b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x22,
+	0x33, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x49, 0x64, 0x73, 0x22, 0x3f, 0x0a, 0x0b, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x73, 0x22, 0x48, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x65, 0x6e, 0x75,
+	0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x22,
+	0x41, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
 	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x22, 0x44, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75,
-	0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65,
-	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x2b, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x82, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x12, 0x4b, 0x0a,
-	0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x53,
-	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x69, 0x0a, 0x1a, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
-	0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67,
-	0x48, 0x6f, 0x75, 0x72, 0x73, 0x32, 0xbd, 0x07, 0x0a, 0x08, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41,
-	0x50, 0x49, 0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x1a,
-	0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65,
-	0x6e, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x65, 0x6e,
-	0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x12,
-	0x41, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x1d,
-	0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
-	0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x65, 0x6e,
-	0x75, 0x65, 0x12, 0x61, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x6e,
-	0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x69,
-	0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
-	0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75,
-	0x72, 0x73, 0x12, 0x24, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x69, 0x6c, 0x22, 0x42, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x44, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x2b, 0x0a, 0x13,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x71, 0x0a, 0x13, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c,
+	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x56, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x22, 0x2d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65,
+	0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e,
+	0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x61, 0x70, 0x69,
+	0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x65, 0x6e,
+	0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x52, 0x07, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x3f, 0x0a, 0x13, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x13, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x22, 0x9c, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x0c, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f,
+	0x75, 0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x45, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0x2c, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xb5, 0x02,
+	0x0a, 0x0a, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x12, 0x2d,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x63, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48,
+	0x6f, 0x75, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2a, 0xb6, 0x01, 0x0a, 0x0e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x5f, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x50, 0x45, 0x4e,
+	0x49, 0x4e, 0x47, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x50, 0x45, 0x43, 0x49, 0x41, 0x4c, 0x5f, 0x4f,
+	0x50, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x53, 0x5f, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f,
+	0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x41, 0x42, 0x4c, 0x45,
+	0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x44,
+	0x4d, 0x49, 0x4e, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x41,
+	0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x07, 0x32, 0xd8,
+	0x0e, 0x0a, 0x08, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x50, 0x49, 0x12, 0x3b, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x1a, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x1d, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x61, 0x0a, 0x12, 0x55,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x69,
-	0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x7f, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75,
-	0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x2e, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4f,
-	0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2f, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4f,
+	0x73, 0x12, 0x24, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68,
+	0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x4f,
+	0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4f,
 	0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x46, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1b, 0x2e,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48,
+	0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48,
+	0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x8e, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48,
+	0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x33, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75,
+	0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x46, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1b,
+	0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20, 0x2e, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
 	0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x65, 0x6e,
-	0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x41, 0x64, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x13, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x49, 0x73, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x12, 0x19, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x49, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x73, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x41, 0x64,
-	0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1a, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
-	0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x46, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x76,
-	0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x65, 0x6e, 0x75,
-	0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52,
+	0x6c, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x41, 0x0a,
+	0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x40, 0x0a, 0x07, 0x49, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x19, 0x2e, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x49, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x49, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x1e, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49,
+	0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49,
+	0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12,
+	0x1a, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x20, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76,
 	0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1d, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70,
 	0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x4d, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x62, 0x62, 0x69, 0x6e, 0x6d, 0x61, 0x2f, 0x62, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x6c, 0x69,
-	0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x67,
-	0x65, 0x6e, 0x2f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x65, 0x6e, 0x75, 0x65,
-	0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69,
+	0x4b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65,
+	0x79, 0x12, 0x1e, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x44, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x43, 0x0a,
+	0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x1c, 0x2e, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x6e,
+	0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x30, 0x01, 0x12, 0x45, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x6e, 0x75, 0x65,
+	0x73, 0x12, 0x1d, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x65, 0x6e,
+	0x75, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x4d, 0x5a, 0x4b, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x62, 0x62, 0x69, 0x6e, 0x6d, 0x61,
+	0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x2f, 0x6c, 0x69, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61,
+	0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x67, 0x6f, 0x2f, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1154,65 +2635,125 @@ func file_src_venue_api_service_proto_rawDescGZIP() []byte {
 	return file_src_venue_api_service_proto_rawDescData
 }
 
-var file_src_venue_api_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_src_venue_api_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_src_venue_api_service_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_src_venue_api_service_proto_goTypes = []interface{}{
-	(*GetVenueRequest)(nil),                      // 0: venue.api.GetVenueRequest
-	(*CreateVenueRequest)(nil),                   // 1: venue.api.CreateVenueRequest
-	(*GetTablesRequest)(nil),                     // 2: venue.api.GetTablesRequest
-	(*GetTablesResponse)(nil),                    // 3: venue.api.GetTablesResponse
-	(*GetOpeningHoursSpecificationRequest)(nil),  // 4: venue.api.GetOpeningHoursSpecificationRequest
-	(*GetOpeningHoursSpecificationResponse)(nil), // 5: venue.api.GetOpeningHoursSpecificationResponse
-	(*AddTableRequest)(nil),                      // 6: venue.api.AddTableRequest
-	(*RemoveTableRequest)(nil),                   // 7: venue.api.RemoveTableRequest
-	(*IsAdminRequest)(nil),                       // 8: venue.api.IsAdminRequest
-	(*IsAdminResponse)(nil),                      // 9: venue.api.IsAdminResponse
-	(*GetAdminsRequest)(nil),                     // 10: venue.api.GetAdminsRequest
-	(*GetAdminsResponse)(nil),                    // 11: venue.api.GetAdminsResponse
-	(*AddAdminRequest)(nil),                      // 12: venue.api.AddAdminRequest
-	(*AddAdminResponse)(nil),                     // 13: venue.api.AddAdminResponse
-	(*RemoveAdminRequest)(nil),                   // 14: venue.api.RemoveAdminRequest
-	(*RemoveAdminResponse)(nil),                  // 15: venue.api.RemoveAdminResponse
-	(*UpdateOpeningHoursRequest)(nil),            // 16: venue.api.UpdateOpeningHoursRequest
-	(*UpdateOpeningHoursResponse)(nil),           // 17: venue.api.UpdateOpeningHoursResponse
-	(*models.OpeningHoursSpecification)(nil),     // 18: venue.models.OpeningHoursSpecification
-	(*models.Table)(nil),                         // 19: venue.models.Table
-	(*models.Venue)(nil),                         // 20: venue.models.Venue
+	(VenueEventType)(0),                               // 0: venue.api.VenueEventType
+	(*GetVenueRequest)(nil),                           // 1: venue.api.GetVenueRequest
+	(*CreateVenueRequest)(nil),                        // 2: venue.api.CreateVenueRequest
+	(*BatchGetVenuesRequest)(nil),                     // 3: venue.api.BatchGetVenuesRequest
+	(*BatchGetVenuesResponse)(nil),                    // 4: venue.api.BatchGetVenuesResponse
+	(*GetTablesRequest)(nil),                          // 5: venue.api.GetTablesRequest
+	(*GetTablesResponse)(nil),                         // 6: venue.api.GetTablesResponse
+	(*GetTablesBatchRequest)(nil),                     // 7: venue.api.GetTablesBatchRequest
+	(*VenueTables)(nil),                               // 8: venue.api.VenueTables
+	(*GetTablesBatchResponse)(nil),                    // 9: venue.api.GetTablesBatchResponse
+	(*GetOpeningHoursSpecificationRequest)(nil),       // 10: venue.api.GetOpeningHoursSpecificationRequest
+	(*GetOpeningHoursSpecificationResponse)(nil),      // 11: venue.api.GetOpeningHoursSpecificationResponse
+	(*GetOpeningHoursSpecificationBatchRequest)(nil),  // 12: venue.api.GetOpeningHoursSpecificationBatchRequest
+	(*VenueOpeningHoursSpecification)(nil),            // 13: venue.api.VenueOpeningHoursSpecification
+	(*GetOpeningHoursSpecificationBatchResponse)(nil), // 14: venue.api.GetOpeningHoursSpecificationBatchResponse
+	(*AddTableRequest)(nil),                           // 15: venue.api.AddTableRequest
+	(*RemoveTableRequest)(nil),                        // 16: venue.api.RemoveTableRequest
+	(*IsAdminRequest)(nil),                            // 17: venue.api.IsAdminRequest
+	(*IsAdminResponse)(nil),                           // 18: venue.api.IsAdminResponse
+	(*IsAdminBatchRequest)(nil),                       // 19: venue.api.IsAdminBatchRequest
+	(*IsAdminBatchResponse)(nil),                      // 20: venue.api.IsAdminBatchResponse
+	(*GetAdminsRequest)(nil),                          // 21: venue.api.GetAdminsRequest
+	(*GetAdminsResponse)(nil),                         // 22: venue.api.GetAdminsResponse
+	(*GetAdminsBatchRequest)(nil),                     // 23: venue.api.GetAdminsBatchRequest
+	(*VenueAdmins)(nil),                               // 24: venue.api.VenueAdmins
+	(*GetAdminsBatchResponse)(nil),                    // 25: venue.api.GetAdminsBatchResponse
+	(*AddAdminRequest)(nil),                           // 26: venue.api.AddAdminRequest
+	(*AddAdminResponse)(nil),                          // 27: venue.api.AddAdminResponse
+	(*RemoveAdminRequest)(nil),                        // 28: venue.api.RemoveAdminRequest
+	(*RemoveAdminResponse)(nil),                       // 29: venue.api.RemoveAdminResponse
+	(*CreateApiKeyRequest)(nil),                       // 30: venue.api.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),                      // 31: venue.api.CreateApiKeyResponse
+	(*GetApiKeysRequest)(nil),                         // 32: venue.api.GetApiKeysRequest
+	(*GetApiKeysResponse)(nil),                        // 33: venue.api.GetApiKeysResponse
+	(*RevokeApiKeyRequest)(nil),                       // 34: venue.api.RevokeApiKeyRequest
+	(*VerifyApiKeyRequest)(nil),                       // 35: venue.api.VerifyApiKeyRequest
+	(*UpdateOpeningHoursRequest)(nil),                 // 36: venue.api.UpdateOpeningHoursRequest
+	(*UpdateOpeningHoursResponse)(nil),                // 37: venue.api.UpdateOpeningHoursResponse
+	(*WatchVenueRequest)(nil),                         // 38: venue.api.WatchVenueRequest
+	(*WatchVenuesRequest)(nil),                        // 39: venue.api.WatchVenuesRequest
+	(*VenueEvent)(nil),                                // 40: venue.api.VenueEvent
+	(*models.OpeningHoursSpecification)(nil),          // 41: venue.models.OpeningHoursSpecification
+	(*models.Venue)(nil),                              // 42: venue.models.Venue
+	(*models.Table)(nil),                              // 43: venue.models.Table
+	(models.ApiKeyRole)(0),                            // 44: venue.models.ApiKeyRole
+	(*models.ApiKey)(nil),                             // 45: venue.models.ApiKey
 }
 var file_src_venue_api_service_proto_depIdxs = []int32{
-	18, // 0: venue.api.CreateVenueRequest.openingHours:type_name -> venue.models.OpeningHoursSpecification
-	19, // 1: venue.api.GetTablesResponse.tables:type_name -> venue.models.Table
-	18, // 2: venue.api.GetOpeningHoursSpecificationResponse.specification:type_name -> venue.models.OpeningHoursSpecification
-	18, // 3: venue.api.UpdateOpeningHoursRequest.openingHours:type_name -> venue.models.OpeningHoursSpecification
-	18, // 4: venue.api.UpdateOpeningHoursResponse.openingHours:type_name -> venue.models.OpeningHoursSpecification
-	0,  // 5: venue.api.VenueAPI.GetVenue:input_type -> venue.api.GetVenueRequest
-	1,  // 6: venue.api.VenueAPI.CreateVenue:input_type -> venue.api.CreateVenueRequest
-	16, // 7: venue.api.VenueAPI.UpdateOpeningHours:input_type -> venue.api.UpdateOpeningHoursRequest
-	16, // 8: venue.api.VenueAPI.UpdateSpecialOpeningHours:input_type -> venue.api.UpdateOpeningHoursRequest
-	4,  // 9: venue.api.VenueAPI.GetOpeningHoursSpecification:input_type -> venue.api.GetOpeningHoursSpecificationRequest
-	2,  // 10: venue.api.VenueAPI.GetTables:input_type -> venue.api.GetTablesRequest
-	6,  // 11: venue.api.VenueAPI.AddTable:input_type -> venue.api.AddTableRequest
-	7,  // 12: venue.api.VenueAPI.RemoveTable:input_type -> venue.api.RemoveTableRequest
-	8,  // 13: venue.api.VenueAPI.IsAdmin:input_type -> venue.api.IsAdminRequest
-	12, // 14: venue.api.VenueAPI.AddAdmin:input_type -> venue.api.AddAdminRequest
-	10, // 15: venue.api.VenueAPI.GetAdmins:input_type -> venue.api.GetAdminsRequest
-	14, // 16: venue.api.VenueAPI.RemoveAdmin:input_type -> venue.api.RemoveAdminRequest
-	20, // 17: venue.api.VenueAPI.GetVenue:output_type -> venue.models.Venue
-	20, // 18: venue.api.VenueAPI.CreateVenue:output_type -> venue.models.Venue
-	17, // 19: venue.api.VenueAPI.UpdateOpeningHours:output_type -> venue.api.UpdateOpeningHoursResponse
-	17, // 20: venue.api.VenueAPI.UpdateSpecialOpeningHours:output_type -> venue.api.UpdateOpeningHoursResponse
-	5,  // 21: venue.api.VenueAPI.GetOpeningHoursSpecification:output_type -> venue.api.GetOpeningHoursSpecificationResponse
-	3,  // 22: venue.api.VenueAPI.GetTables:output_type -> venue.api.GetTablesResponse
-	19, // 23: venue.api.VenueAPI.AddTable:output_type -> venue.models.Table
-	19, // 24: venue.api.VenueAPI.RemoveTable:output_type -> venue.models.Table
-	9,  // 25: venue.api.VenueAPI.IsAdmin:output_type -> venue.api.IsAdminResponse
-	13, // 26: venue.api.VenueAPI.AddAdmin:output_type -> venue.api.AddAdminResponse
-	11, // 27: venue.api.VenueAPI.GetAdmins:output_type -> venue.api.GetAdminsResponse
-	15, // 28: venue.api.VenueAPI.RemoveAdmin:output_type -> venue.api.RemoveAdminResponse
-	17, // [17:29] is the sub-list for method output_type
-	5,  // [5:17] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	41, // 0: venue.api.CreateVenueRequest.openingHours:type_name -> venue.models.OpeningHoursSpecification
+	42, // 1: venue.api.BatchGetVenuesResponse.venues:type_name -> venue.models.Venue
+	43, // 2: venue.api.GetTablesResponse.tables:type_name -> venue.models.Table
+	43, // 3: venue.api.VenueTables.tables:type_name -> venue.models.Table
+	8,  // 4: venue.api.GetTablesBatchResponse.venues:type_name -> venue.api.VenueTables
+	41, // 5: venue.api.GetOpeningHoursSpecificationResponse.specification:type_name -> venue.models.OpeningHoursSpecification
+	41, // 6: venue.api.VenueOpeningHoursSpecification.specification:type_name -> venue.models.OpeningHoursSpecification
+	13, // 7: venue.api.GetOpeningHoursSpecificationBatchResponse.venues:type_name -> venue.api.VenueOpeningHoursSpecification
+	24, // 8: venue.api.GetAdminsBatchResponse.venues:type_name -> venue.api.VenueAdmins
+	44, // 9: venue.api.CreateApiKeyRequest.role:type_name -> venue.models.ApiKeyRole
+	45, // 10: venue.api.CreateApiKeyResponse.apiKey:type_name -> venue.models.ApiKey
+	45, // 11: venue.api.GetApiKeysResponse.apiKeys:type_name -> venue.models.ApiKey
+	41, // 12: venue.api.UpdateOpeningHoursRequest.openingHours:type_name -> venue.models.OpeningHoursSpecification
+	41, // 13: venue.api.UpdateOpeningHoursResponse.openingHours:type_name -> venue.models.OpeningHoursSpecification
+	0,  // 14: venue.api.VenueEvent.type:type_name -> venue.api.VenueEventType
+	41, // 15: venue.api.VenueEvent.openingHours:type_name -> venue.models.OpeningHoursSpecification
+	43, // 16: venue.api.VenueEvent.table:type_name -> venue.models.Table
+	1,  // 17: venue.api.VenueAPI.GetVenue:input_type -> venue.api.GetVenueRequest
+	2,  // 18: venue.api.VenueAPI.CreateVenue:input_type -> venue.api.CreateVenueRequest
+	36, // 19: venue.api.VenueAPI.UpdateOpeningHours:input_type -> venue.api.UpdateOpeningHoursRequest
+	36, // 20: venue.api.VenueAPI.UpdateSpecialOpeningHours:input_type -> venue.api.UpdateOpeningHoursRequest
+	10, // 21: venue.api.VenueAPI.GetOpeningHoursSpecification:input_type -> venue.api.GetOpeningHoursSpecificationRequest
+	3,  // 22: venue.api.VenueAPI.BatchGetVenues:input_type -> venue.api.BatchGetVenuesRequest
+	12, // 23: venue.api.VenueAPI.GetOpeningHoursSpecificationBatch:input_type -> venue.api.GetOpeningHoursSpecificationBatchRequest
+	5,  // 24: venue.api.VenueAPI.GetTables:input_type -> venue.api.GetTablesRequest
+	7,  // 25: venue.api.VenueAPI.GetTablesBatch:input_type -> venue.api.GetTablesBatchRequest
+	15, // 26: venue.api.VenueAPI.AddTable:input_type -> venue.api.AddTableRequest
+	16, // 27: venue.api.VenueAPI.RemoveTable:input_type -> venue.api.RemoveTableRequest
+	17, // 28: venue.api.VenueAPI.IsAdmin:input_type -> venue.api.IsAdminRequest
+	19, // 29: venue.api.VenueAPI.IsAdminBatch:input_type -> venue.api.IsAdminBatchRequest
+	26, // 30: venue.api.VenueAPI.AddAdmin:input_type -> venue.api.AddAdminRequest
+	21, // 31: venue.api.VenueAPI.GetAdmins:input_type -> venue.api.GetAdminsRequest
+	23, // 32: venue.api.VenueAPI.GetAdminsBatch:input_type -> venue.api.GetAdminsBatchRequest
+	28, // 33: venue.api.VenueAPI.RemoveAdmin:input_type -> venue.api.RemoveAdminRequest
+	30, // 34: venue.api.VenueAPI.CreateApiKey:input_type -> venue.api.CreateApiKeyRequest
+	32, // 35: venue.api.VenueAPI.GetApiKeys:input_type -> venue.api.GetApiKeysRequest
+	34, // 36: venue.api.VenueAPI.RevokeApiKey:input_type -> venue.api.RevokeApiKeyRequest
+	35, // 37: venue.api.VenueAPI.VerifyApiKey:input_type -> venue.api.VerifyApiKeyRequest
+	38, // 38: venue.api.VenueAPI.WatchVenue:input_type -> venue.api.WatchVenueRequest
+	39, // 39: venue.api.VenueAPI.WatchVenues:input_type -> venue.api.WatchVenuesRequest
+	42, // 40: venue.api.VenueAPI.GetVenue:output_type -> venue.models.Venue
+	42, // 41: venue.api.VenueAPI.CreateVenue:output_type -> venue.models.Venue
+	37, // 42: venue.api.VenueAPI.UpdateOpeningHours:output_type -> venue.api.UpdateOpeningHoursResponse
+	37, // 43: venue.api.VenueAPI.UpdateSpecialOpeningHours:output_type -> venue.api.UpdateOpeningHoursResponse
+	11, // 44: venue.api.VenueAPI.GetOpeningHoursSpecification:output_type -> venue.api.GetOpeningHoursSpecificationResponse
+	4,  // 45: venue.api.VenueAPI.BatchGetVenues:output_type -> venue.api.BatchGetVenuesResponse
+	14, // 46: venue.api.VenueAPI.GetOpeningHoursSpecificationBatch:output_type -> venue.api.GetOpeningHoursSpecificationBatchResponse
+	6,  // 47: venue.api.VenueAPI.GetTables:output_type -> venue.api.GetTablesResponse
+	9,  // 48: venue.api.VenueAPI.GetTablesBatch:output_type -> venue.api.GetTablesBatchResponse
+	43, // 49: venue.api.VenueAPI.AddTable:output_type -> venue.models.Table
+	43, // 50: venue.api.VenueAPI.RemoveTable:output_type -> venue.models.Table
+	18, // 51: venue.api.VenueAPI.IsAdmin:output_type -> venue.api.IsAdminResponse
+	20, // 52: venue.api.VenueAPI.IsAdminBatch:output_type -> venue.api.IsAdminBatchResponse
+	27, // 53: venue.api.VenueAPI.AddAdmin:output_type -> venue.api.AddAdminResponse
+	22, // 54: venue.api.VenueAPI.GetAdmins:output_type -> venue.api.GetAdminsResponse
+	25, // 55: venue.api.VenueAPI.GetAdminsBatch:output_type -> venue.api.GetAdminsBatchResponse
+	29, // 56: venue.api.VenueAPI.RemoveAdmin:output_type -> venue.api.RemoveAdminResponse
+	31, // 57: venue.api.VenueAPI.CreateApiKey:output_type -> venue.api.CreateApiKeyResponse
+	33, // 58: venue.api.VenueAPI.GetApiKeys:output_type -> venue.api.GetApiKeysResponse
+	45, // 59: venue.api.VenueAPI.RevokeApiKey:output_type -> venue.models.ApiKey
+	45, // 60: venue.api.VenueAPI.VerifyApiKey:output_type -> venue.models.ApiKey
+	40, // 61: venue.api.VenueAPI.WatchVenue:output_type -> venue.api.VenueEvent
+	40, // 62: venue.api.VenueAPI.WatchVenues:output_type -> venue.api.VenueEvent
+	40, // [40:63] is the sub-list for method output_type
+	17, // [17:40] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_src_venue_api_service_proto_init() }
@@ -1233,8 +2774,44 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
-		file_src_venue_api_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateVenueRequest); i {
+		file_src_venue_api_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVenueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetVenuesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetVenuesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTablesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1245,8 +2822,8 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
-		file_src_venue_api_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTablesRequest); i {
+		file_src_venue_api_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTablesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1257,8 +2834,8 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
-		file_src_venue_api_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTablesResponse); i {
+		file_src_venue_api_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTablesBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1269,7 +2846,31 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
-		file_src_venue_api_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_src_venue_api_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VenueTables); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTablesBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetOpeningHoursSpecificationRequest); i {
 			case 0:
 				return &v.state
@@ -1281,7 +2882,7 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
-		file_src_venue_api_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_src_venue_api_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetOpeningHoursSpecificationResponse); i {
 			case 0:
 				return &v.state
@@ -1293,7 +2894,43 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
-		file_src_venue_api_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_src_venue_api_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOpeningHoursSpecificationBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VenueOpeningHoursSpecification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOpeningHoursSpecificationBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddTableRequest); i {
 			case 0:
 				return &v.state
@@ -1305,7 +2942,7 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
-		file_src_venue_api_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_src_venue_api_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveTableRequest); i {
 			case 0:
 				return &v.state
@@ -1317,7 +2954,7 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
-		file_src_venue_api_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_src_venue_api_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IsAdminRequest); i {
 			case 0:
 				return &v.state
@@ -1329,7 +2966,7 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
-		file_src_venue_api_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_src_venue_api_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IsAdminResponse); i {
 			case 0:
 				return &v.state
@@ -1341,7 +2978,31 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
-		file_src_venue_api_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_src_venue_api_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsAdminBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsAdminBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAdminsRequest); i {
 			case 0:
 				return &v.state
@@ -1353,7 +3014,7 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
-		file_src_venue_api_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_src_venue_api_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAdminsResponse); i {
 			case 0:
 				return &v.state
@@ -1365,7 +3026,43 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
-		file_src_venue_api_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_src_venue_api_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAdminsBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VenueAdmins); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAdminsBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddAdminRequest); i {
 			case 0:
 				return &v.state
@@ -1377,7 +3074,7 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
-		file_src_venue_api_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_src_venue_api_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddAdminResponse); i {
 			case 0:
 				return &v.state
@@ -1389,7 +3086,7 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
-		file_src_venue_api_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_src_venue_api_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveAdminRequest); i {
 			case 0:
 				return &v.state
@@ -1401,7 +3098,7 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
-		file_src_venue_api_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_src_venue_api_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveAdminResponse); i {
 			case 0:
 				return &v.state
@@ -1413,7 +3110,79 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
-		file_src_venue_api_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_src_venue_api_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateApiKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateApiKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApiKeysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApiKeysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeApiKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyApiKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateOpeningHoursRequest); i {
 			case 0:
 				return &v.state
@@ -1425,7 +3194,7 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
-		file_src_venue_api_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_src_venue_api_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateOpeningHoursResponse); i {
 			case 0:
 				return &v.state
@@ -1437,19 +3206,56 @@ func file_src_venue_api_service_proto_init() {
 				return nil
 			}
 		}
+		file_src_venue_api_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchVenueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchVenuesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_venue_api_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VenueEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_src_venue_api_service_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   18,
+			NumEnums:      1,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_src_venue_api_service_proto_goTypes,
 		DependencyIndexes: file_src_venue_api_service_proto_depIdxs,
+		EnumInfos:         file_src_venue_api_service_proto_enumTypes,
 		MessageInfos:      file_src_venue_api_service_proto_msgTypes,
 	}.Build()
 	File_src_venue_api_service_proto = out.File
@@ -1475,13 +3281,24 @@ type VenueAPIClient interface {
 	UpdateOpeningHours(ctx context.Context, in *UpdateOpeningHoursRequest, opts ...grpc.CallOption) (*UpdateOpeningHoursResponse, error)
 	UpdateSpecialOpeningHours(ctx context.Context, in *UpdateOpeningHoursRequest, opts ...grpc.CallOption) (*UpdateOpeningHoursResponse, error)
 	GetOpeningHoursSpecification(ctx context.Context, in *GetOpeningHoursSpecificationRequest, opts ...grpc.CallOption) (*GetOpeningHoursSpecificationResponse, error)
+	BatchGetVenues(ctx context.Context, in *BatchGetVenuesRequest, opts ...grpc.CallOption) (*BatchGetVenuesResponse, error)
+	GetOpeningHoursSpecificationBatch(ctx context.Context, in *GetOpeningHoursSpecificationBatchRequest, opts ...grpc.CallOption) (*GetOpeningHoursSpecificationBatchResponse, error)
 	GetTables(ctx context.Context, in *GetTablesRequest, opts ...grpc.CallOption) (*GetTablesResponse, error)
+	GetTablesBatch(ctx context.Context, in *GetTablesBatchRequest, opts ...grpc.CallOption) (*GetTablesBatchResponse, error)
 	AddTable(ctx context.Context, in *AddTableRequest, opts ...grpc.CallOption) (*models.Table, error)
 	RemoveTable(ctx context.Context, in *RemoveTableRequest, opts ...grpc.CallOption) (*models.Table, error)
 	IsAdmin(ctx context.Context, in *IsAdminRequest, opts ...grpc.CallOption) (*IsAdminResponse, error)
+	IsAdminBatch(ctx context.Context, in *IsAdminBatchRequest, opts ...grpc.CallOption) (*IsAdminBatchResponse, error)
 	AddAdmin(ctx context.Context, in *AddAdminRequest, opts ...grpc.CallOption) (*AddAdminResponse, error)
 	GetAdmins(ctx context.Context, in *GetAdminsRequest, opts ...grpc.CallOption) (*GetAdminsResponse, error)
+	GetAdminsBatch(ctx context.Context, in *GetAdminsBatchRequest, opts ...grpc.CallOption) (*GetAdminsBatchResponse, error)
 	RemoveAdmin(ctx context.Context, in *RemoveAdminRequest, opts ...grpc.CallOption) (*RemoveAdminResponse, error)
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	GetApiKeys(ctx context.Context, in *GetApiKeysRequest, opts ...grpc.CallOption) (*GetApiKeysResponse, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*models.ApiKey, error)
+	VerifyApiKey(ctx context.Context, in *VerifyApiKeyRequest, opts ...grpc.CallOption) (*models.ApiKey, error)
+	WatchVenue(ctx context.Context, in *WatchVenueRequest, opts ...grpc.CallOption) (VenueAPI_WatchVenueClient, error)
+	WatchVenues(ctx context.Context, in *WatchVenuesRequest, opts ...grpc.CallOption) (VenueAPI_WatchVenuesClient, error)
 }
 
 type venueAPIClient struct {
@@ -1510,36 +3327,63 @@ func (c *venueAPIClient) CreateVenue(ctx context.Context, in *CreateVenueRequest
 	return out, nil
 }
 
-func (c *venueAPIClient) UpdateOpeningHours(ctx context.Context, in *UpdateOpeningHoursRequest, opts ...grpc.CallOption) (*UpdateOpeningHoursResponse, error) {
-	out := new(UpdateOpeningHoursResponse)
-	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/UpdateOpeningHours", in, out, opts...)
+func (c *venueAPIClient) UpdateOpeningHours(ctx context.Context, in *UpdateOpeningHoursRequest, opts ...grpc.CallOption) (*UpdateOpeningHoursResponse, error) {
+	out := new(UpdateOpeningHoursResponse)
+	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/UpdateOpeningHours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueAPIClient) UpdateSpecialOpeningHours(ctx context.Context, in *UpdateOpeningHoursRequest, opts ...grpc.CallOption) (*UpdateOpeningHoursResponse, error) {
+	out := new(UpdateOpeningHoursResponse)
+	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/UpdateSpecialOpeningHours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueAPIClient) GetOpeningHoursSpecification(ctx context.Context, in *GetOpeningHoursSpecificationRequest, opts ...grpc.CallOption) (*GetOpeningHoursSpecificationResponse, error) {
+	out := new(GetOpeningHoursSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/GetOpeningHoursSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueAPIClient) BatchGetVenues(ctx context.Context, in *BatchGetVenuesRequest, opts ...grpc.CallOption) (*BatchGetVenuesResponse, error) {
+	out := new(BatchGetVenuesResponse)
+	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/BatchGetVenues", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *venueAPIClient) UpdateSpecialOpeningHours(ctx context.Context, in *UpdateOpeningHoursRequest, opts ...grpc.CallOption) (*UpdateOpeningHoursResponse, error) {
-	out := new(UpdateOpeningHoursResponse)
-	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/UpdateSpecialOpeningHours", in, out, opts...)
+func (c *venueAPIClient) GetOpeningHoursSpecificationBatch(ctx context.Context, in *GetOpeningHoursSpecificationBatchRequest, opts ...grpc.CallOption) (*GetOpeningHoursSpecificationBatchResponse, error) {
+	out := new(GetOpeningHoursSpecificationBatchResponse)
+	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/GetOpeningHoursSpecificationBatch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *venueAPIClient) GetOpeningHoursSpecification(ctx context.Context, in *GetOpeningHoursSpecificationRequest, opts ...grpc.CallOption) (*GetOpeningHoursSpecificationResponse, error) {
-	out := new(GetOpeningHoursSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/GetOpeningHoursSpecification", in, out, opts...)
+func (c *venueAPIClient) GetTables(ctx context.Context, in *GetTablesRequest, opts ...grpc.CallOption) (*GetTablesResponse, error) {
+	out := new(GetTablesResponse)
+	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/GetTables", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *venueAPIClient) GetTables(ctx context.Context, in *GetTablesRequest, opts ...grpc.CallOption) (*GetTablesResponse, error) {
-	out := new(GetTablesResponse)
-	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/GetTables", in, out, opts...)
+func (c *venueAPIClient) GetTablesBatch(ctx context.Context, in *GetTablesBatchRequest, opts ...grpc.CallOption) (*GetTablesBatchResponse, error) {
+	out := new(GetTablesBatchResponse)
+	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/GetTablesBatch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1573,6 +3417,15 @@ func (c *venueAPIClient) IsAdmin(ctx context.Context, in *IsAdminRequest, opts .
 	return out, nil
 }
 
+func (c *venueAPIClient) IsAdminBatch(ctx context.Context, in *IsAdminBatchRequest, opts ...grpc.CallOption) (*IsAdminBatchResponse, error) {
+	out := new(IsAdminBatchResponse)
+	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/IsAdminBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *venueAPIClient) AddAdmin(ctx context.Context, in *AddAdminRequest, opts ...grpc.CallOption) (*AddAdminResponse, error) {
 	out := new(AddAdminResponse)
 	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/AddAdmin", in, out, opts...)
@@ -1591,6 +3444,15 @@ func (c *venueAPIClient) GetAdmins(ctx context.Context, in *GetAdminsRequest, op
 	return out, nil
 }
 
+func (c *venueAPIClient) GetAdminsBatch(ctx context.Context, in *GetAdminsBatchRequest, opts ...grpc.CallOption) (*GetAdminsBatchResponse, error) {
+	out := new(GetAdminsBatchResponse)
+	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/GetAdminsBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *venueAPIClient) RemoveAdmin(ctx context.Context, in *RemoveAdminRequest, opts ...grpc.CallOption) (*RemoveAdminResponse, error) {
 	out := new(RemoveAdminResponse)
 	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/RemoveAdmin", in, out, opts...)
@@ -1600,6 +3462,106 @@ func (c *venueAPIClient) RemoveAdmin(ctx context.Context, in *RemoveAdminRequest
 	return out, nil
 }
 
+func (c *venueAPIClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/CreateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueAPIClient) GetApiKeys(ctx context.Context, in *GetApiKeysRequest, opts ...grpc.CallOption) (*GetApiKeysResponse, error) {
+	out := new(GetApiKeysResponse)
+	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/GetApiKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueAPIClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*models.ApiKey, error) {
+	out := new(models.ApiKey)
+	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/RevokeApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueAPIClient) VerifyApiKey(ctx context.Context, in *VerifyApiKeyRequest, opts ...grpc.CallOption) (*models.ApiKey, error) {
+	out := new(models.ApiKey)
+	err := c.cc.Invoke(ctx, "/venue.api.VenueAPI/VerifyApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueAPIClient) WatchVenue(ctx context.Context, in *WatchVenueRequest, opts ...grpc.CallOption) (VenueAPI_WatchVenueClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_VenueAPI_serviceDesc.Streams[0], "/venue.api.VenueAPI/WatchVenue", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &venueAPIWatchVenueClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VenueAPI_WatchVenueClient interface {
+	Recv() (*VenueEvent, error)
+	grpc.ClientStream
+}
+
+type venueAPIWatchVenueClient struct {
+	grpc.ClientStream
+}
+
+func (x *venueAPIWatchVenueClient) Recv() (*VenueEvent, error) {
+	m := new(VenueEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *venueAPIClient) WatchVenues(ctx context.Context, in *WatchVenuesRequest, opts ...grpc.CallOption) (VenueAPI_WatchVenuesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_VenueAPI_serviceDesc.Streams[1], "/venue.api.VenueAPI/WatchVenues", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &venueAPIWatchVenuesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VenueAPI_WatchVenuesClient interface {
+	Recv() (*VenueEvent, error)
+	grpc.ClientStream
+}
+
+type venueAPIWatchVenuesClient struct {
+	grpc.ClientStream
+}
+
+func (x *venueAPIWatchVenuesClient) Recv() (*VenueEvent, error) {
+	m := new(VenueEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // VenueAPIServer is the server API for VenueAPI service.
 type VenueAPIServer interface {
 	GetVenue(context.Context, *GetVenueRequest) (*models.Venue, error)
@@ -1607,13 +3569,24 @@ type VenueAPIServer interface {
 	UpdateOpeningHours(context.Context, *UpdateOpeningHoursRequest) (*UpdateOpeningHoursResponse, error)
 	UpdateSpecialOpeningHours(context.Context, *UpdateOpeningHoursRequest) (*UpdateOpeningHoursResponse, error)
 	GetOpeningHoursSpecification(context.Context, *GetOpeningHoursSpecificationRequest) (*GetOpeningHoursSpecificationResponse, error)
+	BatchGetVenues(context.Context, *BatchGetVenuesRequest) (*BatchGetVenuesResponse, error)
+	GetOpeningHoursSpecificationBatch(context.Context, *GetOpeningHoursSpecificationBatchRequest) (*GetOpeningHoursSpecificationBatchResponse, error)
 	GetTables(context.Context, *GetTablesRequest) (*GetTablesResponse, error)
+	GetTablesBatch(context.Context, *GetTablesBatchRequest) (*GetTablesBatchResponse, error)
 	AddTable(context.Context, *AddTableRequest) (*models.Table, error)
 	RemoveTable(context.Context, *RemoveTableRequest) (*models.Table, error)
 	IsAdmin(context.Context, *IsAdminRequest) (*IsAdminResponse, error)
+	IsAdminBatch(context.Context, *IsAdminBatchRequest) (*IsAdminBatchResponse, error)
 	AddAdmin(context.Context, *AddAdminRequest) (*AddAdminResponse, error)
 	GetAdmins(context.Context, *GetAdminsRequest) (*GetAdminsResponse, error)
+	GetAdminsBatch(context.Context, *GetAdminsBatchRequest) (*GetAdminsBatchResponse, error)
 	RemoveAdmin(context.Context, *RemoveAdminRequest) (*RemoveAdminResponse, error)
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	GetApiKeys(context.Context, *GetApiKeysRequest) (*GetApiKeysResponse, error)
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*models.ApiKey, error)
+	VerifyApiKey(context.Context, *VerifyApiKeyRequest) (*models.ApiKey, error)
+	WatchVenue(*WatchVenueRequest, VenueAPI_WatchVenueServer) error
+	WatchVenues(*WatchVenuesRequest, VenueAPI_WatchVenuesServer) error
 }
 
 // UnimplementedVenueAPIServer can be embedded to have forward compatible implementations.
@@ -1635,9 +3608,18 @@ func (*UnimplementedVenueAPIServer) UpdateSpecialOpeningHours(context.Context, *
 func (*UnimplementedVenueAPIServer) GetOpeningHoursSpecification(context.Context, *GetOpeningHoursSpecificationRequest) (*GetOpeningHoursSpecificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOpeningHoursSpecification not implemented")
 }
+func (*UnimplementedVenueAPIServer) BatchGetVenues(context.Context, *BatchGetVenuesRequest) (*BatchGetVenuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetVenues not implemented")
+}
+func (*UnimplementedVenueAPIServer) GetOpeningHoursSpecificationBatch(context.Context, *GetOpeningHoursSpecificationBatchRequest) (*GetOpeningHoursSpecificationBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpeningHoursSpecificationBatch not implemented")
+}
 func (*UnimplementedVenueAPIServer) GetTables(context.Context, *GetTablesRequest) (*GetTablesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTables not implemented")
 }
+func (*UnimplementedVenueAPIServer) GetTablesBatch(context.Context, *GetTablesBatchRequest) (*GetTablesBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTablesBatch not implemented")
+}
 func (*UnimplementedVenueAPIServer) AddTable(context.Context, *AddTableRequest) (*models.Table, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddTable not implemented")
 }
@@ -1647,15 +3629,39 @@ func (*UnimplementedVenueAPIServer) RemoveTable(context.Context, *RemoveTableReq
 func (*UnimplementedVenueAPIServer) IsAdmin(context.Context, *IsAdminRequest) (*IsAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsAdmin not implemented")
 }
+func (*UnimplementedVenueAPIServer) IsAdminBatch(context.Context, *IsAdminBatchRequest) (*IsAdminBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsAdminBatch not implemented")
+}
 func (*UnimplementedVenueAPIServer) AddAdmin(context.Context, *AddAdminRequest) (*AddAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddAdmin not implemented")
 }
 func (*UnimplementedVenueAPIServer) GetAdmins(context.Context, *GetAdminsRequest) (*GetAdminsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAdmins not implemented")
 }
+func (*UnimplementedVenueAPIServer) GetAdminsBatch(context.Context, *GetAdminsBatchRequest) (*GetAdminsBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdminsBatch not implemented")
+}
 func (*UnimplementedVenueAPIServer) RemoveAdmin(context.Context, *RemoveAdminRequest) (*RemoveAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveAdmin not implemented")
 }
+func (*UnimplementedVenueAPIServer) CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (*UnimplementedVenueAPIServer) GetApiKeys(context.Context, *GetApiKeysRequest) (*GetApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApiKeys not implemented")
+}
+func (*UnimplementedVenueAPIServer) RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*models.ApiKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (*UnimplementedVenueAPIServer) VerifyApiKey(context.Context, *VerifyApiKeyRequest) (*models.ApiKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyApiKey not implemented")
+}
+func (*UnimplementedVenueAPIServer) WatchVenue(*WatchVenueRequest, VenueAPI_WatchVenueServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchVenue not implemented")
+}
+func (*UnimplementedVenueAPIServer) WatchVenues(*WatchVenuesRequest, VenueAPI_WatchVenuesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchVenues not implemented")
+}
 
 func RegisterVenueAPIServer(s *grpc.Server, srv VenueAPIServer) {
 	s.RegisterService(&_VenueAPI_serviceDesc, srv)
@@ -1751,6 +3757,42 @@ func _VenueAPI_GetOpeningHoursSpecification_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VenueAPI_BatchGetVenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetVenuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueAPIServer).BatchGetVenues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/venue.api.VenueAPI/BatchGetVenues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueAPIServer).BatchGetVenues(ctx, req.(*BatchGetVenuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueAPI_GetOpeningHoursSpecificationBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpeningHoursSpecificationBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueAPIServer).GetOpeningHoursSpecificationBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/venue.api.VenueAPI/GetOpeningHoursSpecificationBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueAPIServer).GetOpeningHoursSpecificationBatch(ctx, req.(*GetOpeningHoursSpecificationBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VenueAPI_GetTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTablesRequest)
 	if err := dec(in); err != nil {
@@ -1769,6 +3811,24 @@ func _VenueAPI_GetTables_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VenueAPI_GetTablesBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTablesBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueAPIServer).GetTablesBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/venue.api.VenueAPI/GetTablesBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueAPIServer).GetTablesBatch(ctx, req.(*GetTablesBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VenueAPI_AddTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddTableRequest)
 	if err := dec(in); err != nil {
@@ -1823,6 +3883,24 @@ func _VenueAPI_IsAdmin_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VenueAPI_IsAdminBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsAdminBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueAPIServer).IsAdminBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/venue.api.VenueAPI/IsAdminBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueAPIServer).IsAdminBatch(ctx, req.(*IsAdminBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VenueAPI_AddAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddAdminRequest)
 	if err := dec(in); err != nil {
@@ -1859,6 +3937,24 @@ func _VenueAPI_GetAdmins_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VenueAPI_GetAdminsBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdminsBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueAPIServer).GetAdminsBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/venue.api.VenueAPI/GetAdminsBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueAPIServer).GetAdminsBatch(ctx, req.(*GetAdminsBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VenueAPI_RemoveAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveAdminRequest)
 	if err := dec(in); err != nil {
@@ -1877,6 +3973,120 @@ func _VenueAPI_RemoveAdmin_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VenueAPI_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueAPIServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/venue.api.VenueAPI/CreateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueAPIServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueAPI_GetApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueAPIServer).GetApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/venue.api.VenueAPI/GetApiKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueAPIServer).GetApiKeys(ctx, req.(*GetApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueAPI_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueAPIServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/venue.api.VenueAPI/RevokeApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueAPIServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueAPI_VerifyApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueAPIServer).VerifyApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/venue.api.VenueAPI/VerifyApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueAPIServer).VerifyApiKey(ctx, req.(*VerifyApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueAPI_WatchVenue_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchVenueRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VenueAPIServer).WatchVenue(m, &venueAPIWatchVenueServer{stream})
+}
+
+type VenueAPI_WatchVenueServer interface {
+	Send(*VenueEvent) error
+	grpc.ServerStream
+}
+
+type venueAPIWatchVenueServer struct {
+	grpc.ServerStream
+}
+
+func (x *venueAPIWatchVenueServer) Send(m *VenueEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VenueAPI_WatchVenues_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchVenuesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VenueAPIServer).WatchVenues(m, &venueAPIWatchVenuesServer{stream})
+}
+
+type VenueAPI_WatchVenuesServer interface {
+	Send(*VenueEvent) error
+	grpc.ServerStream
+}
+
+type venueAPIWatchVenuesServer struct {
+	grpc.ServerStream
+}
+
+func (x *venueAPIWatchVenuesServer) Send(m *VenueEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _VenueAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "venue.api.VenueAPI",
 	HandlerType: (*VenueAPIServer)(nil),
@@ -1901,10 +4111,22 @@ var _VenueAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetOpeningHoursSpecification",
 			Handler:    _VenueAPI_GetOpeningHoursSpecification_Handler,
 		},
+		{
+			MethodName: "BatchGetVenues",
+			Handler:    _VenueAPI_BatchGetVenues_Handler,
+		},
+		{
+			MethodName: "GetOpeningHoursSpecificationBatch",
+			Handler:    _VenueAPI_GetOpeningHoursSpecificationBatch_Handler,
+		},
 		{
 			MethodName: "GetTables",
 			Handler:    _VenueAPI_GetTables_Handler,
 		},
+		{
+			MethodName: "GetTablesBatch",
+			Handler:    _VenueAPI_GetTablesBatch_Handler,
+		},
 		{
 			MethodName: "AddTable",
 			Handler:    _VenueAPI_AddTable_Handler,
@@ -1917,6 +4139,10 @@ var _VenueAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IsAdmin",
 			Handler:    _VenueAPI_IsAdmin_Handler,
 		},
+		{
+			MethodName: "IsAdminBatch",
+			Handler:    _VenueAPI_IsAdminBatch_Handler,
+		},
 		{
 			MethodName: "AddAdmin",
 			Handler:    _VenueAPI_AddAdmin_Handler,
@@ -1925,11 +4151,42 @@ var _VenueAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAdmins",
 			Handler:    _VenueAPI_GetAdmins_Handler,
 		},
+		{
+			MethodName: "GetAdminsBatch",
+			Handler:    _VenueAPI_GetAdminsBatch_Handler,
+		},
 		{
 			MethodName: "RemoveAdmin",
 			Handler:    _VenueAPI_RemoveAdmin_Handler,
 		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _VenueAPI_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "GetApiKeys",
+			Handler:    _VenueAPI_GetApiKeys_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _VenueAPI_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "VerifyApiKey",
+			Handler:    _VenueAPI_VerifyApiKey_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchVenue",
+			Handler:       _VenueAPI_WatchVenue_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchVenues",
+			Handler:       _VenueAPI_WatchVenues_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "src/venue/api/service.proto",
 }
@@ -15,6 +15,16 @@ pub struct CreateVenueRequest {
     pub slug: ::prost::alloc::string::String,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BatchGetVenuesRequest {
+    #[prost(string, repeated, tag = "1")]
+    pub ids: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BatchGetVenuesResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub venues: ::prost::alloc::vec::Vec<super::models::Venue>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
 pub struct GetTablesRequest {
     #[prost(string, tag = "1")]
     pub venue_id: ::prost::alloc::string::String,
@@ -23,6 +33,27 @@ pub struct GetTablesRequest {
 pub struct GetTablesResponse {
     #[prost(message, repeated, tag = "1")]
     pub tables: ::prost::alloc::vec::Vec<super::models::Table>,
+    #[prost(int64, tag = "2")]
+    pub version: i64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetTablesBatchRequest {
+    #[prost(string, repeated, tag = "1")]
+    pub venue_ids: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct VenueTables {
+    #[prost(string, tag = "1")]
+    pub venue_id: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag = "2")]
+    pub tables: ::prost::alloc::vec::Vec<super::models::Table>,
+    #[prost(int64, tag = "3")]
+    pub version: i64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetTablesBatchResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub venues: ::prost::alloc::vec::Vec<VenueTables>,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct GetOpeningHoursSpecificationRequest {
@@ -37,6 +68,25 @@ pub struct GetOpeningHoursSpecificationResponse {
     pub specification: ::core::option::Option<super::models::OpeningHoursSpecification>,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetOpeningHoursSpecificationBatchRequest {
+    #[prost(string, repeated, tag = "1")]
+    pub venue_ids: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    #[prost(string, tag = "2")]
+    pub date: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct VenueOpeningHoursSpecification {
+    #[prost(string, tag = "1")]
+    pub venue_id: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "2")]
+    pub specification: ::core::option::Option<super::models::OpeningHoursSpecification>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetOpeningHoursSpecificationBatchResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub venues: ::prost::alloc::vec::Vec<VenueOpeningHoursSpecification>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
 pub struct AddTableRequest {
     #[prost(string, tag = "1")]
     pub venue_id: ::prost::alloc::string::String,
@@ -44,6 +94,8 @@ pub struct AddTableRequest {
     pub name: ::prost::alloc::string::String,
     #[prost(uint32, tag = "3")]
     pub capacity: u32,
+    #[prost(int64, tag = "4")]
+    pub version: i64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct RemoveTableRequest {
@@ -51,6 +103,8 @@ pub struct RemoveTableRequest {
     pub venue_id: ::prost::alloc::string::String,
     #[prost(string, tag = "2")]
     pub table_id: ::prost::alloc::string::String,
+    #[prost(int64, tag = "3")]
+    pub version: i64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct IsAdminRequest {
@@ -67,6 +121,18 @@ pub struct IsAdminResponse {
     pub is_admin: bool,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
+pub struct IsAdminBatchRequest {
+    #[prost(string, repeated, tag = "1")]
+    pub venue_ids: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    #[prost(string, tag = "2")]
+    pub email: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct IsAdminBatchResponse {
+    #[prost(string, repeated, tag = "1")]
+    pub venue_ids: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
 pub struct GetAdminsRequest {
     #[prost(string, tag = "1")]
     pub venue_id: ::prost::alloc::string::String,
@@ -77,6 +143,23 @@ pub struct GetAdminsResponse {
     pub admins: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetAdminsBatchRequest {
+    #[prost(string, repeated, tag = "1")]
+    pub venue_ids: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct VenueAdmins {
+    #[prost(string, tag = "1")]
+    pub venue_id: ::prost::alloc::string::String,
+    #[prost(string, repeated, tag = "2")]
+    pub admins: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetAdminsBatchResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub venues: ::prost::alloc::vec::Vec<VenueAdmins>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
 pub struct AddAdminRequest {
     #[prost(string, tag = "1")]
     pub venue_id: ::prost::alloc::string::String,
@@ -103,16 +186,101 @@ pub struct RemoveAdminResponse {
     pub email: ::prost::alloc::string::String,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CreateApiKeyRequest {
+    #[prost(string, tag = "1")]
+    pub venue_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub name: ::prost::alloc::string::String,
+    #[prost(enumeration = "super::models::ApiKeyRole", tag = "3")]
+    pub role: i32,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CreateApiKeyResponse {
+    #[prost(message, optional, tag = "1")]
+    pub api_key: ::core::option::Option<super::models::ApiKey>,
+    #[prost(string, tag = "2")]
+    pub key: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetApiKeysRequest {
+    #[prost(string, tag = "1")]
+    pub venue_id: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetApiKeysResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub api_keys: ::prost::alloc::vec::Vec<super::models::ApiKey>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RevokeApiKeyRequest {
+    #[prost(string, tag = "1")]
+    pub venue_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub id: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct VerifyApiKeyRequest {
+    #[prost(string, tag = "1")]
+    pub key: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
 pub struct UpdateOpeningHoursRequest {
     #[prost(string, tag = "1")]
     pub venue_id: ::prost::alloc::string::String,
     #[prost(message, repeated, tag = "2")]
     pub opening_hours: ::prost::alloc::vec::Vec<super::models::OpeningHoursSpecification>,
+    #[prost(int64, tag = "3")]
+    pub version: i64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct UpdateOpeningHoursResponse {
     #[prost(message, repeated, tag = "1")]
     pub opening_hours: ::prost::alloc::vec::Vec<super::models::OpeningHoursSpecification>,
+    #[prost(int64, tag = "2")]
+    pub version: i64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WatchVenueRequest {
+    #[prost(string, tag = "1")]
+    pub venue_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub cursor: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WatchVenuesRequest {
+    #[prost(string, tag = "1")]
+    pub cursor: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct VenueEvent {
+    #[prost(string, tag = "1")]
+    pub cursor: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub venue_id: ::prost::alloc::string::String,
+    #[prost(enumeration = "VenueEventType", tag = "3")]
+    pub r#type: i32,
+    #[prost(int64, tag = "4")]
+    pub version: i64,
+    #[prost(string, tag = "5")]
+    pub occurred_at: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag = "6")]
+    pub opening_hours: ::prost::alloc::vec::Vec<super::models::OpeningHoursSpecification>,
+    #[prost(message, optional, tag = "7")]
+    pub table: ::core::option::Option<super::models::Table>,
+    #[prost(string, tag = "8")]
+    pub email: ::prost::alloc::string::String,
+}
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum VenueEventType {
+    Unknown = 0,
+    VenueCreated = 1,
+    OpeningHoursUpdated = 2,
+    SpecialOpeningHoursUpdated = 3,
+    TableAdded = 4,
+    TableRemoved = 5,
+    AdminAdded = 6,
+    AdminRemoved = 7,
 }
 #[doc = r" Generated client implementations."]
 pub mod venue_api_client {
@@ -223,6 +391,37 @@ pub mod venue_api_client {
             );
             self.inner.unary(request.into_request(), path, codec).await
         }
+        pub async fn batch_get_venues(
+            &mut self,
+            request: impl tonic::IntoRequest<super::BatchGetVenuesRequest>,
+        ) -> Result<tonic::Response<super::BatchGetVenuesResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/venue.api.VenueAPI/BatchGetVenues");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn get_opening_hours_specification_batch(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetOpeningHoursSpecificationBatchRequest>,
+        ) -> Result<tonic::Response<super::GetOpeningHoursSpecificationBatchResponse>, tonic::Status>
+        {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/venue.api.VenueAPI/GetOpeningHoursSpecificationBatch",
+            );
+            self.inner.unary(request.into_request(), path, codec).await
+        }
         pub async fn get_tables(
             &mut self,
             request: impl tonic::IntoRequest<super::GetTablesRequest>,
@@ -237,6 +436,20 @@ pub mod venue_api_client {
             let path = http::uri::PathAndQuery::from_static("/venue.api.VenueAPI/GetTables");
             self.inner.unary(request.into_request(), path, codec).await
         }
+        pub async fn get_tables_batch(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetTablesBatchRequest>,
+        ) -> Result<tonic::Response<super::GetTablesBatchResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/venue.api.VenueAPI/GetTablesBatch");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
         pub async fn add_table(
             &mut self,
             request: impl tonic::IntoRequest<super::AddTableRequest>,
@@ -279,6 +492,20 @@ pub mod venue_api_client {
             let path = http::uri::PathAndQuery::from_static("/venue.api.VenueAPI/IsAdmin");
             self.inner.unary(request.into_request(), path, codec).await
         }
+        pub async fn is_admin_batch(
+            &mut self,
+            request: impl tonic::IntoRequest<super::IsAdminBatchRequest>,
+        ) -> Result<tonic::Response<super::IsAdminBatchResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/venue.api.VenueAPI/IsAdminBatch");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
         pub async fn add_admin(
             &mut self,
             request: impl tonic::IntoRequest<super::AddAdminRequest>,
@@ -307,6 +534,20 @@ pub mod venue_api_client {
             let path = http::uri::PathAndQuery::from_static("/venue.api.VenueAPI/GetAdmins");
             self.inner.unary(request.into_request(), path, codec).await
         }
+        pub async fn get_admins_batch(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetAdminsBatchRequest>,
+        ) -> Result<tonic::Response<super::GetAdminsBatchResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/venue.api.VenueAPI/GetAdminsBatch");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
         pub async fn remove_admin(
             &mut self,
             request: impl tonic::IntoRequest<super::RemoveAdminRequest>,
@@ -321,6 +562,96 @@ pub mod venue_api_client {
             let path = http::uri::PathAndQuery::from_static("/venue.api.VenueAPI/RemoveAdmin");
             self.inner.unary(request.into_request(), path, codec).await
         }
+        pub async fn create_api_key(
+            &mut self,
+            request: impl tonic::IntoRequest<super::CreateApiKeyRequest>,
+        ) -> Result<tonic::Response<super::CreateApiKeyResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/venue.api.VenueAPI/CreateApiKey");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn get_api_keys(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetApiKeysRequest>,
+        ) -> Result<tonic::Response<super::GetApiKeysResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/venue.api.VenueAPI/GetApiKeys");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn revoke_api_key(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RevokeApiKeyRequest>,
+        ) -> Result<tonic::Response<super::super::models::ApiKey>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/venue.api.VenueAPI/RevokeApiKey");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn verify_api_key(
+            &mut self,
+            request: impl tonic::IntoRequest<super::VerifyApiKeyRequest>,
+        ) -> Result<tonic::Response<super::super::models::ApiKey>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/venue.api.VenueAPI/VerifyApiKey");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn watch_venue(
+            &mut self,
+            request: impl tonic::IntoRequest<super::WatchVenueRequest>,
+        ) -> Result<tonic::Response<tonic::codec::Streaming<super::VenueEvent>>, tonic::Status>
+        {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/venue.api.VenueAPI/WatchVenue");
+            self.inner
+                .server_streaming(request.into_request(), path, codec)
+                .await
+        }
+        pub async fn watch_venues(
+            &mut self,
+            request: impl tonic::IntoRequest<super::WatchVenuesRequest>,
+        ) -> Result<tonic::Response<tonic::codec::Streaming<super::VenueEvent>>, tonic::Status>
+        {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/venue.api.VenueAPI/WatchVenues");
+            self.inner
+                .server_streaming(request.into_request(), path, codec)
+                .await
+        }
     }
     impl<T: Clone> Clone for VenueApiClient<T> {
         fn clone(&self) -> Self {
@@ -362,10 +693,22 @@ pub mod venue_api_server {
             &self,
             request: tonic::Request<super::GetOpeningHoursSpecificationRequest>,
         ) -> Result<tonic::Response<super::GetOpeningHoursSpecificationResponse>, tonic::Status>;
+        async fn batch_get_venues(
+            &self,
+            request: tonic::Request<super::BatchGetVenuesRequest>,
+        ) -> Result<tonic::Response<super::BatchGetVenuesResponse>, tonic::Status>;
+        async fn get_opening_hours_specification_batch(
+            &self,
+            request: tonic::Request<super::GetOpeningHoursSpecificationBatchRequest>,
+        ) -> Result<tonic::Response<super::GetOpeningHoursSpecificationBatchResponse>, tonic::Status>;
         async fn get_tables(
             &self,
             request: tonic::Request<super::GetTablesRequest>,
         ) -> Result<tonic::Response<super::GetTablesResponse>, tonic::Status>;
+        async fn get_tables_batch(
+            &self,
+            request: tonic::Request<super::GetTablesBatchRequest>,
+        ) -> Result<tonic::Response<super::GetTablesBatchResponse>, tonic::Status>;
         async fn add_table(
             &self,
             request: tonic::Request<super::AddTableRequest>,
@@ -378,6 +721,10 @@ pub mod venue_api_server {
             &self,
             request: tonic::Request<super::IsAdminRequest>,
         ) -> Result<tonic::Response<super::IsAdminResponse>, tonic::Status>;
+        async fn is_admin_batch(
+            &self,
+            request: tonic::Request<super::IsAdminBatchRequest>,
+        ) -> Result<tonic::Response<super::IsAdminBatchResponse>, tonic::Status>;
         async fn add_admin(
             &self,
             request: tonic::Request<super::AddAdminRequest>,
@@ -386,10 +733,48 @@ pub mod venue_api_server {
             &self,
             request: tonic::Request<super::GetAdminsRequest>,
         ) -> Result<tonic::Response<super::GetAdminsResponse>, tonic::Status>;
+        async fn get_admins_batch(
+            &self,
+            request: tonic::Request<super::GetAdminsBatchRequest>,
+        ) -> Result<tonic::Response<super::GetAdminsBatchResponse>, tonic::Status>;
         async fn remove_admin(
             &self,
             request: tonic::Request<super::RemoveAdminRequest>,
         ) -> Result<tonic::Response<super::RemoveAdminResponse>, tonic::Status>;
+        async fn create_api_key(
+            &self,
+            request: tonic::Request<super::CreateApiKeyRequest>,
+        ) -> Result<tonic::Response<super::CreateApiKeyResponse>, tonic::Status>;
+        async fn get_api_keys(
+            &self,
+            request: tonic::Request<super::GetApiKeysRequest>,
+        ) -> Result<tonic::Response<super::GetApiKeysResponse>, tonic::Status>;
+        async fn revoke_api_key(
+            &self,
+            request: tonic::Request<super::RevokeApiKeyRequest>,
+        ) -> Result<tonic::Response<super::super::models::ApiKey>, tonic::Status>;
+        async fn verify_api_key(
+            &self,
+            request: tonic::Request<super::VerifyApiKeyRequest>,
+        ) -> Result<tonic::Response<super::super::models::ApiKey>, tonic::Status>;
+        #[doc = "Server streaming response type for the WatchVenue method."]
+        type WatchVenueStream: Stream<Item = Result<super::VenueEvent, tonic::Status>>
+            + Send
+            + Sync
+            + 'static;
+        async fn watch_venue(
+            &self,
+            request: tonic::Request<super::WatchVenueRequest>,
+        ) -> Result<tonic::Response<Self::WatchVenueStream>, tonic::Status>;
+        #[doc = "Server streaming response type for the WatchVenues method."]
+        type WatchVenuesStream: Stream<Item = Result<super::VenueEvent, tonic::Status>>
+            + Send
+            + Sync
+            + 'static;
+        async fn watch_venues(
+            &self,
+            request: tonic::Request<super::WatchVenuesRequest>,
+        ) -> Result<tonic::Response<Self::WatchVenuesStream>, tonic::Status>;
     }
     #[derive(Debug)]
     pub struct VenueApiServer<T: VenueApi> {
@@ -588,6 +973,79 @@ pub mod venue_api_server {
                     };
                     Box::pin(fut)
                 }
+                "/venue.api.VenueAPI/BatchGetVenues" => {
+                    #[allow(non_camel_case_types)]
+                    struct BatchGetVenuesSvc<T: VenueApi>(pub Arc<T>);
+                    impl<T: VenueApi> tonic::server::UnaryService<super::BatchGetVenuesRequest>
+                        for BatchGetVenuesSvc<T>
+                    {
+                        type Response = super::BatchGetVenuesResponse;
+                        type Future = BoxFuture<tonic::Response<Self::Response>, tonic::Status>;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::BatchGetVenuesRequest>,
+                        ) -> Self::Future {
+                            let inner = self.0.clone();
+                            let fut = async move { (*inner).batch_get_venues(request).await };
+                            Box::pin(fut)
+                        }
+                    }
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let interceptor = inner.1.clone();
+                        let inner = inner.0;
+                        let method = BatchGetVenuesSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = if let Some(interceptor) = interceptor {
+                            tonic::server::Grpc::with_interceptor(codec, interceptor)
+                        } else {
+                            tonic::server::Grpc::new(codec)
+                        };
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/venue.api.VenueAPI/GetOpeningHoursSpecificationBatch" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetOpeningHoursSpecificationBatchSvc<T: VenueApi>(pub Arc<T>);
+                    impl<T: VenueApi>
+                        tonic::server::UnaryService<super::GetOpeningHoursSpecificationBatchRequest>
+                        for GetOpeningHoursSpecificationBatchSvc<T>
+                    {
+                        type Response = super::GetOpeningHoursSpecificationBatchResponse;
+                        type Future = BoxFuture<tonic::Response<Self::Response>, tonic::Status>;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                super::GetOpeningHoursSpecificationBatchRequest,
+                            >,
+                        ) -> Self::Future {
+                            let inner = self.0.clone();
+                            let fut = async move {
+                                (*inner)
+                                    .get_opening_hours_specification_batch(request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let interceptor = inner.1.clone();
+                        let inner = inner.0;
+                        let method = GetOpeningHoursSpecificationBatchSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = if let Some(interceptor) = interceptor {
+                            tonic::server::Grpc::with_interceptor(codec, interceptor)
+                        } else {
+                            tonic::server::Grpc::new(codec)
+                        };
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/venue.api.VenueAPI/GetTables" => {
                     #[allow(non_camel_case_types)]
                     struct GetTablesSvc<T: VenueApi>(pub Arc<T>);
@@ -619,6 +1077,39 @@ pub mod venue_api_server {
                     };
                     Box::pin(fut)
                 }
+                "/venue.api.VenueAPI/GetTablesBatch" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetTablesBatchSvc<T: VenueApi>(pub Arc<T>);
+                    impl<T: VenueApi> tonic::server::UnaryService<super::GetTablesBatchRequest>
+                        for GetTablesBatchSvc<T>
+                    {
+                        type Response = super::GetTablesBatchResponse;
+                        type Future = BoxFuture<tonic::Response<Self::Response>, tonic::Status>;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetTablesBatchRequest>,
+                        ) -> Self::Future {
+                            let inner = self.0.clone();
+                            let fut = async move { (*inner).get_tables_batch(request).await };
+                            Box::pin(fut)
+                        }
+                    }
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let interceptor = inner.1.clone();
+                        let inner = inner.0;
+                        let method = GetTablesBatchSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = if let Some(interceptor) = interceptor {
+                            tonic::server::Grpc::with_interceptor(codec, interceptor)
+                        } else {
+                            tonic::server::Grpc::new(codec)
+                        };
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/venue.api.VenueAPI/AddTable" => {
                     #[allow(non_camel_case_types)]
                     struct AddTableSvc<T: VenueApi>(pub Arc<T>);
@@ -712,6 +1203,37 @@ pub mod venue_api_server {
                     };
                     Box::pin(fut)
                 }
+                "/venue.api.VenueAPI/IsAdminBatch" => {
+                    #[allow(non_camel_case_types)]
+                    struct IsAdminBatchSvc<T: VenueApi>(pub Arc<T>);
+                    impl<T: VenueApi> tonic::server::UnaryService<super::IsAdminBatchRequest> for IsAdminBatchSvc<T> {
+                        type Response = super::IsAdminBatchResponse;
+                        type Future = BoxFuture<tonic::Response<Self::Response>, tonic::Status>;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::IsAdminBatchRequest>,
+                        ) -> Self::Future {
+                            let inner = self.0.clone();
+                            let fut = async move { (*inner).is_admin_batch(request).await };
+                            Box::pin(fut)
+                        }
+                    }
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let interceptor = inner.1.clone();
+                        let inner = inner.0;
+                        let method = IsAdminBatchSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = if let Some(interceptor) = interceptor {
+                            tonic::server::Grpc::with_interceptor(codec, interceptor)
+                        } else {
+                            tonic::server::Grpc::new(codec)
+                        };
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/venue.api.VenueAPI/AddAdmin" => {
                     #[allow(non_camel_case_types)]
                     struct AddAdminSvc<T: VenueApi>(pub Arc<T>);
@@ -774,6 +1296,39 @@ pub mod venue_api_server {
                     };
                     Box::pin(fut)
                 }
+                "/venue.api.VenueAPI/GetAdminsBatch" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetAdminsBatchSvc<T: VenueApi>(pub Arc<T>);
+                    impl<T: VenueApi> tonic::server::UnaryService<super::GetAdminsBatchRequest>
+                        for GetAdminsBatchSvc<T>
+                    {
+                        type Response = super::GetAdminsBatchResponse;
+                        type Future = BoxFuture<tonic::Response<Self::Response>, tonic::Status>;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetAdminsBatchRequest>,
+                        ) -> Self::Future {
+                            let inner = self.0.clone();
+                            let fut = async move { (*inner).get_admins_batch(request).await };
+                            Box::pin(fut)
+                        }
+                    }
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let interceptor = inner.1.clone();
+                        let inner = inner.0;
+                        let method = GetAdminsBatchSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = if let Some(interceptor) = interceptor {
+                            tonic::server::Grpc::with_interceptor(codec, interceptor)
+                        } else {
+                            tonic::server::Grpc::new(codec)
+                        };
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/venue.api.VenueAPI/RemoveAdmin" => {
                     #[allow(non_camel_case_types)]
                     struct RemoveAdminSvc<T: VenueApi>(pub Arc<T>);
@@ -805,6 +1360,202 @@ pub mod venue_api_server {
                     };
                     Box::pin(fut)
                 }
+                "/venue.api.VenueAPI/CreateApiKey" => {
+                    #[allow(non_camel_case_types)]
+                    struct CreateApiKeySvc<T: VenueApi>(pub Arc<T>);
+                    impl<T: VenueApi> tonic::server::UnaryService<super::CreateApiKeyRequest> for CreateApiKeySvc<T> {
+                        type Response = super::CreateApiKeyResponse;
+                        type Future = BoxFuture<tonic::Response<Self::Response>, tonic::Status>;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::CreateApiKeyRequest>,
+                        ) -> Self::Future {
+                            let inner = self.0.clone();
+                            let fut = async move { (*inner).create_api_key(request).await };
+                            Box::pin(fut)
+                        }
+                    }
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let interceptor = inner.1.clone();
+                        let inner = inner.0;
+                        let method = CreateApiKeySvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = if let Some(interceptor) = interceptor {
+                            tonic::server::Grpc::with_interceptor(codec, interceptor)
+                        } else {
+                            tonic::server::Grpc::new(codec)
+                        };
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/venue.api.VenueAPI/GetApiKeys" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetApiKeysSvc<T: VenueApi>(pub Arc<T>);
+                    impl<T: VenueApi> tonic::server::UnaryService<super::GetApiKeysRequest> for GetApiKeysSvc<T> {
+                        type Response = super::GetApiKeysResponse;
+                        type Future = BoxFuture<tonic::Response<Self::Response>, tonic::Status>;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetApiKeysRequest>,
+                        ) -> Self::Future {
+                            let inner = self.0.clone();
+                            let fut = async move { (*inner).get_api_keys(request).await };
+                            Box::pin(fut)
+                        }
+                    }
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let interceptor = inner.1.clone();
+                        let inner = inner.0;
+                        let method = GetApiKeysSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = if let Some(interceptor) = interceptor {
+                            tonic::server::Grpc::with_interceptor(codec, interceptor)
+                        } else {
+                            tonic::server::Grpc::new(codec)
+                        };
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/venue.api.VenueAPI/RevokeApiKey" => {
+                    #[allow(non_camel_case_types)]
+                    struct RevokeApiKeySvc<T: VenueApi>(pub Arc<T>);
+                    impl<T: VenueApi> tonic::server::UnaryService<super::RevokeApiKeyRequest> for RevokeApiKeySvc<T> {
+                        type Response = super::super::models::ApiKey;
+                        type Future = BoxFuture<tonic::Response<Self::Response>, tonic::Status>;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::RevokeApiKeyRequest>,
+                        ) -> Self::Future {
+                            let inner = self.0.clone();
+                            let fut = async move { (*inner).revoke_api_key(request).await };
+                            Box::pin(fut)
+                        }
+                    }
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let interceptor = inner.1.clone();
+                        let inner = inner.0;
+                        let method = RevokeApiKeySvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = if let Some(interceptor) = interceptor {
+                            tonic::server::Grpc::with_interceptor(codec, interceptor)
+                        } else {
+                            tonic::server::Grpc::new(codec)
+                        };
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/venue.api.VenueAPI/VerifyApiKey" => {
+                    #[allow(non_camel_case_types)]
+                    struct VerifyApiKeySvc<T: VenueApi>(pub Arc<T>);
+                    impl<T: VenueApi> tonic::server::UnaryService<super::VerifyApiKeyRequest> for VerifyApiKeySvc<T> {
+                        type Response = super::super::models::ApiKey;
+                        type Future = BoxFuture<tonic::Response<Self::Response>, tonic::Status>;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::VerifyApiKeyRequest>,
+                        ) -> Self::Future {
+                            let inner = self.0.clone();
+                            let fut = async move { (*inner).verify_api_key(request).await };
+                            Box::pin(fut)
+                        }
+                    }
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let interceptor = inner.1.clone();
+                        let inner = inner.0;
+                        let method = VerifyApiKeySvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = if let Some(interceptor) = interceptor {
+                            tonic::server::Grpc::with_interceptor(codec, interceptor)
+                        } else {
+                            tonic::server::Grpc::new(codec)
+                        };
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/venue.api.VenueAPI/WatchVenue" => {
+                    #[allow(non_camel_case_types)]
+                    struct WatchVenueSvc<T: VenueApi>(pub Arc<T>);
+                    impl<T: VenueApi>
+                        tonic::server::ServerStreamingService<super::WatchVenueRequest>
+                        for WatchVenueSvc<T>
+                    {
+                        type Response = super::VenueEvent;
+                        type ResponseStream = T::WatchVenueStream;
+                        type Future =
+                            BoxFuture<tonic::Response<Self::ResponseStream>, tonic::Status>;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::WatchVenueRequest>,
+                        ) -> Self::Future {
+                            let inner = self.0.clone();
+                            let fut = async move { (*inner).watch_venue(request).await };
+                            Box::pin(fut)
+                        }
+                    }
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let interceptor = inner.1;
+                        let inner = inner.0;
+                        let method = WatchVenueSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = if let Some(interceptor) = interceptor {
+                            tonic::server::Grpc::with_interceptor(codec, interceptor)
+                        } else {
+                            tonic::server::Grpc::new(codec)
+                        };
+                        let res = grpc.server_streaming(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/venue.api.VenueAPI/WatchVenues" => {
+                    #[allow(non_camel_case_types)]
+                    struct WatchVenuesSvc<T: VenueApi>(pub Arc<T>);
+                    impl<T: VenueApi>
+                        tonic::server::ServerStreamingService<super::WatchVenuesRequest>
+                        for WatchVenuesSvc<T>
+                    {
+                        type Response = super::VenueEvent;
+                        type ResponseStream = T::WatchVenuesStream;
+                        type Future =
+                            BoxFuture<tonic::Response<Self::ResponseStream>, tonic::Status>;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::WatchVenuesRequest>,
+                        ) -> Self::Future {
+                            let inner = self.0.clone();
+                            let fut = async move { (*inner).watch_venues(request).await };
+                            Box::pin(fut)
+                        }
+                    }
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let interceptor = inner.1;
+                        let inner = inner.0;
+                        let method = WatchVenuesSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = if let Some(interceptor) = interceptor {
+                            tonic::server::Grpc::with_interceptor(codec, interceptor)
+                        } else {
+                            tonic::server::Grpc::new(codec)
+                        };
+                        let res = grpc.server_streaming(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 _ => Box::pin(async move {
                     Ok(http::Response::builder()
                         .status(200)
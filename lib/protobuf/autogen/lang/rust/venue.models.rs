@@ -10,6 +10,8 @@ pub struct Venue {
     pub special_opening_hours: ::prost::alloc::vec::Vec<OpeningHoursSpecification>,
     #[prost(string, tag = "5")]
     pub slug: ::prost::alloc::string::String,
+    #[prost(int64, tag = "6")]
+    pub version: i64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct OpeningHoursSpecification {
@@ -33,3 +35,29 @@ pub struct Table {
     #[prost(uint32, tag = "3")]
     pub capacity: u32,
 }
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ApiKey {
+    #[prost(string, tag = "1")]
+    pub id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub venue_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub name: ::prost::alloc::string::String,
+    #[prost(enumeration = "ApiKeyRole", tag = "4")]
+    pub role: i32,
+    #[prost(string, tag = "5")]
+    pub prefix: ::prost::alloc::string::String,
+    #[prost(string, tag = "6")]
+    pub created_at: ::prost::alloc::string::String,
+    #[prost(string, tag = "7")]
+    pub last_used_at: ::prost::alloc::string::String,
+    #[prost(string, tag = "8")]
+    pub revoked_at: ::prost::alloc::string::String,
+}
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum ApiKeyRole {
+    UnknownRole = 0,
+    ReadBookings = 1,
+    ManageBookings = 2,
+}
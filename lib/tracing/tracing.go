@@ -0,0 +1,58 @@
+// Package tracing sets up OpenTelemetry tracing for the platform's Go services.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/exporters/stdout"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+	"go.uber.org/zap"
+	"time"
+)
+
+// NewTracerProvider installs the global tracer provider for the named service. Spans are sent to an OTLP collector,
+// configured with the standard 'OTEL_EXPORTER_OTLP_*' environment variables, when the exporter is 'otlp', or printed
+// when it is 'stdout'. No spans are recorded when the exporter is empty, but trace context is still propagated.
+func NewTracerProvider(ctx context.Context, service, exporter string) (func(log *zap.SugaredLogger), error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exp sdktrace.SpanExporter
+	switch exporter {
+	case "":
+		return func(log *zap.SugaredLogger) {}, nil
+	case "stdout":
+		e, err := stdout.NewExporter(stdout.WithPrettyPrint())
+		if err != nil {
+			return nil, fmt.Errorf("could not create stdout exporter : %w", err)
+		}
+		exp = e
+	case "otlp":
+		e, err := otlp.NewExporter(ctx, otlpgrpc.NewDriver())
+		if err != nil {
+			return nil, fmt.Errorf("could not create otlp exporter : %w", err)
+		}
+		exp = e
+	default:
+		return nil, fmt.Errorf("unknown trace exporter '%s'", exporter)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.ServiceNameKey.String(service))),
+	)
+	otel.SetTracerProvider(tp)
+
+	return func(log *zap.SugaredLogger) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := tp.Shutdown(ctx); err != nil {
+			log.Errorf("could not shut down tracer provider : %s", err)
+		}
+	}, nil
+}
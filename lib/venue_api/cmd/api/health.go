@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"time"
+)
+
+const serviceName = "venue.api.VenueAPI"
+
+type pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// watchHealth reports the venue service as serving while the store can be pinged, checking every interval until the
+// context is done.
+func watchHealth(ctx context.Context, log *zap.SugaredLogger, hs *health.Server, store pinger, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		status := healthpb.HealthCheckResponse_SERVING
+		pingCtx, cancel := context.WithTimeout(ctx, interval)
+		if err := store.Ping(pingCtx); err != nil {
+			if ctx.Err() != nil {
+				cancel()
+				return
+			}
+			log.Errorf("could not ping store : %s", err)
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		cancel()
+
+		hs.SetServingStatus("", status)
+		hs.SetServingStatus(serviceName, status)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
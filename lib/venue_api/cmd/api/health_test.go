@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"sync"
+	"testing"
+	"time"
+)
+
+// store is a pinger whose pings fail while it is down.
+type store struct {
+	mu   sync.Mutex
+	down bool
+}
+
+func (s *store) Ping(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.down {
+		return errors.New("connection refused")
+	}
+
+	return nil
+}
+
+func (s *store) setDown(down bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.down = down
+}
+
+func servingStatus(t *testing.T, hs *health.Server, service string) healthpb.HealthCheckResponse_ServingStatus {
+	resp, err := hs.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+
+	return resp.Status
+}
+
+func Test_WatchHealth(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	hs := health.NewServer()
+	s := &store{}
+
+	go watchHealth(ctx, zap.NewNop().Sugar(), hs, s, 10*time.Millisecond)
+
+	for _, test := range []struct {
+		down   bool
+		status healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{down: false, status: healthpb.HealthCheckResponse_SERVING},
+		{down: true, status: healthpb.HealthCheckResponse_NOT_SERVING},
+		{down: false, status: healthpb.HealthCheckResponse_SERVING},
+	} {
+		s.setDown(test.down)
+		for _, service := range []string{"", serviceName} {
+			assert.Eventually(t, func() bool {
+				return servingStatus(t, hs, service) == test.status
+			}, time.Second, 5*time.Millisecond, "%q should be %s when the store is down is %t", service, test.status, test.down)
+		}
+	}
+}
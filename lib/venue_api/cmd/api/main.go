@@ -1,19 +1,35 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	"github.com/cobbinma/booking-platform/lib/tracing"
 	"github.com/cobbinma/booking-platform/lib/venue_api/cmd/api/middleware"
+	"github.com/cobbinma/booking-platform/lib/venue_api/internal/memory"
 	"github.com/cobbinma/booking-platform/lib/venue_api/internal/postgres"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/joho/godotenv"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
 	"net"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 )
 
 func main() {
@@ -31,22 +47,74 @@ func main() {
 	}(logger)
 	log := logger.Sugar()
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(log, os.Args[2:]); err != nil {
+			log.Fatalf("could not migrate : %s", err)
+		}
+		return
+	}
+
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8888"
 	}
 
-	db, closeDB, err := postgres.NewPostgres(log)
+	metricsPort := os.Getenv("METRICS_PORT")
+	if metricsPort == "" {
+		metricsPort = "8889"
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	closeTracer, err := tracing.NewTracerProvider(ctx, "venue_api", os.Getenv("TRACE_EXPORTER"))
 	if err != nil {
-		log.Fatalf("could not construct postgres : %s", err)
+		log.Fatalf("could not construct tracer provider : %s", err)
+	}
+	defer closeTracer(log)
+
+	db, closeDB, err := newStore(log)
+	if err != nil {
+		log.Fatalf("could not construct store : %s", err)
 	}
 	defer closeDB(log)
 
-	ensureValidToken, err := middleware.EnsureValidToken()
+	store, ok := db.(pinger)
+	if !ok {
+		log.Fatalf("store does not support health checking")
+	}
+
+	reflect, err := boolEnv("REFLECTION", false)
+	if err != nil {
+		log.Fatalf("could not configure reflection : %s", err)
+	}
+
+	healthCheckInterval, err := durationEnv("HEALTH_CHECK_INTERVAL", 5*time.Second)
+	if err != nil {
+		log.Fatalf("could not configure health checking : %s", err)
+	}
+
+	shutdownTimeout, err := durationEnv("SHUTDOWN_TIMEOUT", 30*time.Second)
+	if err != nil {
+		log.Fatalf("could not configure shutdown : %s", err)
+	}
+
+	// clients ping idle connections to notice broken ones, so pings are permitted as often as the gateway sends them
+	keepaliveMinTime, err := durationEnv("KEEPALIVE_MIN_TIME", 10*time.Second)
+	if err != nil {
+		log.Fatalf("could not configure keepalive : %s", err)
+	}
+
+	validator, err := newTokenValidator(log)
 	if err != nil {
-		log.Fatalf("could not construct auth server inceptor : %s", err)
+		log.Fatalf("could not construct token validator : %s", err)
 	}
 
+	public := []string{healthpb.Health_ServiceDesc.ServiceName, "grpc.reflection.v1alpha.ServerReflection"}
+	tagRequestID, tagStreamRequestID := middleware.RequestID()
+	ensureValidToken, ensureValidStreamToken := middleware.EnsureValidToken(validator, public...)
+	requireScope, requireStreamScope := middleware.RequireScope(scopes, public...)
+
 	cert, err := tls.LoadX509KeyPair("localhost.crt", "localhost.key")
 	if err != nil {
 		log.Fatalf("failed to load cert : %s", err)
@@ -59,14 +127,198 @@ func main() {
 
 	opts := []grpc.ServerOption{
 		grpc.Creds(credentials.NewServerTLSFromCert(&cert)),
-		grpc_middleware.WithUnaryServerChain(grpc_zap.UnaryServerInterceptor(logger), ensureValidToken),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{MinTime: keepaliveMinTime, PermitWithoutStream: true}),
+		grpc_middleware.WithUnaryServerChain(otelgrpc.UnaryServerInterceptor(), grpc_ctxtags.UnaryServerInterceptor(),
+			tagRequestID, grpc_zap.UnaryServerInterceptor(logger),
+			grpc_prometheus.UnaryServerInterceptor, ensureValidToken, requireScope),
+		grpc_middleware.WithStreamServerChain(otelgrpc.StreamServerInterceptor(), grpc_ctxtags.StreamServerInterceptor(),
+			tagStreamRequestID, grpc_zap.StreamServerInterceptor(logger),
+			grpc_prometheus.StreamServerInterceptor, ensureValidStreamToken, requireStreamScope, cancelOnShutdown(ctx)),
 	}
 
 	s := grpc.NewServer(opts...)
 	api.RegisterVenueAPIServer(s, db)
 
-	log.Infof("starting gRPC listener on port %s", port)
-	if err := s.Serve(lis); err != nil {
+	hs := health.NewServer()
+	healthpb.RegisterHealthServer(s, hs)
+	if reflect {
+		reflection.Register(s)
+	}
+
+	grpc_prometheus.EnableHandlingTimeHistogram()
+	grpc_prometheus.Register(s)
+
+	go watchHealth(ctx, log, hs, store, healthCheckInterval)
+	go serveMetrics(ctx, log, metricsPort)
+
+	errs := make(chan error, 1)
+	go func() {
+		log.Infof("starting gRPC listener on port %s", port)
+		errs <- s.Serve(lis)
+	}()
+
+	select {
+	case err := <-errs:
 		log.Fatalf("failed to serve : %s", err)
+	case <-ctx.Done():
+	}
+
+	log.Info("shutting down gRPC server")
+	hs.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(shutdownTimeout):
+		log.Warnf("could not drain gRPC server within %s", shutdownTimeout)
+		s.Stop()
+	}
+}
+
+// cancelOnShutdown cancels streams once shutdown begins, so long lived watches end and let the server drain. Clients
+// are expected to resume from their last cursor on another instance.
+func cancelOnShutdown(shutdown context.Context) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, cancel := context.WithCancel(ss.Context())
+		defer cancel()
+
+		go func() {
+			select {
+			case <-shutdown.Done():
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+
+		return handler(srv, wrapped)
+	}
+}
+
+// newStore constructs the venue store named by the 'STORE' environment variable. The in-memory store loses all data on
+// restart and is meant for running the service without a database.
+func newStore(log *zap.SugaredLogger) (api.VenueAPIServer, func(log *zap.SugaredLogger), error) {
+	switch store := os.Getenv("STORE"); store {
+	case "memory":
+		return memory.NewMemory(log)
+	case "", "postgres":
+		maxOpenConns, err := intEnv("DATABASE_MAX_OPEN_CONNS", 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		maxIdleConns, err := intEnv("DATABASE_MAX_IDLE_CONNS", 2)
+		if err != nil {
+			return nil, nil, err
+		}
+		connMaxLifetime, err := durationEnv("DATABASE_CONN_MAX_LIFETIME", 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		statementTimeout, err := durationEnv("DATABASE_STATEMENT_TIMEOUT", 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		autoMigrate, err := boolEnv("AUTO_MIGRATE", true)
+		if err != nil {
+			return nil, nil, err
+		}
+		eventRetention, err := durationEnv("EVENT_RETENTION", 7*24*time.Hour)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return postgres.NewPostgres(log,
+			postgres.WithMaxOpenConns(maxOpenConns),
+			postgres.WithMaxIdleConns(maxIdleConns),
+			postgres.WithConnMaxLifetime(connMaxLifetime),
+			postgres.WithStatementTimeout(statementTimeout),
+			postgres.WithAutoMigrate(autoMigrate),
+			postgres.WithEventRetention(eventRetention),
+			postgres.WithMetricsRegisterer(prometheus.DefaultRegisterer))
+	default:
+		return nil, nil, fmt.Errorf("unknown store '%s'", store)
+	}
+}
+
+// newTokenValidator constructs the validator for incoming tokens, which must be issued by 'AUTH0_DOMAIN' for the
+// 'AUTH0_API_IDENTIFIER' audience. Keys are read from the 'AUTH_JWKS_FILE' key set or the 'AUTH_STATIC_KEY' secret when
+// either is set, and otherwise fetched from the Auth0 domain.
+func newTokenValidator(log *zap.SugaredLogger) (middleware.TokenValidator, error) {
+	issuer := os.Getenv("AUTH0_DOMAIN")
+	if issuer == "" {
+		return nil, fmt.Errorf("missing auth0 domain environment variable")
+	}
+	if !strings.HasSuffix(issuer, "/") {
+		issuer = issuer + "/"
+	}
+
+	audience := os.Getenv("AUTH0_API_IDENTIFIER")
+	if audience == "" {
+		return nil, fmt.Errorf("missing api identifier environment variable")
+	}
+
+	if key := os.Getenv("AUTH_STATIC_KEY"); key != "" {
+		log.Warn("validating tokens with a static key, this must not be used outside of development")
+		return middleware.NewStaticKeyValidator([]byte(key), issuer, audience), nil
+	}
+
+	if path := os.Getenv("AUTH_JWKS_FILE"); path != "" {
+		return middleware.NewJWKSFileValidator(path, issuer, audience)
+	}
+
+	ttl, err := durationEnv("AUTH_JWKS_CACHE_TTL", time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	return middleware.NewRemoteJWKSValidator(issuer+".well-known/jwks.json", issuer, audience, ttl), nil
+}
+
+func intEnv(key string, fallback int) (int, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse '%s' : %w", key, err)
 	}
+
+	return i, nil
+}
+
+func boolEnv(key string, fallback bool) (bool, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("could not parse '%s' : %w", key, err)
+	}
+
+	return b, nil
+}
+
+func durationEnv(key string, fallback time.Duration) (time.Duration, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse '%s' : %w", key, err)
+	}
+
+	return d, nil
 }
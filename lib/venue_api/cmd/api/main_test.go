@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"testing"
+	"time"
+)
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func Test_CancelOnShutdown(t *testing.T) {
+	shutdown, startShutdown := context.WithCancel(context.Background())
+	defer startShutdown()
+	interceptor := cancelOnShutdown(shutdown)
+
+	started := make(chan struct{})
+	ended := make(chan error, 1)
+	go func() {
+		ended <- interceptor(nil, serverStream{ctx: context.Background()}, &grpc.StreamServerInfo{},
+			func(srv interface{}, stream grpc.ServerStream) error {
+				close(started)
+				<-stream.Context().Done()
+				return stream.Context().Err()
+			})
+	}()
+
+	<-started
+	select {
+	case <-ended:
+		t.Fatal("stream ended before shutdown")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	startShutdown()
+	select {
+	case err := <-ended:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("stream was not cancelled once shutdown started")
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+// serveMetrics exposes the default prometheus registry on its own port, keeping it off the public gRPC listener, until
+// the context is done.
+func serveMetrics(ctx context.Context, log *zap.SugaredLogger, port string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	srv := &http.Server{Addr: ":" + port, Handler: mux}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Errorf("could not shut down metrics server : %s", err)
+		}
+	}()
+
+	log.Infof("starting metrics listener on port %s", port)
+	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		log.Errorf("could not serve metrics : %s", err)
+	}
+}
@@ -2,51 +2,101 @@ package middleware
 
 import (
 	"context"
-	"fmt"
-	"github.com/auth0-community/go-auth0"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
-	"gopkg.in/square/go-jose.v2"
-	"gopkg.in/square/go-jose.v2/jwt"
-	"os"
 	"strings"
 )
 
-func EnsureValidToken() (grpc.UnaryServerInterceptor, error) {
-	baseURL := os.Getenv("AUTH0_DOMAIN")
-	if baseURL == "" {
-		return nil, fmt.Errorf("missing auth0 domain environment variable")
-	}
+const bearerPrefix = "bearer "
 
-	if len(baseURL) > 0 && baseURL[len(baseURL)-1] != '/' {
-		baseURL = baseURL + "/"
-	}
-	apiIdentifier := os.Getenv("AUTH0_API_IDENTIFIER")
-	if apiIdentifier == "" {
-		return nil, fmt.Errorf("missing api identifier environment variable")
-	}
-	client := auth0.NewJWKClient(auth0.JWKClientOptions{URI: fmt.Sprintf("%s.well-known/jwks.json", baseURL)}, nil)
-	validator := auth0.NewValidator(auth0.NewConfiguration(client, []string{apiIdentifier}, baseURL, jose.RS256), nil)
+type claimsKey struct{}
 
-	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Errorf(codes.InvalidArgument, "missing metadata")
-		}
-		if len(md["authorization"]) != 1 {
-			return nil, status.Errorf(codes.InvalidArgument, "auth token has incorrect length")
-		}
+// ClaimsFromContext returns the claims of the token the call was authenticated with.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
 
-		token, err := jwt.ParseSigned(strings.TrimPrefix(md["authorization"][0], "Bearer"))
+// EnsureValidToken returns interceptors rejecting calls without a bearer token accepted by the validator, and adding
+// the claims of accepted tokens to the context. Calls to the given public services, such as health checking, are let
+// through without one.
+func EnsureValidToken(validator TokenValidator, public ...string) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	validate := func(ctx context.Context) (context.Context, error) {
+		token, err := bearerToken(ctx)
 		if err != nil {
-			return nil, status.Errorf(codes.Unauthenticated, "could not parse token : %s", err)
+			return nil, err
 		}
-		if err = validator.ValidateToken(token); err != nil {
+
+		claims, err := validator.Validate(ctx, token)
+		if err != nil {
 			return nil, status.Errorf(codes.Unauthenticated, "could not validate token : %s", err)
 		}
 
-		return handler(ctx, req)
-	}, nil
+		return context.WithValue(ctx, claimsKey{}, claims), nil
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+			if isPublic(info.FullMethod, public) {
+				return handler(ctx, req)
+			}
+
+			ctx, err = validate(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			return handler(ctx, req)
+		}, func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if isPublic(info.FullMethod, public) {
+				return handler(srv, ss)
+			}
+
+			ctx, err := validate(ss.Context())
+			if err != nil {
+				return err
+			}
+
+			wrapped := grpc_middleware.WrapServerStream(ss)
+			wrapped.WrappedContext = ctx
+
+			return handler(srv, wrapped)
+		}
+}
+
+// bearerToken returns the token from the single 'authorization' metadata value, which must use the bearer scheme.
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Errorf(codes.Unauthenticated, "missing metadata")
+	}
+
+	values := md.Get("authorization")
+	if len(values) != 1 {
+		return "", status.Errorf(codes.Unauthenticated, "expected one authorization value, got %d", len(values))
+	}
+
+	value := values[0]
+	if len(value) < len(bearerPrefix) || !strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+		return "", status.Errorf(codes.Unauthenticated, "authorization must use the bearer scheme")
+	}
+
+	token := strings.TrimSpace(value[len(bearerPrefix):])
+	if token == "" {
+		return "", status.Errorf(codes.Unauthenticated, "missing bearer token")
+	}
+
+	return token, nil
+}
+
+func isPublic(fullMethod string, services []string) bool {
+	for i := range services {
+		if strings.HasPrefix(fullMethod, "/"+services[i]+"/") {
+			return true
+		}
+	}
+
+	return false
 }
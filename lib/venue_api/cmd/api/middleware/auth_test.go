@@ -0,0 +1,376 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	issuer   = "https://booking.eu.auth0.com/"
+	audience = "http://venue"
+)
+
+var secret = []byte("local development secret")
+
+func Test_EnsureValidToken(t *testing.T) {
+	valid := jwt.Claims{
+		Issuer:   issuer,
+		Audience: jwt.Audience{audience},
+		Subject:  "gateway@clients",
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		IssuedAt: jwt.NewNumericDate(time.Now()),
+	}
+
+	tests := []struct {
+		name          string
+		authorization []string
+		method        string
+		code          codes.Code
+	}{
+		{
+			name:          "valid token",
+			authorization: []string{"Bearer " + mint(t, secret, jose.HS256, "", valid)},
+			code:          codes.OK,
+		},
+		{
+			name:          "lower case scheme",
+			authorization: []string{"bearer " + mint(t, secret, jose.HS256, "", valid)},
+			code:          codes.OK,
+		},
+		{
+			name: "expired token",
+			authorization: []string{"Bearer " + mint(t, secret, jose.HS256, "", with(valid, func(c *jwt.Claims) {
+				c.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+			}))},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "token without expiry",
+			authorization: []string{"Bearer " + mint(t, secret, jose.HS256, "", with(valid, func(c *jwt.Claims) {
+				c.Expiry = nil
+			}))},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "token not yet valid",
+			authorization: []string{"Bearer " + mint(t, secret, jose.HS256, "", with(valid, func(c *jwt.Claims) {
+				c.NotBefore = jwt.NewNumericDate(time.Now().Add(time.Hour))
+			}))},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "wrong audience",
+			authorization: []string{"Bearer " + mint(t, secret, jose.HS256, "", with(valid, func(c *jwt.Claims) {
+				c.Audience = jwt.Audience{"http://booking"}
+			}))},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "wrong issuer",
+			authorization: []string{"Bearer " + mint(t, secret, jose.HS256, "", with(valid, func(c *jwt.Claims) {
+				c.Issuer = "https://evil.example.com/"
+			}))},
+			code: codes.Unauthenticated,
+		},
+		{
+			name:          "wrong secret",
+			authorization: []string{"Bearer " + mint(t, []byte("another secret"), jose.HS256, "", valid)},
+			code:          codes.Unauthenticated,
+		},
+		{
+			name:          "disallowed algorithm",
+			authorization: []string{"Bearer " + mint(t, secret, jose.HS512, "", valid)},
+			code:          codes.Unauthenticated,
+		},
+		{
+			name: "missing authorization",
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "multiple authorization values",
+			authorization: []string{
+				"Bearer " + mint(t, secret, jose.HS256, "", valid),
+				"Bearer " + mint(t, secret, jose.HS256, "", valid),
+			},
+			code: codes.Unauthenticated,
+		},
+		{
+			name:          "basic scheme",
+			authorization: []string{"Basic dXNlcjpwYXNz"},
+			code:          codes.Unauthenticated,
+		},
+		{
+			name:          "scheme without separator",
+			authorization: []string{"Bearer" + mint(t, secret, jose.HS256, "", valid)},
+			code:          codes.Unauthenticated,
+		},
+		{
+			name:          "scheme without token",
+			authorization: []string{"Bearer "},
+			code:          codes.Unauthenticated,
+		},
+		{
+			name:          "malformed token",
+			authorization: []string{"Bearer not.a.token"},
+			code:          codes.Unauthenticated,
+		},
+		{
+			name:   "public service without token",
+			method: "/grpc.health.v1.Health/Check",
+			code:   codes.OK,
+		},
+	}
+
+	unary, stream := EnsureValidToken(NewStaticKeyValidator(secret, issuer, audience), "grpc.health.v1.Health")
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			method := test.method
+			if method == "" {
+				method = "/venue.api.VenueAPI/GetVenue"
+			}
+
+			md := metadata.MD{}
+			for i := range test.authorization {
+				md.Append("authorization", test.authorization[i])
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			called := false
+			_, err := unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			})
+			assert.Equal(t, test.code, status.Code(err))
+			assert.Equal(t, test.code == codes.OK, called)
+
+			called = false
+			err = stream(nil, &serverStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: method}, func(srv interface{}, stream grpc.ServerStream) error {
+				called = true
+				return nil
+			})
+			assert.Equal(t, test.code, status.Code(err))
+			assert.Equal(t, test.code == codes.OK, called)
+		})
+	}
+}
+
+func Test_EnsureValidTokenMissingMetadata(t *testing.T) {
+	unary, _ := EnsureValidToken(NewStaticKeyValidator(secret, issuer, audience))
+
+	_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/venue.api.VenueAPI/GetVenue"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func Test_JWKSFileValidator(t *testing.T) {
+	key := rsaKey(t)
+	b, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{publicKey(key, "file-key")}})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, ioutil.WriteFile(path, b, 0600))
+
+	validator, err := NewJWKSFileValidator(path, issuer, audience)
+	require.NoError(t, err)
+
+	claims := jwt.Claims{
+		Issuer:   issuer,
+		Audience: jwt.Audience{audience},
+		Subject:  "gateway@clients",
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+
+	validated, err := validator.Validate(context.Background(), mint(t, key, jose.RS256, "file-key", claims))
+	require.NoError(t, err)
+	assert.Equal(t, "gateway@clients", validated.Subject)
+
+	_, err = validator.Validate(context.Background(), mint(t, key, jose.RS256, "unknown-key", claims))
+	assert.Error(t, err)
+
+	_, err = validator.Validate(context.Background(), mint(t, rsaKey(t), jose.RS256, "file-key", claims))
+	assert.Error(t, err)
+
+	_, err = validator.Validate(context.Background(), mint(t, secret, jose.HS256, "file-key", claims))
+	assert.Error(t, err)
+
+	_, err = NewJWKSFileValidator(filepath.Join(t.TempDir(), "missing.json"), issuer, audience)
+	assert.Error(t, err)
+}
+
+func Test_RemoteJWKSValidator(t *testing.T) {
+	first, second := rsaKey(t), rsaKey(t)
+
+	var mu sync.Mutex
+	fetches := 0
+	set := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{publicKey(first, "first")}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		fetches++
+		require.NoError(t, json.NewEncoder(w).Encode(set))
+	}))
+	defer srv.Close()
+
+	remote := NewRemoteJWKSValidator(srv.URL, issuer, audience, time.Hour)
+	remote.(*validator).keys.(*remoteKeys).minRefresh = 0
+
+	claims := jwt.Claims{
+		Issuer:   issuer,
+		Audience: jwt.Audience{audience},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+
+	_, err := remote.Validate(context.Background(), mint(t, first, jose.RS256, "first", claims))
+	require.NoError(t, err)
+	_, err = remote.Validate(context.Background(), mint(t, first, jose.RS256, "first", claims))
+	require.NoError(t, err)
+	assert.Equal(t, 1, fetches, "key set should be cached")
+
+	mu.Lock()
+	set = jose.JSONWebKeySet{Keys: []jose.JSONWebKey{publicKey(second, "second")}}
+	mu.Unlock()
+
+	_, err = remote.Validate(context.Background(), mint(t, second, jose.RS256, "second", claims))
+	require.NoError(t, err, "rotated key should be fetched")
+	assert.Equal(t, 2, fetches)
+
+	_, err = remote.Validate(context.Background(), mint(t, first, jose.RS256, "first", claims))
+	assert.Error(t, err, "retired key should be rejected")
+}
+
+func Test_RemoteJWKSValidatorSlowFetch(t *testing.T) {
+	key := rsaKey(t)
+
+	var mu sync.Mutex
+	fetches := 0
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		fetches++
+		n := fetches
+		mu.Unlock()
+		if n > 1 {
+			<-release
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{publicKey(key, "first")}}))
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	remote := NewRemoteJWKSValidator(srv.URL, issuer, audience, time.Hour)
+	keys := remote.(*validator).keys.(*remoteKeys)
+	keys.minRefresh = 0
+
+	token := mint(t, key, jose.RS256, "first", jwt.Claims{
+		Issuer:   issuer,
+		Audience: jwt.Audience{audience},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	})
+
+	_, err := remote.Validate(context.Background(), token)
+	require.NoError(t, err)
+
+	// the key set goes stale, and its refetch is held by the identity provider
+	keys.ttl = 0
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			started := time.Now()
+			_, err := remote.Validate(context.Background(), token)
+			assert.NoError(t, err)
+			assert.Less(t, int64(time.Since(started)), int64(100*time.Millisecond), "known keys should be used while the key set is fetched")
+		}()
+	}
+	wg.Wait()
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return fetches == 2
+	}, time.Second, 10*time.Millisecond, "concurrent calls should share one fetch")
+}
+
+func Test_RemoteJWKSValidatorUnavailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	validator := NewRemoteJWKSValidator(srv.URL, issuer, audience, time.Hour)
+
+	_, err := validator.Validate(context.Background(), mint(t, rsaKey(t), jose.RS256, "first", jwt.Claims{
+		Issuer:   issuer,
+		Audience: jwt.Audience{audience},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}))
+	assert.Error(t, err)
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func mint(t *testing.T, key interface{}, alg jose.SignatureAlgorithm, kid string, claims jwt.Claims, scope ...string) string {
+	opts := (&jose.SignerOptions{}).WithType("JWT")
+	if kid != "" {
+		opts = opts.WithHeader("kid", kid)
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: key}, opts)
+	require.NoError(t, err)
+
+	builder := jwt.Signed(signer).Claims(claims)
+	if len(scope) > 0 && scope[0] != "" {
+		builder = builder.Claims(map[string]interface{}{"scope": scope[0]})
+	}
+
+	raw, err := builder.CompactSerialize()
+	require.NoError(t, err)
+
+	return raw
+}
+
+func with(claims jwt.Claims, change func(*jwt.Claims)) jwt.Claims {
+	change(&claims)
+	return claims
+}
+
+func rsaKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	return key
+}
+
+func publicKey(key *rsa.PrivateKey, kid string) jose.JSONWebKey {
+	return jose.JSONWebKey{Key: &key.PublicKey, KeyID: kid, Algorithm: string(jose.RS256), Use: "sig"}
+}
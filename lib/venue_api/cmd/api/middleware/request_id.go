@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"context"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDKey is the metadata the gateway sends the id of the request a call was made for in.
+const RequestIDKey = "x-request-id"
+
+const maxRequestIDLength = 128
+
+// RequestID returns interceptors tagging calls with the id of the request they were made for, so the calls are logged
+// with it. They must run after the grpc_ctxtags interceptors and before the logging ones.
+func RequestID() (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	tag := func(ctx context.Context) {
+		if id := requestID(ctx); id != "" {
+			grpc_ctxtags.Extract(ctx).Set("request_id", id)
+		}
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+			tag(ctx)
+			return handler(ctx, req)
+		}, func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			tag(ss.Context())
+			return handler(srv, ss)
+		}
+}
+
+// requestID returns the id sent with the call, ignoring one that is not printable ascii so it cannot break up log lines.
+func requestID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(RequestIDKey)
+	if len(values) == 0 || len(values[0]) > maxRequestIDLength {
+		return ""
+	}
+	for _, r := range values[0] {
+		if r < '!' || r > '~' {
+			return ""
+		}
+	}
+
+	return values[0]
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"context"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"strings"
+	"testing"
+)
+
+func Test_RequestID(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata metadata.MD
+		expected interface{}
+	}{
+		{name: "request id", metadata: metadata.Pairs(RequestIDKey, "4f1c2a"), expected: "4f1c2a"},
+		{name: "no request id", metadata: metadata.MD{}},
+		{name: "request id with a newline", metadata: metadata.Pairs(RequestIDKey, "4f1c2a\nforged log line")},
+		{name: "request id too long", metadata: metadata.Pairs(RequestIDKey, strings.Repeat("a", 129))},
+	}
+
+	tagRequestID, _ := RequestID()
+	tag := grpc_ctxtags.UnaryServerInterceptor()
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), test.metadata)
+			info := &grpc.UnaryServerInfo{FullMethod: "/venue.api.VenueAPI/GetVenue"}
+
+			var tags map[string]interface{}
+			_, err := tag(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return tagRequestID(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+					tags = grpc_ctxtags.Extract(ctx).Values()
+					return nil, nil
+				})
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, tags["request_id"])
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RequireScope returns interceptors rejecting calls whose token lacks the scope the policy gives for the method. Methods
+// missing from the policy are rejected, so a new RPC is unreachable until it is given a scope. It must run after
+// EnsureValidToken, and lets calls to the given public services through.
+func RequireScope(policy map[string]string, public ...string) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	authorize := func(ctx context.Context, method string) error {
+		scope, ok := policy[method]
+		if !ok {
+			return status.Errorf(codes.PermissionDenied, "no scope grants access to '%s'", method)
+		}
+
+		claims, ok := ClaimsFromContext(ctx)
+		if !ok {
+			return status.Errorf(codes.Unauthenticated, "missing token claims")
+		}
+
+		if !claims.HasScope(scope) {
+			return status.Errorf(codes.PermissionDenied, "token lacks scope '%s'", scope)
+		}
+
+		return nil
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+			if isPublic(info.FullMethod, public) {
+				return handler(ctx, req)
+			}
+
+			if err := authorize(ctx, info.FullMethod); err != nil {
+				return nil, err
+			}
+
+			return handler(ctx, req)
+		}, func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if isPublic(info.FullMethod, public) {
+				return handler(srv, ss)
+			}
+
+			if err := authorize(ss.Context(), info.FullMethod); err != nil {
+				return err
+			}
+
+			return handler(srv, ss)
+		}
+}
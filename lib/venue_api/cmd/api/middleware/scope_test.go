@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+	"testing"
+	"time"
+)
+
+func Test_RequireScope(t *testing.T) {
+	policy := map[string]string{
+		"/venue.api.VenueAPI/GetVenue": "venue:read",
+		"/venue.api.VenueAPI/AddAdmin": "venue:admin",
+	}
+
+	tests := []struct {
+		name   string
+		scope  string
+		method string
+		code   codes.Code
+	}{
+		{name: "granted scope", scope: "venue:read", method: "/venue.api.VenueAPI/GetVenue", code: codes.OK},
+		{name: "one of many scopes", scope: "venue:read venue:admin", method: "/venue.api.VenueAPI/AddAdmin", code: codes.OK},
+		{name: "missing scope", scope: "venue:read venue:write", method: "/venue.api.VenueAPI/AddAdmin", code: codes.PermissionDenied},
+		{name: "no scopes", method: "/venue.api.VenueAPI/GetVenue", code: codes.PermissionDenied},
+		{name: "scope prefix", scope: "venue:read-only", method: "/venue.api.VenueAPI/GetVenue", code: codes.PermissionDenied},
+		{name: "method without policy", scope: "venue:read venue:write venue:admin", method: "/venue.api.VenueAPI/CreateVenue", code: codes.PermissionDenied},
+		{name: "public service", method: "/grpc.health.v1.Health/Check", code: codes.OK},
+	}
+
+	ensureValidToken, ensureValidStreamToken := EnsureValidToken(NewStaticKeyValidator(secret, issuer, audience), "grpc.health.v1.Health")
+	requireScope, requireStreamScope := RequireScope(policy, "grpc.health.v1.Health")
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			token := mint(t, secret, jose.HS256, "", jwt.Claims{
+				Issuer:   issuer,
+				Audience: jwt.Audience{audience},
+				Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			}, test.scope)
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+			called := false
+			_, err := ensureValidToken(ctx, nil, &grpc.UnaryServerInfo{FullMethod: test.method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return requireScope(ctx, req, &grpc.UnaryServerInfo{FullMethod: test.method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+					called = true
+					return nil, nil
+				})
+			})
+			assert.Equal(t, test.code, status.Code(err))
+			assert.Equal(t, test.code == codes.OK, called)
+
+			called = false
+			info := &grpc.StreamServerInfo{FullMethod: test.method}
+			err = ensureValidStreamToken(nil, &serverStream{ctx: ctx}, info, func(srv interface{}, ss grpc.ServerStream) error {
+				return requireStreamScope(srv, ss, info, func(srv interface{}, ss grpc.ServerStream) error {
+					called = true
+					return nil
+				})
+			})
+			assert.Equal(t, test.code, status.Code(err))
+			assert.Equal(t, test.code == codes.OK, called)
+		})
+	}
+}
+
+func Test_RequireScopeWithoutClaims(t *testing.T) {
+	requireScope, _ := RequireScope(map[string]string{"/venue.api.VenueAPI/GetVenue": "venue:read"})
+
+	_, err := requireScope(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/venue.api.VenueAPI/GetVenue"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,276 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"golang.org/x/sync/singleflight"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultJWKSCacheTTL = time.Hour
+	// minJWKSRefresh limits how often the key set is fetched, so tokens with made up key ids or an identity provider
+	// outage cannot turn every call into a request for keys
+	minJWKSRefresh = time.Minute
+)
+
+// TokenValidator checks a raw bearer token, returning its claims if it is valid.
+type TokenValidator interface {
+	Validate(ctx context.Context, token string) (*Claims, error)
+}
+
+// Claims are the claims of a validated token. Scope holds the space separated scopes granted to the caller.
+type Claims struct {
+	jwt.Claims
+	Scope string `json:"scope,omitempty"`
+}
+
+// HasScope reports whether the token was granted the given scope.
+func (c *Claims) HasScope(scope string) bool {
+	for _, s := range strings.Fields(c.Scope) {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+type keySource interface {
+	keys(ctx context.Context, kid string) ([]jose.JSONWebKey, error)
+}
+
+type validator struct {
+	keys       keySource
+	algorithms []jose.SignatureAlgorithm
+	issuer     string
+	audience   string
+}
+
+// NewRemoteJWKSValidator validates RS256 tokens against the key set served at the given url. The key set is cached for
+// the given duration, and fetched again early when a token is signed with a key it does not contain, so keys can be
+// rotated without restarting the service.
+func NewRemoteJWKSValidator(url, issuer, audience string, ttl time.Duration) TokenValidator {
+	if ttl <= 0 {
+		ttl = defaultJWKSCacheTTL
+	}
+
+	return &validator{
+		keys: &remoteKeys{
+			url:        url,
+			ttl:        ttl,
+			minRefresh: minJWKSRefresh,
+			client:     &http.Client{Timeout: 10 * time.Second},
+		},
+		algorithms: []jose.SignatureAlgorithm{jose.RS256},
+		issuer:     issuer,
+		audience:   audience,
+	}
+}
+
+// NewJWKSFileValidator validates RS256 tokens against the key set in the given file, which is read once.
+func NewJWKSFileValidator(path, issuer, audience string) (TokenValidator, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read key set : %w", err)
+	}
+
+	set := jose.JSONWebKeySet{}
+	if err := json.Unmarshal(b, &set); err != nil {
+		return nil, fmt.Errorf("could not unmarshal key set : %w", err)
+	}
+
+	return &validator{
+		keys:       staticKeys(set),
+		algorithms: []jose.SignatureAlgorithm{jose.RS256},
+		issuer:     issuer,
+		audience:   audience,
+	}, nil
+}
+
+// NewStaticKeyValidator validates HS256 tokens signed with the given shared secret. It is meant for local development
+// where there is no identity provider to fetch keys from.
+func NewStaticKeyValidator(secret []byte, issuer, audience string) TokenValidator {
+	return &validator{
+		keys:       staticKeys(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: secret, Algorithm: string(jose.HS256)}}}),
+		algorithms: []jose.SignatureAlgorithm{jose.HS256},
+		issuer:     issuer,
+		audience:   audience,
+	}
+}
+
+func (v *validator) Validate(ctx context.Context, raw string) (*Claims, error) {
+	token, err := jwt.ParseSigned(raw)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse token : %w", err)
+	}
+	if len(token.Headers) != 1 {
+		return nil, fmt.Errorf("token must have exactly one signature")
+	}
+
+	header := token.Headers[0]
+	if !v.allowed(header.Algorithm) {
+		return nil, fmt.Errorf("token algorithm '%s' is not allowed", header.Algorithm)
+	}
+
+	keys, err := v.keys.keys(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &Claims{}
+	verified := false
+	for i := range keys {
+		if err := token.Claims(keys[i].Key, claims); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, fmt.Errorf("could not verify token signature")
+	}
+
+	if claims.Expiry == nil {
+		return nil, fmt.Errorf("token has no expiry")
+	}
+	if err := claims.Validate(jwt.Expected{
+		Issuer:   v.issuer,
+		Audience: jwt.Audience{v.audience},
+		Time:     time.Now(),
+	}); err != nil {
+		return nil, fmt.Errorf("invalid token claims : %w", err)
+	}
+
+	return claims, nil
+}
+
+func (v *validator) allowed(alg string) bool {
+	for i := range v.algorithms {
+		if string(v.algorithms[i]) == alg {
+			return true
+		}
+	}
+
+	return false
+}
+
+type staticKeys jose.JSONWebKeySet
+
+func (s staticKeys) keys(ctx context.Context, kid string) ([]jose.JSONWebKey, error) {
+	set := jose.JSONWebKeySet(s)
+	if kid == "" {
+		return set.Keys, nil
+	}
+
+	keys := set.Key(kid)
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no key with id '%s'", kid)
+	}
+
+	return keys, nil
+}
+
+type remoteKeys struct {
+	url        string
+	ttl        time.Duration
+	minRefresh time.Duration
+	client     *http.Client
+	// fetches coalesces concurrent fetches of the key set into one, which is made without holding the lock
+	fetches singleflight.Group
+
+	mu        sync.Mutex
+	set       jose.JSONWebKeySet
+	fetched   time.Time
+	attempted time.Time
+}
+
+func (r *remoteKeys) keys(ctx context.Context, kid string) ([]jose.JSONWebKey, error) {
+	r.mu.Lock()
+	keys := r.set.Key(kid)
+	stale := time.Since(r.fetched) > r.ttl
+	r.mu.Unlock()
+
+	// a stale key set is refreshed in the background while its keys are still used, calls only wait on a fetch when they
+	// need a key the set does not have
+	if len(keys) > 0 {
+		if stale {
+			r.fetches.DoChan("keys", r.refresh)
+		}
+		return keys, nil
+	}
+
+	if _, err, _ := r.fetches.Do("keys", r.refresh); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	keys = r.set.Key(kid)
+	r.mu.Unlock()
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no key with id '%s'", kid)
+	}
+
+	return keys, nil
+}
+
+// refresh fetches the key set, unless it was attempted too recently. A failed fetch keeps the previous keys, so an
+// identity provider outage does not reject tokens signed with keys already known.
+func (r *remoteKeys) refresh() (interface{}, error) {
+	r.mu.Lock()
+	if time.Since(r.attempted) <= r.minRefresh {
+		r.mu.Unlock()
+		return nil, nil
+	}
+	r.attempted = time.Now()
+	r.mu.Unlock()
+
+	set, err := r.fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.set = set
+	r.fetched = time.Now()
+	r.mu.Unlock()
+
+	return nil, nil
+}
+
+// fetch requests the key set. The request is shared by every call waiting on it, so it is not tied to any one call's
+// context, and is bounded by the client's timeout instead.
+func (r *remoteKeys) fetch() (jose.JSONWebKeySet, error) {
+	req, err := http.NewRequest(http.MethodGet, r.url, nil)
+	if err != nil {
+		return jose.JSONWebKeySet{}, fmt.Errorf("could not construct key set request : %w", err)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return jose.JSONWebKeySet{}, fmt.Errorf("could not fetch key set : %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return jose.JSONWebKeySet{}, fmt.Errorf("status code '%v' received fetching key set", resp.StatusCode)
+	}
+
+	set := jose.JSONWebKeySet{}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return jose.JSONWebKeySet{}, fmt.Errorf("could not decode key set : %w", err)
+	}
+	if len(set.Keys) == 0 {
+		return jose.JSONWebKeySet{}, errors.New("key set has no keys")
+	}
+
+	return set, nil
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"github.com/cobbinma/booking-platform/lib/venue_api/internal/postgres"
+	"go.uber.org/zap"
+	"strconv"
+)
+
+const migrateUsage = "usage: migrate up | down N | goto VERSION | force VERSION | status"
+
+// runMigrate handles the 'migrate' subcommand, which manages the schema of the postgres store without starting the
+// gRPC server.
+func runMigrate(log *zap.SugaredLogger, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf(migrateUsage)
+	}
+
+	m, closeMigrator, err := postgres.NewMigrator(log)
+	if err != nil {
+		return fmt.Errorf("could not construct migrator : %w", err)
+	}
+	defer closeMigrator(log)
+
+	switch args[0] {
+	case "up":
+		if len(args) != 1 {
+			return fmt.Errorf(migrateUsage)
+		}
+		return m.Up()
+	case "down":
+		if len(args) != 2 {
+			return fmt.Errorf(migrateUsage)
+		}
+		steps, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("could not parse number of migrations : %w", err)
+		}
+		return m.Down(steps)
+	case "goto":
+		if len(args) != 2 {
+			return fmt.Errorf(migrateUsage)
+		}
+		version, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("could not parse version : %w", err)
+		}
+		return m.Goto(uint(version))
+	case "force":
+		if len(args) != 2 {
+			return fmt.Errorf(migrateUsage)
+		}
+		version, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("could not parse version : %w", err)
+		}
+		return m.Force(version)
+	case "status":
+		if len(args) != 1 {
+			return fmt.Errorf(migrateUsage)
+		}
+		version, dirty, err := m.Status()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("version %d, dirty %t\n", version, dirty)
+		return nil
+	default:
+		return fmt.Errorf(migrateUsage)
+	}
+}
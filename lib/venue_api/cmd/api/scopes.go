@@ -0,0 +1,38 @@
+package main
+
+const (
+	scopeRead  = "venue:read"
+	scopeWrite = "venue:write"
+	scopeAdmin = "venue:admin"
+	// scopeAPIKeys lists and verifies api keys, and is only granted to the gateway, which authenticates callers by them
+	scopeAPIKeys = "venue:apikeys"
+)
+
+// scopes gives the scope a token must be granted to call each venue RPC. Creating venues, managing administrators and
+// issuing api keys need the admin scope, which should only be granted to trusted clients. Reading api keys needs the api
+// keys scope, so services that only read venues cannot learn or check keys.
+var scopes = map[string]string{
+	"/" + serviceName + "/GetVenue":                          scopeRead,
+	"/" + serviceName + "/GetOpeningHoursSpecification":      scopeRead,
+	"/" + serviceName + "/BatchGetVenues":                    scopeRead,
+	"/" + serviceName + "/GetOpeningHoursSpecificationBatch": scopeRead,
+	"/" + serviceName + "/GetTablesBatch":                    scopeRead,
+	"/" + serviceName + "/IsAdminBatch":                      scopeRead,
+	"/" + serviceName + "/GetAdminsBatch":                    scopeRead,
+	"/" + serviceName + "/GetTables":                         scopeRead,
+	"/" + serviceName + "/IsAdmin":                           scopeRead,
+	"/" + serviceName + "/GetAdmins":                         scopeRead,
+	"/" + serviceName + "/WatchVenue":                        scopeRead,
+	"/" + serviceName + "/WatchVenues":                       scopeRead,
+	"/" + serviceName + "/GetApiKeys":                        scopeAPIKeys,
+	"/" + serviceName + "/VerifyApiKey":                      scopeAPIKeys,
+	"/" + serviceName + "/UpdateOpeningHours":                scopeWrite,
+	"/" + serviceName + "/UpdateSpecialOpeningHours":         scopeWrite,
+	"/" + serviceName + "/AddTable":                          scopeWrite,
+	"/" + serviceName + "/RemoveTable":                       scopeWrite,
+	"/" + serviceName + "/CreateVenue":                       scopeAdmin,
+	"/" + serviceName + "/AddAdmin":                          scopeAdmin,
+	"/" + serviceName + "/RemoveAdmin":                       scopeAdmin,
+	"/" + serviceName + "/CreateApiKey":                      scopeAdmin,
+	"/" + serviceName + "/RevokeApiKey":                      scopeAdmin,
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_ScopesCoverEveryMethod(t *testing.T) {
+	service := api.File_src_venue_api_service_proto.Services().ByName("VenueAPI")
+	assert.Equal(t, serviceName, string(service.FullName()))
+
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		method := fmt.Sprintf("/%s/%s", service.FullName(), methods.Get(i).Name())
+		assert.Contains(t, scopes, method, "method has no scope")
+	}
+	assert.Len(t, scopes, methods.Len())
+}
+
+func Test_ScopesAPIKeys(t *testing.T) {
+	for _, method := range []string{"GetApiKeys", "VerifyApiKey"} {
+		method := "/" + serviceName + "/" + method
+		assert.Equal(t, scopeAPIKeys, scopes[method], "api keys must not be readable with the scopes other services are granted")
+	}
+	for _, method := range []string{"CreateApiKey", "RevokeApiKey"} {
+		assert.Equal(t, scopeAdmin, scopes["/"+serviceName+"/"+method])
+	}
+}
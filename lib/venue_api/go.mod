@@ -1,23 +1,32 @@
 module github.com/cobbinma/booking-platform/lib/venue_api
 
-go 1.14
+go 1.16
 
 require (
 	github.com/Masterminds/squirrel v1.5.0
-	github.com/auth0-community/go-auth0 v1.0.1-0.20191119091237-b9b0f95be568
+	github.com/XSAM/otelsql v0.3.0
 	github.com/bradleyjkemp/cupaloy/v2 v2.6.0
 	github.com/cobbinma/booking-platform/lib/protobuf v0.0.0
+	github.com/cobbinma/booking-platform/lib/tracing v0.0.0
 	github.com/golang-migrate/migrate/v4 v4.14.1
 	github.com/google/uuid v1.1.2
 	github.com/grpc-ecosystem/go-grpc-middleware v1.2.2
+	github.com/grpc-ecosystem/go-grpc-prometheus v1.2.0
 	github.com/jmoiron/sqlx v1.3.1
 	github.com/joho/godotenv v1.3.0
 	github.com/lib/pq v1.9.0
 	github.com/ory/dockertest/v3 v3.6.3
-	github.com/stretchr/testify v1.6.1
+	github.com/prometheus/client_golang v1.10.0
+	github.com/stretchr/testify v1.7.0
+	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.20.0
+	go.opentelemetry.io/otel v0.20.0
 	go.uber.org/zap v1.16.0
-	google.golang.org/grpc v1.35.0
+	golang.org/x/sync v0.0.0-20201207232520-09787c993a3a
+	google.golang.org/grpc v1.37.0
+	google.golang.org/protobuf v1.26.0
 	gopkg.in/square/go-jose.v2 v2.5.1
 )
 
 replace github.com/cobbinma/booking-platform/lib/protobuf v0.0.0 => ./.protobuf
+
+replace github.com/cobbinma/booking-platform/lib/tracing v0.0.0 => ./.tracing
@@ -0,0 +1,52 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const (
+	// keyPrefix marks our keys so they are recognisable in logs and to secret scanners
+	keyPrefix = "bpk_"
+	// displayLength is how much of a key is kept in the clear so admins can tell their keys apart
+	displayLength = len(keyPrefix) + 8
+	secretSize    = 32
+)
+
+// New returns a random key, the prefix of it that can be shown to admins, and the hash of it that is stored.
+func New() (key string, prefix string, hash string, err error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", "", fmt.Errorf("could not generate key : %w", err)
+	}
+
+	key = keyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	return key, key[:displayLength], Hash(key), nil
+}
+
+// Hash returns the hash a key is stored and looked up by. Keys are long and random, so a fast hash is enough to stop
+// a leaked table being used to call the api.
+func Hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Validate checks a request to create a key names the key and gives it a role.
+func Validate(name string, role models.ApiKeyRole) error {
+	if strings.TrimSpace(name) == "" {
+		return status.Error(codes.InvalidArgument, "api key name must be given")
+	}
+	if _, ok := models.ApiKeyRole_name[int32(role)]; !ok || role == models.ApiKeyRole_UNKNOWN_ROLE {
+		return status.Error(codes.InvalidArgument, "api key role must be given")
+	}
+
+	return nil
+}
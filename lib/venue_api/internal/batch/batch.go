@@ -0,0 +1,35 @@
+package batch
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxSize is the most venues a batch request may ask for, so one call cannot hold a database connection for long.
+const MaxSize = 100
+
+// IDs checks the venue ids of a batch request, returning them with any given more than once removed.
+func IDs(ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "venue ids must be given")
+	}
+
+	unique := make([]string, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if id == "" {
+			return nil, status.Error(codes.InvalidArgument, "venue ids must not be empty")
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+
+	if len(unique) > MaxSize {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d venues may be requested at once", MaxSize)
+	}
+
+	return unique, nil
+}
@@ -0,0 +1,92 @@
+package conformance
+
+import (
+	"context"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+var _ api.VenueAPI_WatchVenueServer = (*eventStream)(nil)
+var _ api.VenueAPI_WatchVenuesServer = (*eventStream)(nil)
+
+// eventStream stands in for the server side of a watch stream, handing every sent event to the test.
+type eventStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *api.VenueEvent
+}
+
+func newEventStream(ctx context.Context) *eventStream {
+	return &eventStream{ctx: ctx, events: make(chan *api.VenueEvent)}
+}
+
+func (s *eventStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *eventStream) Send(event *api.VenueEvent) error {
+	select {
+	case s.events <- event:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+// watch runs the watcher until n events have been received, calling during once the watcher has started. The watcher
+// must stop with codes.Canceled when its stream is cancelled.
+func watch(t *testing.T, n int, watcher func(*eventStream) error, during func()) []*api.VenueEvent {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream := newEventStream(ctx)
+	done := make(chan error, 1)
+	go func() {
+		done <- watcher(stream)
+	}()
+
+	if during != nil {
+		during()
+	}
+
+	events := []*api.VenueEvent{}
+	for len(events) < n {
+		select {
+		case event := <-stream.events:
+			events = append(events, event)
+		case err := <-done:
+			require.FailNow(t, "watcher stopped early", "error : %s", err)
+		case <-ctx.Done():
+			require.FailNow(t, "timed out waiting for events", "received %d of %d", len(events), n)
+		}
+	}
+
+	cancel()
+	assert.Equal(t, codes.Canceled, status.Code(<-done))
+
+	return events
+}
+
+func eventTypes(events []*api.VenueEvent) []api.VenueEventType {
+	types := []api.VenueEventType{}
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+
+	return types
+}
+
+func eventVersions(events []*api.VenueEvent) []int64 {
+	versions := []int64{}
+	for _, event := range events {
+		versions = append(versions, event.Version)
+	}
+
+	return versions
+}
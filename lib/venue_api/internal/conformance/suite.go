@@ -0,0 +1,726 @@
+package conformance
+
+import (
+	"context"
+	"github.com/bradleyjkemp/cupaloy/v2"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// Run checks that an empty venue store behaves as every api.VenueAPIServer implementation must. The store has to be
+// constructed with a static UUID generator returning UUID. The cases build on each other so they run in order, and
+// every store is compared against the same snapshots, kept in the .snapshots directory of this package.
+func Run(t *testing.T, repository api.VenueAPIServer) {
+	s := suite(repository)
+	for i := range s {
+		t.Run(s[i].name, s[i].test)
+	}
+}
+
+var snapshots = cupaloy.New(cupaloy.UseStringerMethods(false), cupaloy.SnapshotSubdirectory(snapshotDir()))
+
+// snapshotDir returns the .snapshots directory beside this file, wherever the suite is run from.
+func snapshotDir() string {
+	_, file, _, _ := runtime.Caller(0)
+
+	return filepath.Join(filepath.Dir(file), ".snapshots")
+}
+
+// UUID is the identifier every entity created during the suite is given.
+const UUID = "b31a9f99-3f64-4ee9-af27-45b2acd36d86"
+
+type test struct {
+	name string
+	test func(t *testing.T)
+}
+
+func suite(repository api.VenueAPIServer) []test {
+	var events []*api.VenueEvent
+	var key string
+	const Slug = "test-venue"
+	return []test{
+		{
+			name: "add venue successfully",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				venue, err := repository.CreateVenue(ctx, &api.CreateVenueRequest{
+					Name: "Test Venue",
+					OpeningHours: []*models.OpeningHoursSpecification{
+						{
+							DayOfWeek:    1,
+							Opens:        "10:00",
+							Closes:       "20:00",
+							ValidFrom:    "",
+							ValidThrough: "",
+						},
+						{
+							DayOfWeek:    2,
+							Opens:        "10:00",
+							Closes:       "22:00",
+							ValidFrom:    "",
+							ValidThrough: "",
+						},
+					},
+					Slug: Slug,
+				})
+				require.NoError(t, err)
+
+				snapshots.SnapshotT(t, venue)
+			},
+		},
+		{
+			name: "get venue successfully",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				venues, err := repository.GetVenue(ctx, &api.GetVenueRequest{Id: UUID})
+				require.NoError(t, err)
+
+				snapshots.SnapshotT(t, venues)
+			},
+		},
+		{
+			name: "get venue by slug successfully",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				venues, err := repository.GetVenue(ctx, &api.GetVenueRequest{Slug: Slug})
+				require.NoError(t, err)
+
+				snapshots.SnapshotT(t, venues)
+			},
+		},
+		{
+			name: "batch get venues",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				resp, err := repository.BatchGetVenues(ctx, &api.BatchGetVenuesRequest{Ids: []string{UUID, uuid.New().String(), UUID}})
+				require.NoError(t, err)
+
+				venue, err := repository.GetVenue(ctx, &api.GetVenueRequest{Id: UUID})
+				require.NoError(t, err)
+
+				require.Len(t, resp.Venues, 1, "unknown venues should be left out and repeated ones only returned once")
+				assert.Equal(t, venue.String(), resp.Venues[0].String())
+			},
+		},
+		{
+			name: "batch get venues without ids",
+			test: func(t *testing.T) {
+				_, err := repository.BatchGetVenues(context.Background(), &api.BatchGetVenuesRequest{})
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "batch get too many venues",
+			test: func(t *testing.T) {
+				ids := make([]string, 101)
+				for i := range ids {
+					ids[i] = uuid.New().String()
+				}
+
+				_, err := repository.BatchGetVenues(context.Background(), &api.BatchGetVenuesRequest{Ids: ids})
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "update venue opening hours",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				_, err := repository.UpdateOpeningHours(ctx, &api.UpdateOpeningHoursRequest{VenueId: UUID, Version: 1, OpeningHours: []*models.OpeningHoursSpecification{
+					{
+						DayOfWeek:    2,
+						Opens:        "11:00",
+						Closes:       "22:00",
+						ValidFrom:    "",
+						ValidThrough: "",
+					},
+					{
+						DayOfWeek:    3,
+						Opens:        "10:30",
+						Closes:       "23:00",
+						ValidFrom:    "",
+						ValidThrough: "",
+					},
+				}})
+				require.NoError(t, err)
+
+				venue, err := repository.GetVenue(ctx, &api.GetVenueRequest{Id: UUID})
+				require.NoError(t, err)
+
+				snapshots.SnapshotT(t, venue)
+			},
+		},
+		{
+			name: "update venue special opening hours",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				_, err := repository.UpdateSpecialOpeningHours(ctx, &api.UpdateOpeningHoursRequest{VenueId: UUID, Version: 2, OpeningHours: []*models.OpeningHoursSpecification{
+					{
+						DayOfWeek:    2,
+						Opens:        "11:00",
+						Closes:       "22:00",
+						ValidFrom:    "3000-11-01T00:00:00Z",
+						ValidThrough: "3000-12-01T00:00:00Z",
+					},
+					{
+						DayOfWeek:    3,
+						Opens:        "11:00",
+						Closes:       "23:00",
+						ValidFrom:    "3000-11-01T00:00:00Z",
+						ValidThrough: "3000-12-01T00:00:00Z",
+					},
+				}})
+				require.NoError(t, err)
+
+				venue, err := repository.GetVenue(ctx, &api.GetVenueRequest{Id: UUID})
+				require.NoError(t, err)
+
+				snapshots.SnapshotT(t, venue)
+			},
+		},
+		{
+			name: "update venue opening hours with stale version",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				_, err := repository.UpdateOpeningHours(ctx, &api.UpdateOpeningHoursRequest{VenueId: UUID, Version: 1, OpeningHours: nil})
+				require.Equal(t, codes.Aborted, status.Code(err))
+
+				venue, err := repository.GetVenue(ctx, &api.GetVenueRequest{Id: UUID})
+				require.NoError(t, err)
+
+				assert.Equal(t, int64(3), venue.Version)
+				assert.Equal(t, 2, len(venue.OpeningHours))
+			},
+		},
+		{
+			name: "update venue opening hours without version",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				_, err := repository.UpdateOpeningHours(ctx, &api.UpdateOpeningHoursRequest{VenueId: UUID, OpeningHours: nil})
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "update unknown venue opening hours",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				_, err := repository.UpdateOpeningHours(ctx, &api.UpdateOpeningHoursRequest{VenueId: uuid.New().String(), Version: 1, OpeningHours: nil})
+				require.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "get venue with cancelled context",
+			test: func(t *testing.T) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				_, err := repository.GetVenue(ctx, &api.GetVenueRequest{Id: UUID})
+				require.Equal(t, codes.Canceled, status.Code(err))
+			},
+		},
+		{
+			name: "update opening hours with cancelled context keeps version",
+			test: func(t *testing.T) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				_, err := repository.UpdateOpeningHours(ctx, &api.UpdateOpeningHoursRequest{VenueId: UUID, Version: 3, OpeningHours: nil})
+				require.Equal(t, codes.Canceled, status.Code(err))
+
+				venue, err := repository.GetVenue(context.Background(), &api.GetVenueRequest{Id: UUID})
+				require.NoError(t, err)
+				assert.Equal(t, int64(3), venue.Version)
+			},
+		},
+		{
+			name: "get opening hours not found",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				_, err := repository.GetOpeningHoursSpecification(ctx, &api.GetOpeningHoursSpecificationRequest{
+					VenueId: UUID,
+					Date:    "3000-11-14T00:00:00Z",
+				})
+				require.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "get opening hours",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				hours, err := repository.GetOpeningHoursSpecification(ctx, &api.GetOpeningHoursSpecificationRequest{
+					VenueId: UUID,
+					Date:    "3000-11-11T00:00:00Z",
+				})
+				require.NoError(t, err)
+
+				snapshots.SnapshotT(t, hours)
+			},
+		},
+		{
+			name: "batch get opening hours",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				resp, err := repository.GetOpeningHoursSpecificationBatch(ctx, &api.GetOpeningHoursSpecificationBatchRequest{
+					VenueIds: []string{UUID, uuid.New().String()},
+					Date:     "3000-11-11T00:00:00Z",
+				})
+				require.NoError(t, err)
+
+				hours, err := repository.GetOpeningHoursSpecification(ctx, &api.GetOpeningHoursSpecificationRequest{
+					VenueId: UUID,
+					Date:    "3000-11-11T00:00:00Z",
+				})
+				require.NoError(t, err)
+
+				require.Len(t, resp.Venues, 1)
+				assert.Equal(t, UUID, resp.Venues[0].VenueId)
+				assert.Equal(t, hours.Specification.String(), resp.Venues[0].Specification.String())
+
+				closed, err := repository.GetOpeningHoursSpecificationBatch(ctx, &api.GetOpeningHoursSpecificationBatchRequest{
+					VenueIds: []string{UUID},
+					Date:     "3000-11-14T00:00:00Z",
+				})
+				require.NoError(t, err)
+				assert.Empty(t, closed.Venues, "venues that are not open should be left out")
+			},
+		},
+		{
+			name: "update venue opening hours",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				_, err := repository.UpdateOpeningHours(ctx, &api.UpdateOpeningHoursRequest{VenueId: UUID, Version: 3, OpeningHours: nil})
+				require.NoError(t, err)
+
+				venue, err := repository.GetVenue(ctx, &api.GetVenueRequest{Id: UUID})
+				require.NoError(t, err)
+
+				assert.Equal(t, 0, len(venue.OpeningHours))
+			},
+		},
+		{
+			name: "update venue special opening hours with no entries",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				_, err := repository.UpdateSpecialOpeningHours(ctx, &api.UpdateOpeningHoursRequest{VenueId: UUID, Version: 4, OpeningHours: nil})
+				require.NoError(t, err)
+
+				venue, err := repository.GetVenue(ctx, &api.GetVenueRequest{Id: UUID})
+				require.NoError(t, err)
+
+				assert.Equal(t, 0, len(venue.SpecialOpeningHours))
+			},
+		},
+		{
+			name: "get opening hours on a sunday",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				_, err := repository.UpdateSpecialOpeningHours(ctx, &api.UpdateOpeningHoursRequest{
+					VenueId: UUID,
+					Version: 5,
+					OpeningHours: []*models.OpeningHoursSpecification{
+						{
+							DayOfWeek:    7,
+							Opens:        "11:00",
+							Closes:       "22:00",
+							ValidFrom:    "3000-11-01T00:00:00Z",
+							ValidThrough: "3000-12-01T00:00:00Z",
+						},
+					},
+				})
+				require.NoError(t, err)
+
+				hours, err := repository.GetOpeningHoursSpecification(ctx, &api.GetOpeningHoursSpecificationRequest{
+					VenueId: UUID,
+					Date:    "3000-11-16T00:00:00Z",
+				})
+				require.NoError(t, err)
+
+				snapshots.SnapshotT(t, hours)
+			},
+		},
+		{
+			name: "add table successfully",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				table, err := repository.AddTable(ctx, &api.AddTableRequest{
+					VenueId:  UUID,
+					Name:     "test table",
+					Capacity: 4,
+					Version:  6,
+				})
+				require.NoError(t, err)
+
+				snapshots.SnapshotT(t, table)
+			},
+		},
+		{
+			name: "get tables successfully",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				table, err := repository.GetTables(ctx, &api.GetTablesRequest{
+					VenueId: UUID})
+				require.NoError(t, err)
+
+				snapshots.SnapshotT(t, table)
+			},
+		},
+		{
+			name: "get tables of unknown venue",
+			test: func(t *testing.T) {
+				_, err := repository.GetTables(context.Background(), &api.GetTablesRequest{VenueId: uuid.New().String()})
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "batch get tables",
+			test: func(t *testing.T) {
+				resp, err := repository.GetTablesBatch(context.Background(), &api.GetTablesBatchRequest{
+					VenueIds: []string{UUID, uuid.New().String()},
+				})
+				require.NoError(t, err)
+
+				require.Len(t, resp.Venues, 1)
+				assert.Equal(t, UUID, resp.Venues[0].VenueId)
+				assert.Equal(t, int64(7), resp.Venues[0].Version)
+				require.Len(t, resp.Venues[0].Tables, 1)
+				assert.Equal(t, "test table", resp.Venues[0].Tables[0].Name)
+			},
+		},
+		{
+			name: "remove not found table",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				_, err := repository.RemoveTable(ctx, &api.RemoveTableRequest{
+					VenueId: UUID,
+					TableId: uuid.New().String(),
+					Version: 7,
+				})
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "remove table successfully",
+			test: func(t *testing.T) {
+				ctx := context.Background()
+				removed, err := repository.RemoveTable(ctx, &api.RemoveTableRequest{
+					VenueId: UUID,
+					TableId: UUID,
+					Version: 7,
+				})
+				require.NoError(t, err)
+
+				assert.Equal(t, &models.Table{
+					Id:       UUID,
+					Name:     "test table",
+					Capacity: 4,
+				}, removed)
+			},
+		},
+		{
+			name: "is not administrator",
+			test: func(t *testing.T) {
+				resp, err := repository.IsAdmin(context.Background(), &api.IsAdminRequest{
+					VenueId: UUID,
+					Email:   "test@test.com",
+				})
+				require.NoError(t, err)
+
+				assert.Equal(t, false, resp.IsAdmin)
+			},
+		},
+		{
+			name: "add administrator",
+			test: func(t *testing.T) {
+				venueID := UUID
+				email := "test@test.com"
+				resp, err := repository.AddAdmin(context.Background(), &api.AddAdminRequest{
+					VenueId: venueID,
+					Email:   email,
+				})
+				require.NoError(t, err)
+
+				assert.Equal(t, venueID, resp.VenueId)
+				assert.Equal(t, email, resp.Email)
+			},
+		},
+		{
+			name: "add duplicate administrator",
+			test: func(t *testing.T) {
+				_, err := repository.AddAdmin(context.Background(), &api.AddAdminRequest{
+					VenueId: UUID,
+					Email:   "test@test.com",
+				})
+				assert.Equal(t, codes.AlreadyExists, status.Code(err))
+			},
+		},
+		{
+			name: "is administrator",
+			test: func(t *testing.T) {
+				resp, err := repository.IsAdmin(context.Background(), &api.IsAdminRequest{
+					VenueId: UUID,
+					Email:   "test@test.com",
+				})
+				require.NoError(t, err)
+
+				assert.Equal(t, true, resp.IsAdmin)
+			},
+		},
+		{
+			name: "is administrator by slug",
+			test: func(t *testing.T) {
+				resp, err := repository.IsAdmin(context.Background(), &api.IsAdminRequest{
+					Slug:  Slug,
+					Email: "test@test.com",
+				})
+				require.NoError(t, err)
+
+				assert.Equal(t, true, resp.IsAdmin)
+			},
+		},
+		{
+			name: "batch is administrator",
+			test: func(t *testing.T) {
+				resp, err := repository.IsAdminBatch(context.Background(), &api.IsAdminBatchRequest{
+					VenueIds: []string{uuid.New().String(), UUID},
+					Email:    "test@test.com",
+				})
+				require.NoError(t, err)
+
+				assert.Equal(t, []string{UUID}, resp.VenueIds)
+			},
+		},
+		{
+			name: "get administrators",
+			test: func(t *testing.T) {
+				resp, err := repository.GetAdmins(context.Background(), &api.GetAdminsRequest{VenueId: UUID})
+				require.NoError(t, err)
+
+				require.Equal(t, 1, len(resp.Admins))
+				assert.Equal(t, "test@test.com", resp.Admins[0])
+			},
+		},
+		{
+			name: "batch get administrators",
+			test: func(t *testing.T) {
+				resp, err := repository.GetAdminsBatch(context.Background(), &api.GetAdminsBatchRequest{
+					VenueIds: []string{UUID, uuid.New().String()},
+				})
+				require.NoError(t, err)
+
+				require.Len(t, resp.Venues, 1)
+				assert.Equal(t, UUID, resp.Venues[0].VenueId)
+				assert.Equal(t, []string{"test@test.com"}, resp.Venues[0].Admins)
+			},
+		},
+		{
+			name: "remove administrator",
+			test: func(t *testing.T) {
+				venueID := UUID
+				email := "test@test.com"
+				resp, err := repository.RemoveAdmin(context.Background(), &api.RemoveAdminRequest{
+					VenueId: venueID,
+					Email:   email,
+				})
+				require.NoError(t, err)
+				require.Equal(t, email, resp.Email)
+
+				admin, err := repository.IsAdmin(context.Background(), &api.IsAdminRequest{
+					VenueId: venueID,
+					Email:   email,
+				})
+				require.NoError(t, err)
+
+				assert.Equal(t, false, admin.IsAdmin)
+			},
+		},
+		{
+			name: "get administrators none",
+			test: func(t *testing.T) {
+				resp, err := repository.GetAdmins(context.Background(), &api.GetAdminsRequest{VenueId: UUID})
+				require.NoError(t, err)
+
+				require.Equal(t, 0, len(resp.Admins))
+			},
+		},
+		{
+			name: "create api key",
+			test: func(t *testing.T) {
+				resp, err := repository.CreateApiKey(context.Background(), &api.CreateApiKeyRequest{
+					VenueId: UUID,
+					Name:    "till",
+					Role:    models.ApiKeyRole_MANAGE_BOOKINGS,
+				})
+				require.NoError(t, err)
+
+				key = resp.Key
+				assert.True(t, strings.HasPrefix(resp.Key, resp.ApiKey.Prefix))
+				assert.Equal(t, UUID, resp.ApiKey.Id)
+				assert.Equal(t, UUID, resp.ApiKey.VenueId)
+				assert.Equal(t, "till", resp.ApiKey.Name)
+				assert.Equal(t, models.ApiKeyRole_MANAGE_BOOKINGS, resp.ApiKey.Role)
+				assert.NotEmpty(t, resp.ApiKey.CreatedAt)
+				assert.Empty(t, resp.ApiKey.LastUsedAt)
+				assert.Empty(t, resp.ApiKey.RevokedAt)
+			},
+		},
+		{
+			name: "create api key without role",
+			test: func(t *testing.T) {
+				_, err := repository.CreateApiKey(context.Background(), &api.CreateApiKeyRequest{VenueId: UUID, Name: "till"})
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "create api key without name",
+			test: func(t *testing.T) {
+				_, err := repository.CreateApiKey(context.Background(), &api.CreateApiKeyRequest{
+					VenueId: UUID,
+					Role:    models.ApiKeyRole_READ_BOOKINGS,
+				})
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "create api key for unknown venue",
+			test: func(t *testing.T) {
+				_, err := repository.CreateApiKey(context.Background(), &api.CreateApiKeyRequest{
+					VenueId: "d6d5c1a8-7d4c-4b4e-9d1e-6f3f0b3a4c2e",
+					Name:    "till",
+					Role:    models.ApiKeyRole_READ_BOOKINGS,
+				})
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "verify api key",
+			test: func(t *testing.T) {
+				verified, err := repository.VerifyApiKey(context.Background(), &api.VerifyApiKeyRequest{Key: key})
+				require.NoError(t, err)
+
+				assert.Equal(t, UUID, verified.Id)
+				assert.Equal(t, UUID, verified.VenueId)
+				assert.Equal(t, models.ApiKeyRole_MANAGE_BOOKINGS, verified.Role)
+				assert.NotEmpty(t, verified.LastUsedAt)
+			},
+		},
+		{
+			name: "verify unknown api key",
+			test: func(t *testing.T) {
+				_, err := repository.VerifyApiKey(context.Background(), &api.VerifyApiKeyRequest{Key: key + "x"})
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "get api keys",
+			test: func(t *testing.T) {
+				resp, err := repository.GetApiKeys(context.Background(), &api.GetApiKeysRequest{VenueId: UUID})
+				require.NoError(t, err)
+
+				require.Len(t, resp.ApiKeys, 1)
+				assert.Equal(t, "till", resp.ApiKeys[0].Name)
+				assert.NotEmpty(t, resp.ApiKeys[0].LastUsedAt)
+			},
+		},
+		{
+			name: "revoke api key",
+			test: func(t *testing.T) {
+				revoked, err := repository.RevokeApiKey(context.Background(), &api.RevokeApiKeyRequest{VenueId: UUID, Id: UUID})
+				require.NoError(t, err)
+
+				assert.Equal(t, UUID, revoked.Id)
+				assert.NotEmpty(t, revoked.RevokedAt)
+			},
+		},
+		{
+			name: "verify revoked api key",
+			test: func(t *testing.T) {
+				_, err := repository.VerifyApiKey(context.Background(), &api.VerifyApiKeyRequest{Key: key})
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "revoke revoked api key",
+			test: func(t *testing.T) {
+				_, err := repository.RevokeApiKey(context.Background(), &api.RevokeApiKeyRequest{VenueId: UUID, Id: UUID})
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "watch venue with invalid cursor",
+			test: func(t *testing.T) {
+				err := repository.WatchVenue(&api.WatchVenueRequest{VenueId: UUID, Cursor: "invalid"}, newEventStream(context.Background()))
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "watch venue from the beginning",
+			test: func(t *testing.T) {
+				events = watch(t, 10, func(stream *eventStream) error {
+					return repository.WatchVenue(&api.WatchVenueRequest{VenueId: UUID, Cursor: "0"}, stream)
+				}, nil)
+
+				assert.Equal(t, []api.VenueEventType{
+					api.VenueEventType_VENUE_CREATED,
+					api.VenueEventType_OPENING_HOURS_UPDATED,
+					api.VenueEventType_SPECIAL_OPENING_HOURS_UPDATED,
+					api.VenueEventType_OPENING_HOURS_UPDATED,
+					api.VenueEventType_SPECIAL_OPENING_HOURS_UPDATED,
+					api.VenueEventType_SPECIAL_OPENING_HOURS_UPDATED,
+					api.VenueEventType_TABLE_ADDED,
+					api.VenueEventType_TABLE_REMOVED,
+					api.VenueEventType_ADMIN_ADDED,
+					api.VenueEventType_ADMIN_REMOVED,
+				}, eventTypes(events))
+				assert.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 0, 0}, eventVersions(events))
+				assert.Equal(t, "test table", events[6].Table.Name)
+				assert.Equal(t, "test@test.com", events[9].Email)
+				for _, event := range events {
+					assert.Equal(t, UUID, event.VenueId)
+					assert.NotEmpty(t, event.OccurredAt)
+				}
+			},
+		},
+		{
+			name: "watch venue resumes after cursor",
+			test: func(t *testing.T) {
+				require.Len(t, events, 10)
+				resumed := watch(t, 3, func(stream *eventStream) error {
+					return repository.WatchVenue(&api.WatchVenueRequest{VenueId: UUID, Cursor: events[6].Cursor}, stream)
+				}, nil)
+
+				assert.Equal(t, events[7:], resumed)
+			},
+		},
+		{
+			name: "watch venues streams new events",
+			test: func(t *testing.T) {
+				require.Len(t, events, 10)
+				const venueID = "d6d5c1a8-7d4c-4b4e-9d1e-6f3f0b3a4c2e"
+				email := "new@test.com"
+				latest := watch(t, 2, func(stream *eventStream) error {
+					return repository.WatchVenues(&api.WatchVenuesRequest{Cursor: events[9].Cursor}, stream)
+				}, func() {
+					_, err := repository.AddAdmin(context.Background(), &api.AddAdminRequest{VenueId: venueID, Email: email})
+					require.NoError(t, err)
+					_, err = repository.RemoveAdmin(context.Background(), &api.RemoveAdminRequest{VenueId: venueID, Email: email})
+					require.NoError(t, err)
+				})
+
+				assert.Equal(t, []api.VenueEventType{
+					api.VenueEventType_ADMIN_ADDED,
+					api.VenueEventType_ADMIN_REMOVED,
+				}, eventTypes(latest))
+				for _, event := range latest {
+					assert.Equal(t, venueID, event.VenueId)
+					assert.Equal(t, email, event.Email)
+				}
+			},
+		},
+	}
+}
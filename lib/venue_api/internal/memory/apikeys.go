@@ -0,0 +1,139 @@
+package memory
+
+import (
+	"context"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/models"
+	"github.com/cobbinma/booking-platform/lib/venue_api/internal/apikey"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// lastUsedInterval is how long after a key's recorded use the next is recorded, as the postgres store does.
+const lastUsedInterval = time.Minute
+
+type apiKey struct {
+	id         string
+	venueID    string
+	name       string
+	role       models.ApiKeyRole
+	prefix     string
+	hash       string
+	createdAt  time.Time
+	lastUsedAt time.Time
+	revokedAt  time.Time
+}
+
+func (c *client) CreateApiKey(ctx context.Context, req *api.CreateApiKeyRequest) (*api.CreateApiKeyResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not insert row")
+	}
+	if err := apikey.Validate(req.Name, req.Role); err != nil {
+		return nil, err
+	}
+
+	key, prefix, hash, err := apikey.New()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not create api key : %s", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.venueByID(req.VenueId) == nil {
+		return nil, status.Errorf(codes.NotFound, "could not find venue")
+	}
+
+	k := &apiKey{
+		id:        c.uuid.UUID(),
+		venueID:   req.VenueId,
+		name:      req.Name,
+		role:      req.Role,
+		prefix:    prefix,
+		hash:      hash,
+		createdAt: time.Now(),
+	}
+	c.apiKeys = append(c.apiKeys, k)
+
+	return &api.CreateApiKeyResponse{ApiKey: k.model(), Key: key}, nil
+}
+
+func (c *client) GetApiKeys(ctx context.Context, req *api.GetApiKeysRequest) (*api.GetApiKeysResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not query api keys : %s", err)
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	keys := []*models.ApiKey{}
+	for _, k := range c.apiKeys {
+		if k.venueID == req.VenueId {
+			keys = append(keys, k.model())
+		}
+	}
+
+	return &api.GetApiKeysResponse{ApiKeys: keys}, nil
+}
+
+func (c *client) RevokeApiKey(ctx context.Context, req *api.RevokeApiKeyRequest) (*models.ApiKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not update row")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, k := range c.apiKeys {
+		if k.id == req.Id && k.venueID == req.VenueId && k.revokedAt.IsZero() {
+			k.revokedAt = time.Now()
+			return k.model(), nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "could not find api key")
+}
+
+func (c *client) VerifyApiKey(ctx context.Context, req *api.VerifyApiKeyRequest) (*models.ApiKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not query api key")
+	}
+
+	hash := apikey.Hash(req.Key)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, k := range c.apiKeys {
+		if k.hash == hash && k.revokedAt.IsZero() {
+			if time.Since(k.lastUsedAt) >= lastUsedInterval {
+				k.lastUsedAt = time.Now()
+			}
+			return k.model(), nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "could not find api key")
+}
+
+func (k apiKey) model() *models.ApiKey {
+	return &models.ApiKey{
+		Id:         k.id,
+		VenueId:    k.venueID,
+		Name:       k.name,
+		Role:       k.role,
+		Prefix:     k.prefix,
+		CreatedAt:  formatTime(k.createdAt),
+		LastUsedAt: formatTime(k.lastUsedAt),
+		RevokedAt:  formatTime(k.revokedAt),
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,148 @@
+package memory
+
+import (
+	"context"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/models"
+	"github.com/cobbinma/booking-platform/lib/venue_api/internal/batch"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (c *client) BatchGetVenues(ctx context.Context, req *api.BatchGetVenuesRequest) (*api.BatchGetVenuesResponse, error) {
+	ids, err := batch.IDs(req.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not query venues : %s", err)
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	venues := []*models.Venue{}
+	for _, id := range ids {
+		if v := c.venueByID(id); v != nil {
+			venues = append(venues, v.model())
+		}
+	}
+
+	return &api.BatchGetVenuesResponse{Venues: venues}, nil
+}
+
+func (c *client) GetTablesBatch(ctx context.Context, req *api.GetTablesBatchRequest) (*api.GetTablesBatchResponse, error) {
+	ids, err := batch.IDs(req.VenueIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not query tables : %s", err)
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	venues := []*api.VenueTables{}
+	for _, id := range ids {
+		v := c.venueByID(id)
+		if v == nil {
+			continue
+		}
+
+		tables := []*models.Table{}
+		for _, t := range v.tables {
+			tables = append(tables, t.model())
+		}
+		venues = append(venues, &api.VenueTables{VenueId: v.id, Tables: tables, Version: v.version})
+	}
+
+	return &api.GetTablesBatchResponse{Venues: venues}, nil
+}
+
+func (c *client) GetOpeningHoursSpecificationBatch(ctx context.Context, req *api.GetOpeningHoursSpecificationBatchRequest) (*api.GetOpeningHoursSpecificationBatchResponse, error) {
+	ids, err := batch.IDs(req.VenueIds)
+	if err != nil {
+		return nil, err
+	}
+
+	date, err := time.Parse(time.RFC3339, req.Date)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse date. should be in format '%s'", time.RFC3339)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not query opening hours : %s", err)
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	venues := []*api.VenueOpeningHoursSpecification{}
+	for _, id := range ids {
+		v := c.venueByID(id)
+		if v == nil {
+			continue
+		}
+
+		if hours := v.openingHoursOn(date); hours != nil {
+			venues = append(venues, &api.VenueOpeningHoursSpecification{VenueId: v.id, Specification: hours})
+		}
+	}
+
+	return &api.GetOpeningHoursSpecificationBatchResponse{Venues: venues}, nil
+}
+
+func (c *client) IsAdminBatch(ctx context.Context, req *api.IsAdminBatchRequest) (*api.IsAdminBatchResponse, error) {
+	ids, err := batch.IDs(req.VenueIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "internal database error")
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	administered := []string{}
+	for _, id := range ids {
+		for _, email := range c.admins[id] {
+			if email == req.Email {
+				administered = append(administered, id)
+				break
+			}
+		}
+	}
+
+	return &api.IsAdminBatchResponse{VenueIds: administered}, nil
+}
+
+func (c *client) GetAdminsBatch(ctx context.Context, req *api.GetAdminsBatchRequest) (*api.GetAdminsBatchResponse, error) {
+	ids, err := batch.IDs(req.VenueIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could query admins : %s", err)
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	venues := []*api.VenueAdmins{}
+	for _, id := range ids {
+		if c.venueByID(id) == nil {
+			continue
+		}
+
+		venues = append(venues, &api.VenueAdmins{VenueId: id, Admins: append([]string{}, c.admins[id]...)})
+	}
+
+	return &api.GetAdminsBatchResponse{Venues: venues}, nil
+}
@@ -0,0 +1,567 @@
+package memory
+
+import (
+	"context"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/models"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
+)
+
+var _ api.VenueAPIServer = (*client)(nil)
+
+type client struct {
+	mu      sync.RWMutex
+	log     *zap.SugaredLogger
+	uuid    uuidGenerator
+	venues  []*venue
+	admins  map[string][]string
+	apiKeys []*apiKey
+	events  []*api.VenueEvent
+	changed chan struct{}
+}
+
+type venue struct {
+	id                  string
+	name                string
+	slug                string
+	version             int64
+	openingHours        []openingHours
+	specialOpeningHours []specialOpeningHours
+	tables              []table
+}
+
+type openingHours struct {
+	dayOfWeek uint32
+	opens     string
+	closes    string
+}
+
+type specialOpeningHours struct {
+	openingHours
+	validFrom    time.Time
+	validThrough time.Time
+}
+
+type table struct {
+	id       string
+	name     string
+	capacity uint32
+}
+
+// NewMemory returns a venue store that keeps all state in process. It behaves like the postgres store and is intended
+// for local development and tests where a database is not available.
+func NewMemory(log *zap.SugaredLogger, options ...func(*client)) (api.VenueAPIServer, func(log *zap.SugaredLogger), error) {
+	c := &client{log: log, admins: map[string][]string{}, changed: make(chan struct{})}
+	for i := range options {
+		options[i](c)
+	}
+
+	if c.uuid == nil {
+		c.uuid = newRandomUUID()
+	}
+
+	return c, func(log *zap.SugaredLogger) {}, nil
+}
+
+func (c *client) GetTables(ctx context.Context, req *api.GetTablesRequest) (*api.GetTablesResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not query tables : %s", err)
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	v := c.venueByID(req.VenueId)
+	if v == nil {
+		return nil, status.Errorf(codes.NotFound, "could not find venue")
+	}
+
+	tables := []*models.Table{}
+	for _, t := range v.tables {
+		tables = append(tables, t.model())
+	}
+
+	return &api.GetTablesResponse{Tables: tables, Version: v.version}, nil
+}
+
+func (c *client) AddTable(ctx context.Context, req *api.AddTableRequest) (*models.Table, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not begin transaction : %s", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v, err := c.checkVersion(req.VenueId, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range v.tables {
+		if t.name == req.Name {
+			return nil, status.Errorf(codes.AlreadyExists, "table '%s' already exists", req.Name)
+		}
+	}
+
+	t := table{id: c.uuid.UUID(), name: req.Name, capacity: req.Capacity}
+	v.tables = append(v.tables, t)
+	v.version++
+
+	c.publish(&api.VenueEvent{
+		VenueId: v.id,
+		Type:    api.VenueEventType_TABLE_ADDED,
+		Version: v.version,
+		Table:   t.model(),
+	})
+
+	return t.model(), nil
+}
+
+func (c *client) RemoveTable(ctx context.Context, req *api.RemoveTableRequest) (*models.Table, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not begin transaction : %s", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v, err := c.checkVersion(req.VenueId, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, t := range v.tables {
+		if t.id == req.TableId {
+			v.tables = append(v.tables[:i:i], v.tables[i+1:]...)
+			v.version++
+
+			c.publish(&api.VenueEvent{
+				VenueId: v.id,
+				Type:    api.VenueEventType_TABLE_REMOVED,
+				Version: v.version,
+				Table:   t.model(),
+			})
+			return t.model(), nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "could not find venue")
+}
+
+func (c *client) GetVenue(ctx context.Context, req *api.GetVenueRequest) (*models.Venue, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could get find venue : %s", err)
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, v := range c.venues {
+		if (req.Id == "" || v.id == req.Id) && (req.Slug == "" || v.slug == req.Slug) {
+			return v.model(), nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "could not find venue")
+}
+
+func (c *client) CreateVenue(ctx context.Context, req *api.CreateVenueRequest) (*models.Venue, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not begin transaction : %s", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, v := range c.venues {
+		if v.slug == req.Slug {
+			return nil, status.Errorf(codes.AlreadyExists, "venue with slug '%s' already exists", req.Slug)
+		}
+	}
+
+	hours, err := toOpeningHours(req.OpeningHours)
+	if err != nil {
+		return nil, err
+	}
+
+	id := c.uuid.UUID()
+	c.venues = append(c.venues, &venue{
+		id:           id,
+		name:         req.Name,
+		slug:         req.Slug,
+		version:      1,
+		openingHours: hours,
+	})
+
+	c.publish(&api.VenueEvent{
+		VenueId:      id,
+		Type:         api.VenueEventType_VENUE_CREATED,
+		Version:      1,
+		OpeningHours: req.OpeningHours,
+	})
+
+	return &models.Venue{
+		Id:                  id,
+		Name:                req.Name,
+		OpeningHours:        req.OpeningHours,
+		SpecialOpeningHours: nil,
+		Version:             1,
+	}, nil
+}
+
+func (c *client) GetOpeningHoursSpecification(ctx context.Context, req *api.GetOpeningHoursSpecificationRequest) (*api.GetOpeningHoursSpecificationResponse, error) {
+	date, err := time.Parse(time.RFC3339, req.Date)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse date. should be in format '%s'", time.RFC3339)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not query opening hours : %s", err)
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	v := c.venueByID(req.VenueId)
+	if v == nil {
+		return nil, status.Error(codes.NotFound, "venue is not open for business on given date")
+	}
+
+	if hours := v.openingHoursOn(date); hours != nil {
+		return &api.GetOpeningHoursSpecificationResponse{Specification: hours}, nil
+	}
+
+	return nil, status.Error(codes.NotFound, "venue is not open for business on given date")
+}
+
+func (c *client) UpdateOpeningHours(ctx context.Context, req *api.UpdateOpeningHoursRequest) (*api.UpdateOpeningHoursResponse, error) {
+	c.log.Infof("updating opening hours for venue '%s'", req.VenueId)
+
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not begin transaction : %s", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v, err := c.checkVersion(req.VenueId, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	hours, err := toOpeningHours(req.OpeningHours)
+	if err != nil {
+		return nil, err
+	}
+
+	v.openingHours = hours
+	v.version++
+
+	c.publish(&api.VenueEvent{
+		VenueId:      v.id,
+		Type:         api.VenueEventType_OPENING_HOURS_UPDATED,
+		Version:      v.version,
+		OpeningHours: req.OpeningHours,
+	})
+
+	return &api.UpdateOpeningHoursResponse{OpeningHours: req.OpeningHours, Version: v.version}, nil
+}
+
+func (c *client) UpdateSpecialOpeningHours(ctx context.Context, req *api.UpdateOpeningHoursRequest) (*api.UpdateOpeningHoursResponse, error) {
+	c.log.Infof("updating special opening hours for venue '%s'", req.VenueId)
+
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not begin transaction : %s", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v, err := c.checkVersion(req.VenueId, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	hours := make([]specialOpeningHours, 0, len(req.OpeningHours))
+	for _, h := range req.OpeningHours {
+		from, err := time.Parse(time.RFC3339, h.ValidFrom)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "could not parse valid from : %s", err)
+		}
+		through, err := time.Parse(time.RFC3339, h.ValidThrough)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "could not parse valid through : %s", err)
+		}
+		if h.DayOfWeek < 1 || h.DayOfWeek > 7 {
+			return nil, status.Errorf(codes.InvalidArgument, "day of week must be between 1 and 7")
+		}
+
+		// special opening hours are stored as dates, so the time of day is dropped
+		from, through = date(from), date(through)
+		if !through.After(from) {
+			return nil, status.Errorf(codes.InvalidArgument, "valid through must be after valid from")
+		}
+
+		hours = append(hours, specialOpeningHours{
+			openingHours: openingHours{dayOfWeek: h.DayOfWeek, opens: h.Opens, closes: h.Closes},
+			validFrom:    from,
+			validThrough: through,
+		})
+	}
+
+	v.specialOpeningHours = hours
+	v.version++
+
+	c.publish(&api.VenueEvent{
+		VenueId:      v.id,
+		Type:         api.VenueEventType_SPECIAL_OPENING_HOURS_UPDATED,
+		Version:      v.version,
+		OpeningHours: req.OpeningHours,
+	})
+
+	return &api.UpdateOpeningHoursResponse{OpeningHours: req.OpeningHours, Version: v.version}, nil
+}
+
+func (c *client) IsAdmin(ctx context.Context, req *api.IsAdminRequest) (*api.IsAdminResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "internal database error")
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var venueID string
+	if req.VenueId != "" {
+		venueID = req.VenueId
+	} else if req.Slug != "" {
+		v := c.venueBySlug(req.Slug)
+		if v == nil {
+			return nil, status.Errorf(codes.NotFound, "could not find venue")
+		}
+
+		venueID = v.id
+	} else {
+		return nil, status.Error(codes.InvalidArgument, "either venue id or slug must be given")
+	}
+
+	for _, email := range c.admins[venueID] {
+		if email == req.Email {
+			return &api.IsAdminResponse{IsAdmin: true}, nil
+		}
+	}
+
+	return &api.IsAdminResponse{IsAdmin: false}, nil
+}
+
+func (c *client) AddAdmin(ctx context.Context, req *api.AddAdminRequest) (*api.AddAdminResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not insert row")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, email := range c.admins[req.VenueId] {
+		if email == req.Email {
+			return nil, status.Errorf(codes.AlreadyExists, "could not insert row")
+		}
+	}
+	c.admins[req.VenueId] = append(c.admins[req.VenueId], req.Email)
+
+	c.publish(&api.VenueEvent{
+		VenueId: req.VenueId,
+		Type:    api.VenueEventType_ADMIN_ADDED,
+		Email:   req.Email,
+	})
+
+	return &api.AddAdminResponse{
+		VenueId: req.VenueId,
+		Email:   req.Email,
+	}, nil
+}
+
+func (c *client) RemoveAdmin(ctx context.Context, req *api.RemoveAdminRequest) (*api.RemoveAdminResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not delete row")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	admins := c.admins[req.VenueId]
+	for i, email := range admins {
+		if email == req.Email {
+			c.admins[req.VenueId] = append(admins[:i:i], admins[i+1:]...)
+
+			c.publish(&api.VenueEvent{
+				VenueId: req.VenueId,
+				Type:    api.VenueEventType_ADMIN_REMOVED,
+				Email:   req.Email,
+			})
+			break
+		}
+	}
+
+	return &api.RemoveAdminResponse{Email: req.Email}, nil
+}
+
+func (c *client) GetAdmins(ctx context.Context, req *api.GetAdminsRequest) (*api.GetAdminsResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could query admins : %s", err)
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	emails := append([]string{}, c.admins[req.VenueId]...)
+
+	return &api.GetAdminsResponse{Admins: emails}, nil
+}
+
+// Ping reports whether the store can be reached, which is always the case in memory.
+func (c *client) Ping(ctx context.Context) error {
+	return ctx.Err()
+}
+
+func (c *client) venueByID(id string) *venue {
+	for _, v := range c.venues {
+		if v.id == id {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func (c *client) venueBySlug(slug string) *venue {
+	for _, v := range c.venues {
+		if v.slug == slug {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// checkVersion mirrors the optimistic concurrency check of the postgres store. The caller must hold the write lock
+// and only bump the version once every other validation has passed.
+func (c *client) checkVersion(venueID string, version int64) (*venue, error) {
+	if version == 0 {
+		return nil, status.Error(codes.InvalidArgument, "venue version must be given")
+	}
+
+	v := c.venueByID(venueID)
+	if v == nil {
+		return nil, status.Errorf(codes.NotFound, "could not find venue")
+	}
+
+	if v.version != version {
+		return nil, status.Errorf(codes.Aborted, "venue has been modified since version %d", version)
+	}
+
+	return v, nil
+}
+
+func toOpeningHours(specs []*models.OpeningHoursSpecification) ([]openingHours, error) {
+	hours := make([]openingHours, 0, len(specs))
+	days := map[uint32]bool{}
+	for _, h := range specs {
+		if h.DayOfWeek < 1 || h.DayOfWeek > 7 {
+			return nil, status.Errorf(codes.InvalidArgument, "day of week must be between 1 and 7")
+		}
+		if days[h.DayOfWeek] {
+			return nil, status.Errorf(codes.AlreadyExists, "opening hours for day %d given more than once", h.DayOfWeek)
+		}
+		days[h.DayOfWeek] = true
+
+		hours = append(hours, openingHours{dayOfWeek: h.DayOfWeek, opens: h.Opens, closes: h.Closes})
+	}
+
+	return hours, nil
+}
+
+func date(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func errorCode(err error) codes.Code {
+	if err == context.DeadlineExceeded {
+		return codes.DeadlineExceeded
+	}
+
+	return codes.Canceled
+}
+
+func (v venue) model() *models.Venue {
+	hours := []*models.OpeningHoursSpecification{}
+	for _, h := range v.openingHours {
+		hours = append(hours, h.model())
+	}
+
+	specialHours := []*models.OpeningHoursSpecification{}
+	for _, h := range v.specialOpeningHours {
+		specialHours = append(specialHours, h.model())
+	}
+
+	return &models.Venue{
+		Id:                  v.id,
+		Name:                v.name,
+		OpeningHours:        hours,
+		SpecialOpeningHours: specialHours,
+		Slug:                v.slug,
+		Version:             v.version,
+	}
+}
+
+// openingHoursOn returns the hours the venue is open on the date, preferring special opening hours to its usual ones.
+// It returns nil when the venue is not open for business on the date.
+func (v venue) openingHoursOn(date time.Time) *models.OpeningHoursSpecification {
+	weekday := date.Weekday()
+	if weekday == 0 {
+		weekday = 7
+	}
+
+	for _, hours := range v.specialOpeningHours {
+		if hours.validFrom.Before(date) && hours.validThrough.After(date) && uint32(weekday) == hours.dayOfWeek {
+			return hours.model()
+		}
+	}
+
+	for _, hours := range v.openingHours {
+		if uint32(weekday) == hours.dayOfWeek {
+			return hours.model()
+		}
+	}
+
+	return nil
+}
+
+func (h openingHours) model() *models.OpeningHoursSpecification {
+	return &models.OpeningHoursSpecification{
+		DayOfWeek: h.dayOfWeek,
+		Opens:     h.opens,
+		Closes:    h.closes,
+	}
+}
+
+func (h specialOpeningHours) model() *models.OpeningHoursSpecification {
+	return &models.OpeningHoursSpecification{
+		DayOfWeek:    h.dayOfWeek,
+		Opens:        h.opens,
+		Closes:       h.closes,
+		ValidFrom:    h.validFrom.Format(time.RFC3339),
+		ValidThrough: h.validThrough.Format(time.RFC3339),
+	}
+}
+
+func (t table) model() *models.Table {
+	return &models.Table{
+		Id:       t.id,
+		Name:     t.name,
+		Capacity: t.capacity,
+	}
+}
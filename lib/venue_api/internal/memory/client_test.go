@@ -0,0 +1,19 @@
+package memory_test
+
+import (
+	"github.com/cobbinma/booking-platform/lib/venue_api/internal/conformance"
+	"github.com/cobbinma/booking-platform/lib/venue_api/internal/memory"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func Test_Repository(t *testing.T) {
+	log := zap.NewNop().Sugar()
+
+	repository, closeDB, err := memory.NewMemory(log, memory.WithStaticUUIDGenerator(conformance.UUID))
+	require.NoError(t, err)
+	defer closeDB(log)
+
+	conformance.Run(t, repository)
+}
@@ -0,0 +1,74 @@
+package memory
+
+import (
+	"context"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+	"time"
+)
+
+// publish records an event and wakes up every watcher. The caller must hold the write lock.
+func (c *client) publish(event *api.VenueEvent) {
+	event = proto.Clone(event).(*api.VenueEvent)
+	event.Cursor = strconv.Itoa(len(c.events) + 1)
+	event.OccurredAt = time.Now().UTC().Format(time.RFC3339)
+	c.events = append(c.events, event)
+
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+func (c *client) WatchVenue(req *api.WatchVenueRequest, stream api.VenueAPI_WatchVenueServer) error {
+	if req.VenueId == "" {
+		return status.Error(codes.InvalidArgument, "venue id must be given")
+	}
+
+	return c.watch(stream.Context(), req.VenueId, req.Cursor, stream.Send)
+}
+
+func (c *client) WatchVenues(req *api.WatchVenuesRequest, stream api.VenueAPI_WatchVenuesServer) error {
+	return c.watch(stream.Context(), "", req.Cursor, stream.Send)
+}
+
+func (c *client) watch(ctx context.Context, venueID, cursor string, send func(*api.VenueEvent) error) error {
+	c.mu.RLock()
+	after := len(c.events)
+	c.mu.RUnlock()
+
+	if cursor != "" {
+		parsed, err := strconv.Atoi(cursor)
+		if err != nil || parsed < 0 {
+			return status.Errorf(codes.InvalidArgument, "invalid cursor '%s'", cursor)
+		}
+		after = parsed
+	}
+
+	for {
+		c.mu.RLock()
+		var events []*api.VenueEvent
+		if after < len(c.events) {
+			events = c.events[after:]
+			after = len(c.events)
+		}
+		changed := c.changed
+		c.mu.RUnlock()
+
+		for _, event := range events {
+			if venueID != "" && event.VenueId != venueID {
+				continue
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.Error(errorCode(ctx.Err()), ctx.Err().Error())
+		case <-changed:
+		}
+	}
+}
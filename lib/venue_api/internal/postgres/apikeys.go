@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/models"
+	"github.com/cobbinma/booking-platform/lib/venue_api/internal/apikey"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"time"
+)
+
+const ApiKeysTable = "api_keys"
+
+const foreignKeyViolation = "23503"
+
+// lastUsedInterval is how long after a key's recorded use the next is recorded.
+const lastUsedInterval = time.Minute
+
+var apiKeyColumns = strings.Join([]string{"id", "venue_id", "name", "role", "prefix", "created_at", "last_used_at", "revoked_at"}, ", ")
+
+func (c client) CreateApiKey(ctx context.Context, req *api.CreateApiKeyRequest) (*api.CreateApiKeyResponse, error) {
+	if err := apikey.Validate(req.Name, req.Role); err != nil {
+		return nil, err
+	}
+
+	key, prefix, hash, err := apikey.New()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not create api key : %s", err)
+	}
+
+	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert(ApiKeysTable).
+		Columns("id", "venue_id", "name", "role", "prefix", "hash").
+		Values(c.uuid.UUID(), req.VenueId, req.Name, req.Role.String(), prefix, hash).
+		Suffix("RETURNING " + apiKeyColumns).ToSql()
+	if err != nil {
+		c.log.Errorw("could not construct sql", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal database error")
+	}
+
+	created, err := scanApiKey(c.db.QueryRowxContext(ctx, sql, args...))
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation {
+			return nil, status.Errorf(codes.NotFound, "could not find venue")
+		}
+		return nil, status.Errorf(errorCode(err), "could not insert api key : %s", err)
+	}
+
+	return &api.CreateApiKeyResponse{ApiKey: created, Key: key}, nil
+}
+
+func (c client) GetApiKeys(ctx context.Context, req *api.GetApiKeysRequest) (*api.GetApiKeysResponse, error) {
+	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(apiKeyColumns).From(ApiKeysTable).
+		Where(sq.Eq{"venue_id": req.VenueId}).
+		OrderBy("created_at", "id").ToSql()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not build api keys sql : %s", err)
+	}
+
+	rows, err := c.db.QueryxContext(ctx, sql, args...)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "could not query api keys : %s", err)
+	}
+	defer closeRows(c.log, rows.Rows)
+
+	keys := []*models.ApiKey{}
+	for rows.Next() {
+		key, err := scanApiKey(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not scan api key row : %s", err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "api keys rows error : %s", err)
+	}
+
+	return &api.GetApiKeysResponse{ApiKeys: keys}, nil
+}
+
+func (c client) RevokeApiKey(ctx context.Context, req *api.RevokeApiKeyRequest) (*models.ApiKey, error) {
+	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(ApiKeysTable).
+		Set("revoked_at", sq.Expr("now()")).
+		Where(sq.And{sq.Eq{"id": req.Id}, sq.Eq{"venue_id": req.VenueId}, sq.Eq{"revoked_at": nil}}).
+		Suffix("RETURNING " + apiKeyColumns).ToSql()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not build revoke api key sql : %s", err)
+	}
+
+	revoked, err := scanApiKey(c.db.QueryRowxContext(ctx, sql, args...))
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "could not find api key")
+		}
+
+		return nil, status.Errorf(errorCode(err), "could not revoke api key : %s", err)
+	}
+
+	return revoked, nil
+}
+
+// VerifyApiKey looks a key up by its hash, recording that it has been used. Unknown and revoked keys are not told apart.
+// Use is recorded at most once every lastUsedInterval, so a busy key does not make every verification a write.
+func (c client) VerifyApiKey(ctx context.Context, req *api.VerifyApiKeyRequest) (*models.ApiKey, error) {
+	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(apiKeyColumns).From(ApiKeysTable).
+		Where(sq.And{sq.Eq{"hash": apikey.Hash(req.Key)}, sq.Eq{"revoked_at": nil}}).ToSql()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not build verify api key sql : %s", err)
+	}
+
+	verified, err := scanApiKey(c.db.QueryRowxContext(ctx, sql, args...))
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "could not find api key")
+		}
+
+		return nil, status.Errorf(errorCode(err), "could not verify api key : %s", err)
+	}
+
+	if lastUsedAt, err := time.Parse(time.RFC3339, verified.LastUsedAt); err == nil && time.Since(lastUsedAt) < lastUsedInterval {
+		return verified, nil
+	}
+
+	sql, args, err = sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(ApiKeysTable).
+		Set("last_used_at", sq.Expr("now()")).
+		Where(sq.And{
+			sq.Eq{"id": verified.Id},
+			sq.Or{sq.Eq{"last_used_at": nil}, sq.Lt{"last_used_at": time.Now().Add(-lastUsedInterval)}},
+		}).
+		Suffix("RETURNING " + apiKeyColumns).ToSql()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not build api key last used sql : %s", err)
+	}
+
+	used, err := scanApiKey(c.db.QueryRowxContext(ctx, sql, args...))
+	if err != nil {
+		// a concurrent verification has already recorded the use
+		if errors.Is(err, sql2.ErrNoRows) {
+			return verified, nil
+		}
+
+		return nil, status.Errorf(errorCode(err), "could not record api key use : %s", err)
+	}
+
+	return used, nil
+}
+
+func scanApiKey(row interface {
+	Scan(dest ...interface{}) error
+}) (*models.ApiKey, error) {
+	var (
+		key                   models.ApiKey
+		role                  string
+		createdAt             time.Time
+		lastUsedAt, revokedAt sql2.NullTime
+	)
+	if err := row.Scan(&key.Id, &key.VenueId, &key.Name, &role, &key.Prefix, &createdAt, &lastUsedAt, &revokedAt); err != nil {
+		return nil, err
+	}
+
+	key.Role = models.ApiKeyRole(models.ApiKeyRole_value[role])
+	key.CreatedAt = createdAt.UTC().Format(time.RFC3339)
+	if lastUsedAt.Valid {
+		key.LastUsedAt = lastUsedAt.Time.UTC().Format(time.RFC3339)
+	}
+	if revokedAt.Valid {
+		key.RevokedAt = revokedAt.Time.UTC().Format(time.RFC3339)
+	}
+
+	return &key, nil
+}
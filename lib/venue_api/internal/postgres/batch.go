@@ -0,0 +1,372 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/models"
+	"github.com/cobbinma/booking-platform/lib/venue_api/internal/batch"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// venueRow is a venue as stored in the venues table, without the rows of other tables that belong to it.
+type venueRow struct {
+	id, name, slug string
+	version        int64
+}
+
+func (c client) BatchGetVenues(ctx context.Context, req *api.BatchGetVenuesRequest) (*api.BatchGetVenuesResponse, error) {
+	ids, err := batch.IDs(req.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.getVenueRows(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	hours, err := c.getOpeningHoursBatch(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("could not get opening hours : %w", err)
+	}
+
+	specialHours, err := c.getSpecialOpeningHoursBatch(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("could not get special opening hours : %w", err)
+	}
+
+	venues := []*models.Venue{}
+	for _, id := range ids {
+		row, ok := rows[id]
+		if !ok {
+			continue
+		}
+
+		venues = append(venues, &models.Venue{
+			Id:                  row.id,
+			Name:                row.name,
+			OpeningHours:        nonNilHours(hours[id]),
+			SpecialOpeningHours: nonNilHours(specialHours[id]),
+			Slug:                row.slug,
+			Version:             row.version,
+		})
+	}
+
+	return &api.BatchGetVenuesResponse{Venues: venues}, nil
+}
+
+func (c client) GetTablesBatch(ctx context.Context, req *api.GetTablesBatchRequest) (*api.GetTablesBatchResponse, error) {
+	ids, err := batch.IDs(req.VenueIds)
+	if err != nil {
+		return nil, err
+	}
+
+	venues, err := c.getVenueRows(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("venue_id", "id", "name", "capacity").
+		From(TablesTable).Where(sq.Eq{"venue_id": ids}).ToSql()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not build tables sql : %s", err)
+	}
+
+	rows, err := c.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "could not query tables : %s", err)
+	}
+	defer closeRows(c.log, rows)
+
+	tables := map[string][]*models.Table{}
+	for rows.Next() {
+		var capacity uint32
+		var venueID, id, name string
+		if err := rows.Scan(&venueID, &id, &name, &capacity); err != nil {
+			return nil, status.Errorf(codes.Internal, "could not scan tables row : %s", err)
+		}
+		tables[venueID] = append(tables[venueID], &models.Table{
+			Id:       id,
+			Name:     name,
+			Capacity: capacity,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "tables rows error : %s", err)
+	}
+
+	resp := &api.GetTablesBatchResponse{Venues: []*api.VenueTables{}}
+	for _, id := range ids {
+		venue, ok := venues[id]
+		if !ok {
+			continue
+		}
+
+		resp.Venues = append(resp.Venues, &api.VenueTables{
+			VenueId: id,
+			Tables:  nonNilTables(tables[id]),
+			Version: venue.version,
+		})
+	}
+
+	return resp, nil
+}
+
+func (c client) GetOpeningHoursSpecificationBatch(ctx context.Context, req *api.GetOpeningHoursSpecificationBatchRequest) (*api.GetOpeningHoursSpecificationBatchResponse, error) {
+	ids, err := batch.IDs(req.VenueIds)
+	if err != nil {
+		return nil, err
+	}
+
+	date, err := time.Parse(time.RFC3339, req.Date)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse date. should be in format '%s'", time.RFC3339)
+	}
+
+	specialHours, err := c.getSpecialOpeningHoursBatch(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("could not get special opening hours : %w", err)
+	}
+
+	openHours, err := c.getOpeningHoursBatch(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("could not get opening hours : %w", err)
+	}
+
+	venues := []*api.VenueOpeningHoursSpecification{}
+	for _, id := range ids {
+		hours, err := openingHoursOn(date, specialHours[id], openHours[id])
+		if err != nil {
+			return nil, err
+		}
+		if hours == nil {
+			continue
+		}
+
+		venues = append(venues, &api.VenueOpeningHoursSpecification{VenueId: id, Specification: hours})
+	}
+
+	return &api.GetOpeningHoursSpecificationBatchResponse{Venues: venues}, nil
+}
+
+func (c client) IsAdminBatch(ctx context.Context, req *api.IsAdminBatchRequest) (*api.IsAdminBatchResponse, error) {
+	ids, err := batch.IDs(req.VenueIds)
+	if err != nil {
+		return nil, err
+	}
+
+	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("venue_id").From(AdminsTable).
+		Where(sq.And{sq.Eq{"venue_id": ids}, sq.Eq{"email": req.Email}}).ToSql()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not build admins sql : %s", err)
+	}
+
+	rows, err := c.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "could not query admins : %s", err)
+	}
+	defer closeRows(c.log, rows)
+
+	administered := map[string]bool{}
+	for rows.Next() {
+		var venueID string
+		if err := rows.Scan(&venueID); err != nil {
+			return nil, status.Errorf(codes.Internal, "could not scan admin row : %s", err)
+		}
+		administered[venueID] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "admins rows error : %s", err)
+	}
+
+	resp := &api.IsAdminBatchResponse{VenueIds: []string{}}
+	for _, id := range ids {
+		if administered[id] {
+			resp.VenueIds = append(resp.VenueIds, id)
+		}
+	}
+
+	return resp, nil
+}
+
+func (c client) GetAdminsBatch(ctx context.Context, req *api.GetAdminsBatchRequest) (*api.GetAdminsBatchResponse, error) {
+	ids, err := batch.IDs(req.VenueIds)
+	if err != nil {
+		return nil, err
+	}
+
+	venues, err := c.getVenueRows(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("venue_id", "email").From(AdminsTable).
+		Where(sq.Eq{"venue_id": ids}).ToSql()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not build admins sql : %s", err)
+	}
+
+	rows, err := c.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "could query admins : %s", err)
+	}
+	defer closeRows(c.log, rows)
+
+	admins := map[string][]string{}
+	for rows.Next() {
+		var venueID, email string
+		if err := rows.Scan(&venueID, &email); err != nil {
+			return nil, status.Errorf(codes.Internal, "could scan admin row: %s", err)
+		}
+		admins[venueID] = append(admins[venueID], email)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "admins rows error : %s", err)
+	}
+
+	resp := &api.GetAdminsBatchResponse{Venues: []*api.VenueAdmins{}}
+	for _, id := range ids {
+		if _, ok := venues[id]; !ok {
+			continue
+		}
+
+		emails := admins[id]
+		if emails == nil {
+			emails = []string{}
+		}
+		resp.Venues = append(resp.Venues, &api.VenueAdmins{VenueId: id, Admins: emails})
+	}
+
+	return resp, nil
+}
+
+// getVenueRows returns the venues with the given ids by id, leaving out those that cannot be found.
+func (c client) getVenueRows(ctx context.Context, ids []string) (map[string]venueRow, error) {
+	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("id", "name", "slug", "version").From(VenuesTable).
+		Where(sq.Eq{"id": ids}).ToSql()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not build venues sql : %s", err)
+	}
+
+	rows, err := c.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "could not query venues : %s", err)
+	}
+	defer closeRows(c.log, rows)
+
+	venues := map[string]venueRow{}
+	for rows.Next() {
+		var row venueRow
+		if err := rows.Scan(&row.id, &row.name, &row.slug, &row.version); err != nil {
+			return nil, status.Errorf(codes.Internal, "could not scan venues row : %s", err)
+		}
+		venues[row.id] = row
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "venues rows error : %s", err)
+	}
+
+	return venues, nil
+}
+
+func (c client) getOpeningHoursBatch(ctx context.Context, ids []string) (map[string][]*models.OpeningHoursSpecification, error) {
+	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("venue_id", "day_of_week", "opens", "closes").
+		From(OpeningHoursTable).Where(sq.Eq{"venue_id": ids}).ToSql()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not build opening hours sql : %s", err)
+	}
+
+	rows, err := c.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "could not query opening hours : %s", err)
+	}
+	defer closeRows(c.log, rows)
+
+	hours := map[string][]*models.OpeningHoursSpecification{}
+	for rows.Next() {
+		var day_of_week uint32
+		var venueID, opens, closes string
+		if err := rows.Scan(&venueID, &day_of_week, &opens, &closes); err != nil {
+			return nil, status.Errorf(codes.Internal, "could not scan opening hours row : %s", err)
+		}
+		hours[venueID] = append(hours[venueID], &models.OpeningHoursSpecification{
+			DayOfWeek: day_of_week,
+			Opens:     opens,
+			Closes:    closes,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "opening hours rows error : %s", err)
+	}
+
+	return hours, nil
+}
+
+func (c client) getSpecialOpeningHoursBatch(ctx context.Context, ids []string) (map[string][]*models.OpeningHoursSpecification, error) {
+	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("venue_id", "day_of_week", "opens", "closes", "valid_from", "valid_through").
+		From(SpecialOpeningHoursTable).Where(sq.Eq{"venue_id": ids}).ToSql()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not build opening hours sql : %s", err)
+	}
+
+	rows, err := c.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "could not query opening hours : %s", err)
+	}
+	defer closeRows(c.log, rows)
+
+	hours := map[string][]*models.OpeningHoursSpecification{}
+	for rows.Next() {
+		var day_of_week uint32
+		var venueID, opens, closes string
+		var valid_from, valid_through time.Time
+		if err := rows.Scan(&venueID, &day_of_week, &opens, &closes, &valid_from, &valid_through); err != nil {
+			return nil, status.Errorf(codes.Internal, "could not scan opening hours row : %s", err)
+		}
+		hours[venueID] = append(hours[venueID], &models.OpeningHoursSpecification{
+			DayOfWeek:    day_of_week,
+			Opens:        opens,
+			Closes:       closes,
+			ValidFrom:    valid_from.Format(time.RFC3339),
+			ValidThrough: valid_through.Format(time.RFC3339),
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "opening hours rows error : %s", err)
+	}
+
+	return hours, nil
+}
+
+// nonNil returns empty hours rather than nil, as the per venue queries do.
+func nonNilHours(hours []*models.OpeningHoursSpecification) []*models.OpeningHoursSpecification {
+	if hours == nil {
+		return []*models.OpeningHoursSpecification{}
+	}
+
+	return hours
+}
+
+func nonNilTables(tables []*models.Table) []*models.Table {
+	if tables == nil {
+		return []*models.Table{}
+	}
+
+	return tables
+}
@@ -3,22 +3,24 @@ package postgres
 import (
 	"context"
 	sql2 "database/sql"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	sq "github.com/Masterminds/squirrel"
+	"github.com/XSAM/otelsql"
 	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
 	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/models"
-	"github.com/golang-migrate/migrate/v4"
-	pgres "github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/semconv"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"net/url"
 	"os"
+	"strconv"
 	"time"
 )
 
@@ -28,62 +30,149 @@ const (
 	VenuesTable              = "venues"
 	TablesTable              = "tables"
 	AdminsTable              = "admins"
+	EventsTable              = "venue_events"
 )
 
+const (
+	uniqueViolation = "23505"
+	checkViolation  = "23514"
+)
+
+// defaultMaxIdleConns matches the database/sql default so that leaving the option unset keeps today's behaviour.
+const defaultMaxIdleConns = 2
+
 var _ api.VenueAPIServer = (*client)(nil)
 
 type client struct {
-	db               *sqlx.DB
-	log              *zap.SugaredLogger
-	pgURL            *url.URL
-	migrationsSource string
-	uuid             uuidGenerator
+	db                *sqlx.DB
+	log               *zap.SugaredLogger
+	pgURL             *url.URL
+	migrationsSource  string
+	uuid              uuidGenerator
+	maxOpenConns      int
+	maxIdleConns      int
+	connMaxLifetime   time.Duration
+	statementTimeout  time.Duration
+	autoMigrate       bool
+	eventPollInterval time.Duration
+	eventRetention    time.Duration
+	registerer        prometheus.Registerer
 }
 
 func NewPostgres(log *zap.SugaredLogger, options ...func(*client)) (api.VenueAPIServer, func(log *zap.SugaredLogger), error) {
-	c := &client{log: log}
-	for i := range options {
-		options[i](c)
+	c, err := newClient(log, options...)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	if c.pgURL == nil {
-		u := os.Getenv("DATABASE_URL")
-		if u == "" {
-			return nil, nil, fmt.Errorf("environment variable 'DATABASE_URL' is not set")
+	if c.autoMigrate {
+		if err := c.migrate(); err != nil {
+			c.close()
+			return nil, nil, fmt.Errorf("could not migrate : %w", err)
 		}
-		p, err := url.Parse(u)
-		if err != nil {
-			return nil, nil, fmt.Errorf("could not parse 'DATABASE_URL'")
-		}
-		c.pgURL = p
 	}
 
-	if c.migrationsSource == "" {
-		c.migrationsSource = "file://internal/postgres/migrations"
+	if err := c.registerMetrics(); err != nil {
+		c.close()
+		return nil, nil, err
+	}
+
+	ctx, stopPruning := context.WithCancel(context.Background())
+	if c.eventRetention > 0 {
+		go c.pruneEvents(ctx)
+	}
+
+	return c, func(log *zap.SugaredLogger) {
+		stopPruning()
+		c.close()
+	}, nil
+}
+
+func newClient(log *zap.SugaredLogger, options ...func(*client)) (*client, error) {
+	c := &client{log: log, maxIdleConns: defaultMaxIdleConns, autoMigrate: true, eventPollInterval: defaultEventPollInterval,
+		eventRetention: defaultEventRetention}
+	for i := range options {
+		options[i](c)
+	}
+
+	if err := c.resolveDatabaseURL(); err != nil {
+		return nil, err
 	}
 
 	if c.uuid == nil {
 		c.uuid = newRandomUUID()
 	}
 
-	db, err := sqlx.Connect("postgres", c.pgURL.String())
+	// the statement timeout is only applied to the service's own connections so that long running migrations are not
+	// cut short
+	dsn := *c.pgURL
+	if c.statementTimeout > 0 {
+		q := dsn.Query()
+		q.Set("statement_timeout", strconv.FormatInt(c.statementTimeout.Milliseconds(), 10))
+		dsn.RawQuery = q.Encode()
+	}
+
+	// queries are made through a wrapped driver so each statement is recorded as a span of the calling request
+	connector, err := otelsql.WrapDriver(&pq.Driver{}, semconv.DBSystemPostgres.Value.AsString()).(driver.DriverContext).OpenConnector(dsn.String())
 	if err != nil {
-		return nil, nil, fmt.Errorf("could not connect to database : %w", err)
+		return nil, fmt.Errorf("could not open database connector : %w", err)
+	}
+
+	db := sqlx.NewDb(sql2.OpenDB(connector), "postgres")
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("could not connect to database : %w", err)
 	}
+	db.SetMaxOpenConns(c.maxOpenConns)
+	db.SetMaxIdleConns(c.maxIdleConns)
+	db.SetConnMaxLifetime(c.connMaxLifetime)
 	c.db = db
 
-	if err := c.migrate(); err != nil {
-		return nil, nil, fmt.Errorf("could not migrate : %w", err)
+	return c, nil
+}
+
+func (c *client) resolveDatabaseURL() error {
+	if c.pgURL != nil {
+		return nil
 	}
 
-	return c, func(log *zap.SugaredLogger) {
-		if err := c.db.Close(); err != nil {
-			c.log.Errorf("could not close database connection : %s", err)
-		}
-	}, nil
+	u := os.Getenv("DATABASE_URL")
+	if u == "" {
+		return fmt.Errorf("environment variable 'DATABASE_URL' is not set")
+	}
+	p, err := url.Parse(u)
+	if err != nil {
+		return fmt.Errorf("could not parse 'DATABASE_URL'")
+	}
+	c.pgURL = p
+
+	return nil
+}
+
+// Ping reports whether the database can be reached.
+func (c client) Ping(ctx context.Context) error {
+	return c.db.PingContext(ctx)
 }
 
+func (c client) close() {
+	if err := c.db.Close(); err != nil {
+		c.log.Errorf("could not close database connection : %s", err)
+	}
+}
+
+// GetTables reads the tables and the version in one snapshot, so the version returned is that of the tables.
 func (c client) GetTables(ctx context.Context, req *api.GetTablesRequest) (*api.GetTablesResponse, error) {
+	tx, err := c.db.BeginTxx(ctx, &sql2.TxOptions{Isolation: sql2.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "could not begin transaction : %s", err)
+	}
+	defer rollback(tx)
+
+	version, err := getVersion(ctx, tx, req.VenueId)
+	if err != nil {
+		return nil, err
+	}
+
 	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Select("id", "name", "capacity").
 		From(TablesTable).Where(sq.Eq{"venue_id": req.VenueId}).ToSql()
@@ -92,34 +181,49 @@ func (c client) GetTables(ctx context.Context, req *api.GetTablesRequest) (*api.
 	}
 
 	tables := []*models.Table{}
-	rows, err := c.db.Query(sql, args...)
-	if err != nil && !errors.Is(err, sql2.ErrNoRows) {
-		return nil, status.Errorf(codes.Internal, "could not query tables : %s", err)
-	}
-	if rows != nil {
-		for rows.Next() {
-			var capacity uint32
-			var id, name string
-			if err := rows.Scan(&id, &name, &capacity); err != nil {
-				return nil, status.Errorf(codes.Internal, "could not scan tables row : %s", err)
-			}
-			tables = append(tables, &models.Table{
-				Id:       id,
-				Name:     name,
-				Capacity: capacity,
-			})
-		}
+	rows, err := tx.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "could not query tables : %s", err)
+	}
+	defer closeRows(c.log, rows)
 
-		if err := rows.Err(); err != nil {
-			return nil, status.Errorf(codes.Internal, "tables rows error : %s", err)
+	for rows.Next() {
+		var capacity uint32
+		var id, name string
+		if err := rows.Scan(&id, &name, &capacity); err != nil {
+			return nil, status.Errorf(codes.Internal, "could not scan tables row : %s", err)
 		}
+		tables = append(tables, &models.Table{
+			Id:       id,
+			Name:     name,
+			Capacity: capacity,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "tables rows error : %s", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not commit transaction : %s", err)
 	}
 
-	return &api.GetTablesResponse{Tables: tables}, nil
+	return &api.GetTablesResponse{Tables: tables, Version: version}, nil
 }
 
 func (c client) AddTable(ctx context.Context, req *api.AddTableRequest) (*models.Table, error) {
 	id := c.uuid.UUID()
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "could not begin transaction : %s", err)
+	}
+	defer rollback(tx)
+
+	version, err := c.incrementVersion(ctx, tx, req.VenueId, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
 	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Insert(TablesTable).
 		Columns("id", "venue_id", "name", "capacity").
@@ -128,18 +232,44 @@ func (c client) AddTable(ctx context.Context, req *api.AddTableRequest) (*models
 		return nil, status.Errorf(codes.Internal, "could not build table sql : %s", err)
 	}
 
-	if _, err := c.db.Exec(sql, args...); err != nil {
-		return nil, status.Errorf(codes.Internal, "could not insert table : %s", err)
+	if _, err := tx.ExecContext(ctx, sql, args...); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not insert table : %s", err)
 	}
 
-	return &models.Table{
+	table := &models.Table{
 		Id:       id,
 		Name:     req.Name,
 		Capacity: req.Capacity,
-	}, nil
+	}
+
+	if err := c.publish(ctx, tx, &api.VenueEvent{
+		VenueId: req.VenueId,
+		Type:    api.VenueEventType_TABLE_ADDED,
+		Version: version,
+		Table:   table,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not commit transaction : %s", err)
+	}
+
+	return table, nil
 }
 
 func (c client) RemoveTable(ctx context.Context, req *api.RemoveTableRequest) (*models.Table, error) {
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "could not begin transaction : %s", err)
+	}
+	defer rollback(tx)
+
+	version, err := c.incrementVersion(ctx, tx, req.VenueId, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
 	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Select("id", "name", "capacity").
 		From(TablesTable).
@@ -149,20 +279,14 @@ func (c client) RemoveTable(ctx context.Context, req *api.RemoveTableRequest) (*
 		return nil, status.Errorf(codes.Internal, "could not build select table sql : %s", err)
 	}
 
-	row := c.db.QueryRow(sql, args...)
-	if row.Err() != nil {
-		c.log.Errorw("could not query row", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "internal database error")
-	}
-
 	var id, name string
 	var capacity uint32
-	if err := c.db.QueryRow(sql, args...).Scan(&id, &name, &capacity); err != nil {
+	if err := tx.QueryRowContext(ctx, sql, args...).Scan(&id, &name, &capacity); err != nil {
 		if errors.Is(err, sql2.ErrNoRows) {
 			return nil, status.Errorf(codes.NotFound, "could not find venue")
 		}
 
-		return nil, status.Errorf(codes.Internal, "could get find venue : %s", err)
+		return nil, status.Errorf(errorCode(err), "could get find venue : %s", err)
 	}
 
 	sql, args, err = sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
@@ -173,13 +297,29 @@ func (c client) RemoveTable(ctx context.Context, req *api.RemoveTableRequest) (*
 		return nil, status.Errorf(codes.Internal, "could not build select table sql : %s", err)
 	}
 
-	if _, err := c.db.Exec(sql, args...); err != nil {
-		return nil, status.Errorf(codes.Internal, "could not delete table : %s", err)
+	if _, err := tx.ExecContext(ctx, sql, args...); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not delete table : %s", err)
 	}
 
-	return &models.Table{Id: id, Name: name, Capacity: capacity}, nil
+	table := &models.Table{Id: id, Name: name, Capacity: capacity}
+
+	if err := c.publish(ctx, tx, &api.VenueEvent{
+		VenueId: req.VenueId,
+		Type:    api.VenueEventType_TABLE_REMOVED,
+		Version: version,
+		Table:   table,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not commit transaction : %s", err)
+	}
+
+	return table, nil
 }
 
+// GetVenue reads the venue and its opening hours in one snapshot, so the version returned is that of the hours.
 func (c client) GetVenue(ctx context.Context, req *api.GetVenueRequest) (*models.Venue, error) {
 	where := sq.And{}
 	if req.Id != "" {
@@ -189,41 +329,53 @@ func (c client) GetVenue(ctx context.Context, req *api.GetVenueRequest) (*models
 		where = append(where, sq.Eq{"slug": req.Slug})
 	}
 	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
-		Select("id", "name", "slug").From(VenuesTable).
+		Select("id", "name", "slug", "version").From(VenuesTable).
 		Where(where).ToSql()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "could not venue build sql : %s", err)
 	}
 
+	tx, err := c.db.BeginTxx(ctx, &sql2.TxOptions{Isolation: sql2.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "could not begin transaction : %s", err)
+	}
+	defer rollback(tx)
+
 	var id, name, slug string
-	if err := c.db.QueryRow(sql, args...).Scan(&id, &name, &slug); err != nil {
+	var version int64
+	if err := tx.QueryRowContext(ctx, sql, args...).Scan(&id, &name, &slug, &version); err != nil {
 		if errors.Is(err, sql2.ErrNoRows) {
 			return nil, status.Errorf(codes.NotFound, "could not find venue")
 		}
 
-		return nil, status.Errorf(codes.Internal, "could get find venue : %s", err)
+		return nil, status.Errorf(errorCode(err), "could get find venue : %s", err)
 	}
 
-	hours, err := c.getOpeningHours(id)
+	hours, err := c.getOpeningHours(ctx, tx, id)
 	if err != nil {
 		return nil, fmt.Errorf("could not get opening hours : %w", err)
 	}
 
-	specialHours, err := c.getSpecialOpeningHours(id)
+	specialHours, err := c.getSpecialOpeningHours(ctx, tx, id)
 	if err != nil {
 		return nil, fmt.Errorf("could not get special opening hours : %w", err)
 	}
 
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not commit transaction : %s", err)
+	}
+
 	return &models.Venue{
 		Id:                  id,
 		Name:                name,
 		OpeningHours:        hours,
 		SpecialOpeningHours: specialHours,
 		Slug:                slug,
+		Version:             version,
 	}, nil
 }
 
-func (c client) getOpeningHours(venueId string) ([]*models.OpeningHoursSpecification, error) {
+func (c client) getOpeningHours(ctx context.Context, db sqlx.QueryerContext, venueId string) ([]*models.OpeningHoursSpecification, error) {
 	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Select("day_of_week", "opens", "closes").
 		From(OpeningHoursTable).Where(sq.Eq{"venue_id": venueId}).ToSql()
@@ -232,33 +384,33 @@ func (c client) getOpeningHours(venueId string) ([]*models.OpeningHoursSpecifica
 	}
 
 	hours := []*models.OpeningHoursSpecification{}
-	rows, err := c.db.Query(sql, args...)
-	if err != nil && !errors.Is(err, sql2.ErrNoRows) {
-		return nil, status.Errorf(codes.Internal, "could not query opening hours : %s", err)
-	}
-	if rows != nil {
-		for rows.Next() {
-			var day_of_week uint32
-			var opens, closes string
-			if err := rows.Scan(&day_of_week, &opens, &closes); err != nil {
-				return nil, status.Errorf(codes.Internal, "could not scan opening hours row : %s", err)
-			}
-			hours = append(hours, &models.OpeningHoursSpecification{
-				DayOfWeek: day_of_week,
-				Opens:     opens,
-				Closes:    closes,
-			})
-		}
+	rows, err := db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "could not query opening hours : %s", err)
+	}
+	defer closeRows(c.log, rows)
 
-		if err := rows.Err(); err != nil {
-			return nil, status.Errorf(codes.Internal, "opening hours rows error : %s", err)
+	for rows.Next() {
+		var day_of_week uint32
+		var opens, closes string
+		if err := rows.Scan(&day_of_week, &opens, &closes); err != nil {
+			return nil, status.Errorf(codes.Internal, "could not scan opening hours row : %s", err)
 		}
+		hours = append(hours, &models.OpeningHoursSpecification{
+			DayOfWeek: day_of_week,
+			Opens:     opens,
+			Closes:    closes,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "opening hours rows error : %s", err)
 	}
 
 	return hours, nil
 }
 
-func (c client) getSpecialOpeningHours(venueId string) ([]*models.OpeningHoursSpecification, error) {
+func (c client) getSpecialOpeningHours(ctx context.Context, db sqlx.QueryerContext, venueId string) ([]*models.OpeningHoursSpecification, error) {
 	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Select("day_of_week", "opens", "closes", "valid_from", "valid_through").
 		From(SpecialOpeningHoursTable).Where(sq.Eq{"venue_id": venueId}).ToSql()
@@ -267,30 +419,30 @@ func (c client) getSpecialOpeningHours(venueId string) ([]*models.OpeningHoursSp
 	}
 
 	hours := []*models.OpeningHoursSpecification{}
-	rows, err := c.db.Query(sql, args...)
-	if err != nil && !errors.Is(err, sql2.ErrNoRows) {
-		return nil, status.Errorf(codes.Internal, "could not query opening hours : %s", err)
-	}
-	if rows != nil {
-		for rows.Next() {
-			var day_of_week uint32
-			var opens, closes string
-			var valid_from, valid_through time.Time
-			if err := rows.Scan(&day_of_week, &opens, &closes, &valid_from, &valid_through); err != nil {
-				return nil, status.Errorf(codes.Internal, "could not scan opening hours row : %s", err)
-			}
-			hours = append(hours, &models.OpeningHoursSpecification{
-				DayOfWeek:    day_of_week,
-				Opens:        opens,
-				Closes:       closes,
-				ValidFrom:    valid_from.Format(time.RFC3339),
-				ValidThrough: valid_through.Format(time.RFC3339),
-			})
-		}
+	rows, err := db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "could not query opening hours : %s", err)
+	}
+	defer closeRows(c.log, rows)
 
-		if err := rows.Err(); err != nil {
-			return nil, status.Errorf(codes.Internal, "opening hours rows error : %s", err)
+	for rows.Next() {
+		var day_of_week uint32
+		var opens, closes string
+		var valid_from, valid_through time.Time
+		if err := rows.Scan(&day_of_week, &opens, &closes, &valid_from, &valid_through); err != nil {
+			return nil, status.Errorf(codes.Internal, "could not scan opening hours row : %s", err)
 		}
+		hours = append(hours, &models.OpeningHoursSpecification{
+			DayOfWeek:    day_of_week,
+			Opens:        opens,
+			Closes:       closes,
+			ValidFrom:    valid_from.Format(time.RFC3339),
+			ValidThrough: valid_through.Format(time.RFC3339),
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "opening hours rows error : %s", err)
 	}
 
 	return hours, nil
@@ -298,10 +450,11 @@ func (c client) getSpecialOpeningHours(venueId string) ([]*models.OpeningHoursSp
 
 func (c client) CreateVenue(ctx context.Context, req *api.CreateVenueRequest) (*models.Venue, error) {
 	id := c.uuid.UUID()
-	tx, err := c.db.Beginx()
+	tx, err := c.db.BeginTxx(ctx, nil)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "could not begin transaction : %s", err)
+		return nil, status.Errorf(errorCode(err), "could not begin transaction : %s", err)
 	}
+	defer rollback(tx)
 
 	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Insert(VenuesTable).
@@ -310,34 +463,45 @@ func (c client) CreateVenue(ctx context.Context, req *api.CreateVenueRequest) (*
 		return nil, status.Errorf(codes.Internal, "could not build venue sql : %s", err)
 	}
 
-	if _, err := tx.Exec(sql, args...); err != nil {
-		return nil, status.Errorf(codes.Internal, "could not insert venue : %s", err)
+	if _, err := tx.ExecContext(ctx, sql, args...); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not insert venue : %s", err)
 	}
 
-	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
-		Insert(OpeningHoursTable).
-		Columns("venue_id", "day_of_week", "opens", "closes")
+	if len(req.OpeningHours) > 0 {
+		builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+			Insert(OpeningHoursTable).
+			Columns("venue_id", "day_of_week", "opens", "closes")
 
-	for _, hours := range req.OpeningHours {
-		builder = builder.Values(
-			id,
-			hours.DayOfWeek,
-			hours.Opens,
-			hours.Closes,
-		)
-	}
+		for _, hours := range req.OpeningHours {
+			builder = builder.Values(
+				id,
+				hours.DayOfWeek,
+				hours.Opens,
+				hours.Closes,
+			)
+		}
 
-	sql, args, err = builder.ToSql()
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "could not build opening_hours sql : %s", err)
+		sql, args, err = builder.ToSql()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not build opening_hours sql : %s", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, sql, args...); err != nil {
+			return nil, status.Errorf(errorCode(err), "could not insert opening hours : %s", err)
+		}
 	}
 
-	if _, err := tx.Exec(sql, args...); err != nil {
-		return nil, status.Errorf(codes.Internal, "could not insert opening hours : %s", err)
+	if err := c.publish(ctx, tx, &api.VenueEvent{
+		VenueId:      id,
+		Type:         api.VenueEventType_VENUE_CREATED,
+		Version:      1,
+		OpeningHours: req.OpeningHours,
+	}); err != nil {
+		return nil, err
 	}
 
 	if err := tx.Commit(); err != nil {
-		return nil, status.Errorf(codes.Internal, "could not commit transaction : %s", err)
+		return nil, status.Errorf(errorCode(err), "could not commit transaction : %s", err)
 	}
 
 	return &models.Venue{
@@ -345,6 +509,7 @@ func (c client) CreateVenue(ctx context.Context, req *api.CreateVenueRequest) (*
 		Name:                req.Name,
 		OpeningHours:        req.OpeningHours,
 		SpecialOpeningHours: nil,
+		Version:             1,
 	}, nil
 }
 
@@ -354,14 +519,33 @@ func (c client) GetOpeningHoursSpecification(ctx context.Context, req *api.GetOp
 		return nil, status.Errorf(codes.InvalidArgument, "could not parse date. should be in format '%s'", time.RFC3339)
 	}
 
-	weekday := date.Weekday()
-	if weekday == 0 {
-		weekday = 7
+	specialHours, err := c.getSpecialOpeningHours(ctx, c.db, req.VenueId)
+	if err != nil {
+		return nil, fmt.Errorf("could not get special opening hours : %w", err)
 	}
 
-	specialHours, err := c.getSpecialOpeningHours(req.VenueId)
+	openHours, err := c.getOpeningHours(ctx, c.db, req.VenueId)
 	if err != nil {
-		return nil, fmt.Errorf("could not get special opening hours : %w", err)
+		return nil, fmt.Errorf("could not get opening hours : %w", err)
+	}
+
+	hours, err := openingHoursOn(date, specialHours, openHours)
+	if err != nil {
+		return nil, err
+	}
+	if hours == nil {
+		return nil, status.Error(codes.NotFound, "venue is not open for business on given date")
+	}
+
+	return &api.GetOpeningHoursSpecificationResponse{Specification: hours}, nil
+}
+
+// openingHoursOn returns the hours a venue is open on the date, preferring special opening hours to its usual ones.
+// It returns nil when the venue is not open for business on the date.
+func openingHoursOn(date time.Time, specialHours, openHours []*models.OpeningHoursSpecification) (*models.OpeningHoursSpecification, error) {
+	weekday := date.Weekday()
+	if weekday == 0 {
+		weekday = 7
 	}
 
 	for _, hours := range specialHours {
@@ -375,30 +559,31 @@ func (c client) GetOpeningHoursSpecification(ctx context.Context, req *api.GetOp
 		}
 
 		if from.Before(date) && through.After(date) && uint32(weekday) == hours.DayOfWeek {
-			return &api.GetOpeningHoursSpecificationResponse{Specification: hours}, nil
+			return hours, nil
 		}
 	}
 
-	openHours, err := c.getOpeningHours(req.VenueId)
-	if err != nil {
-		return nil, fmt.Errorf("could not get opening hours : %w", err)
-	}
-
 	for _, hours := range openHours {
 		if uint32(weekday) == hours.DayOfWeek {
-			return &api.GetOpeningHoursSpecificationResponse{Specification: hours}, nil
+			return hours, nil
 		}
 	}
 
-	return nil, status.Error(codes.NotFound, "venue is not open for business on given date")
+	return nil, nil
 }
 
 func (c client) UpdateOpeningHours(ctx context.Context, req *api.UpdateOpeningHoursRequest) (*api.UpdateOpeningHoursResponse, error) {
 	c.log.Infof("updating opening hours for venue '%s'", req.VenueId)
 
-	tx, err := c.db.Beginx()
+	tx, err := c.db.BeginTxx(ctx, nil)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "could not begin transaction : %s", err)
+		return nil, status.Errorf(errorCode(err), "could not begin transaction : %s", err)
+	}
+	defer rollback(tx)
+
+	version, err := c.incrementVersion(ctx, tx, req.VenueId, req.Version)
+	if err != nil {
+		return nil, err
 	}
 
 	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
@@ -408,8 +593,8 @@ func (c client) UpdateOpeningHours(ctx context.Context, req *api.UpdateOpeningHo
 		return nil, status.Errorf(codes.Internal, "could not build delete sql : %s", err)
 	}
 
-	if _, err := tx.Exec(sql, args...); err != nil {
-		return nil, status.Errorf(codes.Internal, "could not delete venue opening hours : %s", err)
+	if _, err := tx.ExecContext(ctx, sql, args...); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not delete venue opening hours : %s", err)
 	}
 
 	if len(req.OpeningHours) > 0 {
@@ -431,24 +616,39 @@ func (c client) UpdateOpeningHours(ctx context.Context, req *api.UpdateOpeningHo
 			return nil, status.Errorf(codes.Internal, "could not build opening_hours sql : %s", err)
 		}
 
-		if _, err := tx.Exec(sql, args...); err != nil {
-			return nil, status.Errorf(codes.Internal, "could not insert opening hours : %s", err)
+		if _, err := tx.ExecContext(ctx, sql, args...); err != nil {
+			return nil, status.Errorf(errorCode(err), "could not insert opening hours : %s", err)
 		}
 	}
 
+	if err := c.publish(ctx, tx, &api.VenueEvent{
+		VenueId:      req.VenueId,
+		Type:         api.VenueEventType_OPENING_HOURS_UPDATED,
+		Version:      version,
+		OpeningHours: req.OpeningHours,
+	}); err != nil {
+		return nil, err
+	}
+
 	if err := tx.Commit(); err != nil {
-		return nil, status.Errorf(codes.Internal, "could not commit transaction : %s", err)
+		return nil, status.Errorf(errorCode(err), "could not commit transaction : %s", err)
 	}
 
-	return &api.UpdateOpeningHoursResponse{OpeningHours: req.OpeningHours}, nil
+	return &api.UpdateOpeningHoursResponse{OpeningHours: req.OpeningHours, Version: version}, nil
 }
 
 func (c client) UpdateSpecialOpeningHours(ctx context.Context, req *api.UpdateOpeningHoursRequest) (*api.UpdateOpeningHoursResponse, error) {
 	c.log.Infof("updating special opening hours for venue '%s'", req.VenueId)
 
-	tx, err := c.db.Beginx()
+	tx, err := c.db.BeginTxx(ctx, nil)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "could not begin transaction : %s", err)
+		return nil, status.Errorf(errorCode(err), "could not begin transaction : %s", err)
+	}
+	defer rollback(tx)
+
+	version, err := c.incrementVersion(ctx, tx, req.VenueId, req.Version)
+	if err != nil {
+		return nil, err
 	}
 
 	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
@@ -458,8 +658,8 @@ func (c client) UpdateSpecialOpeningHours(ctx context.Context, req *api.UpdateOp
 		return nil, status.Errorf(codes.Internal, "could not build delete sql : %s", err)
 	}
 
-	if _, err := tx.Exec(sql, args...); err != nil {
-		return nil, status.Errorf(codes.Internal, "could not delete venue special opening hours : %s", err)
+	if _, err := tx.ExecContext(ctx, sql, args...); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not delete venue special opening hours : %s", err)
 	}
 
 	if len(req.OpeningHours) > 0 {
@@ -491,16 +691,25 @@ func (c client) UpdateSpecialOpeningHours(ctx context.Context, req *api.UpdateOp
 			return nil, status.Errorf(codes.Internal, "could not build opening_hours sql : %s", err)
 		}
 
-		if _, err := tx.Exec(sql, args...); err != nil {
-			return nil, status.Errorf(codes.Internal, "could not insert special opening hours : %s", err)
+		if _, err := tx.ExecContext(ctx, sql, args...); err != nil {
+			return nil, status.Errorf(errorCode(err), "could not insert special opening hours : %s", err)
 		}
 	}
 
+	if err := c.publish(ctx, tx, &api.VenueEvent{
+		VenueId:      req.VenueId,
+		Type:         api.VenueEventType_SPECIAL_OPENING_HOURS_UPDATED,
+		Version:      version,
+		OpeningHours: req.OpeningHours,
+	}); err != nil {
+		return nil, err
+	}
+
 	if err := tx.Commit(); err != nil {
-		return nil, status.Errorf(codes.Internal, "could not commit transaction : %s", err)
+		return nil, status.Errorf(errorCode(err), "could not commit transaction : %s", err)
 	}
 
-	return &api.UpdateOpeningHoursResponse{OpeningHours: req.OpeningHours}, nil
+	return &api.UpdateOpeningHoursResponse{OpeningHours: req.OpeningHours, Version: version}, nil
 }
 
 func (c client) IsAdmin(ctx context.Context, req *api.IsAdminRequest) (*api.IsAdminResponse, error) {
@@ -516,12 +725,12 @@ func (c client) IsAdmin(ctx context.Context, req *api.IsAdminRequest) (*api.IsAd
 		}
 
 		var id string
-		if err := c.db.QueryRow(sql, args...).Scan(&id); err != nil {
+		if err := c.db.QueryRowContext(ctx, sql, args...).Scan(&id); err != nil {
 			if errors.Is(err, sql2.ErrNoRows) {
 				return nil, status.Errorf(codes.NotFound, "could not find venue")
 			}
 
-			return nil, status.Errorf(codes.Internal, "could get find venue : %s", err)
+			return nil, status.Errorf(errorCode(err), "could get find venue : %s", err)
 		}
 
 		venueID = id
@@ -538,16 +747,16 @@ func (c client) IsAdmin(ctx context.Context, req *api.IsAdminRequest) (*api.IsAd
 		return nil, status.Errorf(codes.Internal, "internal database error")
 	}
 
-	row := c.db.QueryRow(sql, args...)
-	if row.Err() != nil {
+	row := c.db.QueryRowContext(ctx, sql, args...)
+	if err := row.Err(); err != nil {
 		c.log.Errorw("could not query row", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "internal database error")
+		return nil, status.Errorf(errorCode(err), "could not query admins : %s", err)
 	}
 
 	var count int
 	if err := row.Scan(&count); err != nil {
 		c.log.Errorw("could not scan row", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "internal database error")
+		return nil, status.Errorf(errorCode(err), "could not scan admins row : %s", err)
 	}
 
 	if count != 1 {
@@ -566,10 +775,28 @@ func (c client) AddAdmin(ctx context.Context, req *api.AddAdminRequest) (*api.Ad
 		return nil, status.Errorf(codes.Internal, "internal database error")
 	}
 
-	_, err = c.db.Exec(sql, args...)
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "could not begin transaction : %s", err)
+	}
+	defer rollback(tx)
+
+	_, err = tx.ExecContext(ctx, sql, args...)
 	if err != nil {
 		c.log.Errorw("could not insert row", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "could not insert row")
+		return nil, status.Errorf(errorCode(err), "could not insert row")
+	}
+
+	if err := c.publish(ctx, tx, &api.VenueEvent{
+		VenueId: req.VenueId,
+		Type:    api.VenueEventType_ADMIN_ADDED,
+		Email:   req.Email,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not commit transaction : %s", err)
 	}
 
 	return &api.AddAdminResponse{
@@ -587,10 +814,35 @@ func (c client) RemoveAdmin(ctx context.Context, req *api.RemoveAdminRequest) (*
 		return nil, status.Errorf(codes.Internal, "internal database error")
 	}
 
-	_, err = c.db.Exec(sql, args...)
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "could not begin transaction : %s", err)
+	}
+	defer rollback(tx)
+
+	result, err := tx.ExecContext(ctx, sql, args...)
 	if err != nil {
 		c.log.Errorw("could not delete row", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "could not delete row")
+		return nil, status.Errorf(errorCode(err), "could not delete row")
+	}
+
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get deleted rows : %s", err)
+	}
+
+	if removed > 0 {
+		if err := c.publish(ctx, tx, &api.VenueEvent{
+			VenueId: req.VenueId,
+			Type:    api.VenueEventType_ADMIN_REMOVED,
+			Email:   req.Email,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(errorCode(err), "could not commit transaction : %s", err)
 	}
 
 	return &api.RemoveAdminResponse{Email: req.Email}, nil
@@ -605,10 +857,12 @@ func (c client) GetAdmins(ctx context.Context, req *api.GetAdminsRequest) (*api.
 	}
 
 	emails := []string{}
-	rows, err := c.db.Queryx(sql, args...)
+	rows, err := c.db.QueryContext(ctx, sql, args...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "could query admins : %s", err)
+		return nil, status.Errorf(errorCode(err), "could query admins : %s", err)
 	}
+	defer closeRows(c.log, rows)
+
 	for rows.Next() {
 		email := ""
 		if err := rows.Scan(&email); err != nil {
@@ -618,30 +872,105 @@ func (c client) GetAdmins(ctx context.Context, req *api.GetAdminsRequest) (*api.
 		emails = append(emails, email)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "admins rows error : %s", err)
+	}
+
 	return &api.GetAdminsResponse{Admins: emails}, nil
 }
 
-func (c *client) migrate() error {
-	driver, err := pgres.WithInstance(c.db.DB, &pgres.Config{})
+// getVersion reads the configuration version of a venue inside the given transaction.
+func getVersion(ctx context.Context, tx *sqlx.Tx, venueID string) (int64, error) {
+	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("version").From(VenuesTable).
+		Where(sq.Eq{"id": venueID}).ToSql()
 	if err != nil {
-		return fmt.Errorf("could not create database driver : %w", err)
+		return 0, status.Errorf(codes.Internal, "could not build version sql : %s", err)
 	}
 
-	m, err := migrate.NewWithDatabaseInstance(
-		c.migrationsSource,
-		"postgres", driver)
+	var version int64
+	if err := tx.QueryRowContext(ctx, sql, args...).Scan(&version); err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return 0, status.Errorf(codes.NotFound, "could not find venue")
+		}
+
+		return 0, status.Errorf(errorCode(err), "could not get venue version : %s", err)
+	}
+
+	return version, nil
+}
+
+// incrementVersion bumps the configuration version of a venue inside the given transaction. The row lock taken by the
+// update serialises concurrent writers, so a caller holding a stale version receives codes.Aborted rather than
+// silently overwriting another change.
+func (c client) incrementVersion(ctx context.Context, tx *sqlx.Tx, venueID string, version int64) (int64, error) {
+	if version == 0 {
+		return 0, status.Error(codes.InvalidArgument, "venue version must be given")
+	}
+
+	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(VenuesTable).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.And{sq.Eq{"id": venueID}, sq.Eq{"version": version}}).
+		Suffix("RETURNING version").ToSql()
 	if err != nil {
-		return fmt.Errorf("error instantiating migrate : %w", err)
+		return 0, status.Errorf(codes.Internal, "could not build version sql : %s", err)
+	}
+
+	var updated int64
+	if err := tx.QueryRowContext(ctx, sql, args...).Scan(&updated); err != nil {
+		if !errors.Is(err, sql2.ErrNoRows) {
+			return 0, status.Errorf(errorCode(err), "could not update venue version : %s", err)
+		}
+
+		sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+			Select("COUNT(*)").From(VenuesTable).
+			Where(sq.Eq{"id": venueID}).ToSql()
+		if err != nil {
+			return 0, status.Errorf(codes.Internal, "could not build venue sql : %s", err)
+		}
+
+		var count int
+		if err := tx.QueryRowContext(ctx, sql, args...).Scan(&count); err != nil {
+			return 0, status.Errorf(errorCode(err), "could not count venues : %s", err)
+		}
+
+		if count == 0 {
+			return 0, status.Errorf(codes.NotFound, "could not find venue")
+		}
+
+		return 0, status.Errorf(codes.Aborted, "venue has been modified since version %d", version)
 	}
 
-	version, dirty, _ := m.Version()
-	c.log.Infof("database version %d, dirty %t", version, dirty)
+	return updated, nil
+}
+
+// rollback abandons a transaction on any early return, releasing the row lock taken by incrementVersion. It is a
+// no-op once the transaction has been committed.
+func rollback(tx *sqlx.Tx) {
+	_ = tx.Rollback()
+}
 
-	c.log.Infof("starting migration")
-	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
-		return fmt.Errorf("an error occurred while syncing the database.. %w", err)
+func closeRows(log *zap.SugaredLogger, rows *sql2.Rows) {
+	if err := rows.Close(); err != nil {
+		log.Errorf("could not close rows : %s", err)
 	}
+}
 
-	c.log.Infof("migration successfully")
-	return nil
+// errorCode reports cancelled and timed out queries and constraint violations with the matching gRPC code so that
+// callers can tell them apart from genuine database failures.
+func errorCode(err error) codes.Code {
+	var pqErr *pq.Error
+	switch {
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	case errors.As(err, &pqErr) && pqErr.Code == uniqueViolation:
+		return codes.AlreadyExists
+	case errors.As(err, &pqErr) && pqErr.Code == checkViolation:
+		return codes.InvalidArgument
+	default:
+		return codes.Internal
+	}
 }
@@ -3,18 +3,12 @@
 package postgres_test
 
 import (
-	"context"
-	"github.com/bradleyjkemp/cupaloy/v2"
 	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
-	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/models"
+	"github.com/cobbinma/booking-platform/lib/venue_api/internal/conformance"
 	"github.com/cobbinma/booking-platform/lib/venue_api/internal/postgres"
-	"github.com/google/uuid"
 	"github.com/ory/dockertest/v3"
-	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"net"
 	"net/url"
 	"runtime"
@@ -68,8 +62,10 @@ func Test_Repository(t *testing.T) {
 	require.NoError(t, pool.Retry(func() error {
 		p, c, err := postgres.
 			NewPostgres(log, postgres.WithDatabaseURL(pgURL),
-				postgres.WithMigrationsSourceURL("file://migrations"),
-				postgres.WithStaticUUIDGenerator("b31a9f99-3f64-4ee9-af27-45b2acd36d86"))
+				postgres.WithStaticUUIDGenerator(conformance.UUID),
+				postgres.WithMaxOpenConns(4),
+				postgres.WithStatementTimeout(5*time.Second),
+				postgres.WithEventPollInterval(100*time.Millisecond))
 		if err != nil {
 			return err
 		}
@@ -79,346 +75,5 @@ func Test_Repository(t *testing.T) {
 	}))
 	defer closeDB(log)
 
-	s := suite(repository)
-	for i := range s {
-		t.Run(s[i].name, s[i].test)
-	}
-}
-
-type test struct {
-	name string
-	test func(t *testing.T)
-}
-
-func suite(repository api.VenueAPIServer) []test {
-	const UUID = "b31a9f99-3f64-4ee9-af27-45b2acd36d86"
-	const Slug = "test-venue"
-	return []test{
-		{
-			name: "add venue successfully",
-			test: func(t *testing.T) {
-				ctx := context.Background()
-				venue, err := repository.CreateVenue(ctx, &api.CreateVenueRequest{
-					Name: "Test Venue",
-					OpeningHours: []*models.OpeningHoursSpecification{
-						{
-							DayOfWeek:    1,
-							Opens:        "10:00",
-							Closes:       "20:00",
-							ValidFrom:    "",
-							ValidThrough: "",
-						},
-						{
-							DayOfWeek:    2,
-							Opens:        "10:00",
-							Closes:       "22:00",
-							ValidFrom:    "",
-							ValidThrough: "",
-						},
-					},
-					Slug: Slug,
-				})
-				require.NoError(t, err)
-
-				cupaloy.New(cupaloy.UseStringerMethods(false)).SnapshotT(t, venue)
-			},
-		},
-		{
-			name: "get venue successfully",
-			test: func(t *testing.T) {
-				ctx := context.Background()
-				venues, err := repository.GetVenue(ctx, &api.GetVenueRequest{Id: UUID})
-				require.NoError(t, err)
-
-				cupaloy.New(cupaloy.UseStringerMethods(false)).SnapshotT(t, venues)
-			},
-		},
-		{
-			name: "get venue by slug successfully",
-			test: func(t *testing.T) {
-				ctx := context.Background()
-				venues, err := repository.GetVenue(ctx, &api.GetVenueRequest{Slug: Slug})
-				require.NoError(t, err)
-
-				cupaloy.New(cupaloy.UseStringerMethods(false)).SnapshotT(t, venues)
-			},
-		},
-		{
-			name: "update venue opening hours",
-			test: func(t *testing.T) {
-				ctx := context.Background()
-				_, err := repository.UpdateOpeningHours(ctx, &api.UpdateOpeningHoursRequest{VenueId: UUID, OpeningHours: []*models.OpeningHoursSpecification{
-					{
-						DayOfWeek:    2,
-						Opens:        "11:00",
-						Closes:       "22:00",
-						ValidFrom:    "",
-						ValidThrough: "",
-					},
-					{
-						DayOfWeek:    3,
-						Opens:        "10:30",
-						Closes:       "23:00",
-						ValidFrom:    "",
-						ValidThrough: "",
-					},
-				}})
-				require.NoError(t, err)
-
-				venue, err := repository.GetVenue(ctx, &api.GetVenueRequest{Id: UUID})
-				require.NoError(t, err)
-
-				cupaloy.New(cupaloy.UseStringerMethods(false)).SnapshotT(t, venue)
-			},
-		},
-		{
-			name: "update venue special opening hours",
-			test: func(t *testing.T) {
-				ctx := context.Background()
-				_, err := repository.UpdateSpecialOpeningHours(ctx, &api.UpdateOpeningHoursRequest{VenueId: UUID, OpeningHours: []*models.OpeningHoursSpecification{
-					{
-						DayOfWeek:    2,
-						Opens:        "11:00",
-						Closes:       "22:00",
-						ValidFrom:    "3000-11-01T00:00:00Z",
-						ValidThrough: "3000-12-01T00:00:00Z",
-					},
-					{
-						DayOfWeek:    3,
-						Opens:        "11:00",
-						Closes:       "23:00",
-						ValidFrom:    "3000-11-01T00:00:00Z",
-						ValidThrough: "3000-12-01T00:00:00Z",
-					},
-				}})
-				require.NoError(t, err)
-
-				venue, err := repository.GetVenue(ctx, &api.GetVenueRequest{Id: UUID})
-				require.NoError(t, err)
-
-				cupaloy.New(cupaloy.UseStringerMethods(false)).SnapshotT(t, venue)
-			},
-		},
-		{
-			name: "get opening hours not found",
-			test: func(t *testing.T) {
-				ctx := context.Background()
-				_, err := repository.GetOpeningHoursSpecification(ctx, &api.GetOpeningHoursSpecificationRequest{
-					VenueId: UUID,
-					Date:    "3000-11-14T00:00:00Z",
-				})
-				require.Equal(t, codes.NotFound, status.Code(err))
-			},
-		},
-		{
-			name: "get opening hours",
-			test: func(t *testing.T) {
-				ctx := context.Background()
-				hours, err := repository.GetOpeningHoursSpecification(ctx, &api.GetOpeningHoursSpecificationRequest{
-					VenueId: UUID,
-					Date:    "3000-11-11T00:00:00Z",
-				})
-				require.NoError(t, err)
-
-				cupaloy.New(cupaloy.UseStringerMethods(false)).SnapshotT(t, hours)
-			},
-		},
-		{
-			name: "update venue opening hours",
-			test: func(t *testing.T) {
-				ctx := context.Background()
-				_, err := repository.UpdateOpeningHours(ctx, &api.UpdateOpeningHoursRequest{VenueId: UUID, OpeningHours: nil})
-				require.NoError(t, err)
-
-				venue, err := repository.GetVenue(ctx, &api.GetVenueRequest{Id: UUID})
-				require.NoError(t, err)
-
-				assert.Equal(t, 0, len(venue.OpeningHours))
-			},
-		},
-		{
-			name: "update venue special opening hours with no entries",
-			test: func(t *testing.T) {
-				ctx := context.Background()
-				_, err := repository.UpdateSpecialOpeningHours(ctx, &api.UpdateOpeningHoursRequest{VenueId: UUID, OpeningHours: nil})
-				require.NoError(t, err)
-
-				venue, err := repository.GetVenue(ctx, &api.GetVenueRequest{Id: UUID})
-				require.NoError(t, err)
-
-				assert.Equal(t, 0, len(venue.SpecialOpeningHours))
-			},
-		},
-		{
-			name: "get opening hours on a sunday",
-			test: func(t *testing.T) {
-				ctx := context.Background()
-				_, err := repository.UpdateSpecialOpeningHours(ctx, &api.UpdateOpeningHoursRequest{
-					VenueId: UUID,
-					OpeningHours: []*models.OpeningHoursSpecification{
-						{
-							DayOfWeek:    7,
-							Opens:        "11:00",
-							Closes:       "22:00",
-							ValidFrom:    "3000-11-01T00:00:00Z",
-							ValidThrough: "3000-12-01T00:00:00Z",
-						},
-					},
-				})
-				require.NoError(t, err)
-
-				hours, err := repository.GetOpeningHoursSpecification(ctx, &api.GetOpeningHoursSpecificationRequest{
-					VenueId: UUID,
-					Date:    "3000-11-16T00:00:00Z",
-				})
-				require.NoError(t, err)
-
-				cupaloy.New(cupaloy.UseStringerMethods(false)).SnapshotT(t, hours)
-			},
-		},
-		{
-			name: "add table successfully",
-			test: func(t *testing.T) {
-				ctx := context.Background()
-				table, err := repository.AddTable(ctx, &api.AddTableRequest{
-					VenueId:  UUID,
-					Name:     "test table",
-					Capacity: 4,
-				})
-				require.NoError(t, err)
-
-				cupaloy.New(cupaloy.UseStringerMethods(false)).SnapshotT(t, table)
-			},
-		},
-		{
-			name: "get tables successfully",
-			test: func(t *testing.T) {
-				ctx := context.Background()
-				table, err := repository.GetTables(ctx, &api.GetTablesRequest{
-					VenueId: UUID})
-				require.NoError(t, err)
-
-				cupaloy.New(cupaloy.UseStringerMethods(false)).SnapshotT(t, table)
-			},
-		},
-		{
-			name: "remove not found table",
-			test: func(t *testing.T) {
-				ctx := context.Background()
-				_, err := repository.RemoveTable(ctx, &api.RemoveTableRequest{
-					VenueId: UUID,
-					TableId: uuid.New().String(),
-				})
-				assert.Equal(t, codes.NotFound, status.Code(err))
-			},
-		},
-		{
-			name: "remove table successfully",
-			test: func(t *testing.T) {
-				ctx := context.Background()
-				removed, err := repository.RemoveTable(ctx, &api.RemoveTableRequest{
-					VenueId: UUID,
-					TableId: UUID,
-				})
-				require.NoError(t, err)
-
-				assert.Equal(t, &models.Table{
-					Id:       UUID,
-					Name:     "test table",
-					Capacity: 4,
-				}, removed)
-			},
-		},
-		{
-			name: "is not administrator",
-			test: func(t *testing.T) {
-				resp, err := repository.IsAdmin(context.Background(), &api.IsAdminRequest{
-					VenueId: UUID,
-					Email:   "test@test.com",
-				})
-				require.NoError(t, err)
-
-				assert.Equal(t, false, resp.IsAdmin)
-			},
-		},
-		{
-			name: "add administrator",
-			test: func(t *testing.T) {
-				venueID := UUID
-				email := "test@test.com"
-				resp, err := repository.AddAdmin(context.Background(), &api.AddAdminRequest{
-					VenueId: venueID,
-					Email:   email,
-				})
-				require.NoError(t, err)
-
-				assert.Equal(t, venueID, resp.VenueId)
-				assert.Equal(t, email, resp.Email)
-			},
-		},
-		{
-			name: "is administrator",
-			test: func(t *testing.T) {
-				resp, err := repository.IsAdmin(context.Background(), &api.IsAdminRequest{
-					VenueId: UUID,
-					Email:   "test@test.com",
-				})
-				require.NoError(t, err)
-
-				assert.Equal(t, true, resp.IsAdmin)
-			},
-		},
-		{
-			name: "is administrator by slug",
-			test: func(t *testing.T) {
-				resp, err := repository.IsAdmin(context.Background(), &api.IsAdminRequest{
-					Slug:  Slug,
-					Email: "test@test.com",
-				})
-				require.NoError(t, err)
-
-				assert.Equal(t, true, resp.IsAdmin)
-			},
-		},
-		{
-			name: "get administrators",
-			test: func(t *testing.T) {
-				resp, err := repository.GetAdmins(context.Background(), &api.GetAdminsRequest{VenueId: UUID})
-				require.NoError(t, err)
-
-				require.Equal(t, 1, len(resp.Admins))
-				assert.Equal(t, "test@test.com", resp.Admins[0])
-			},
-		},
-		{
-			name: "remove administrator",
-			test: func(t *testing.T) {
-				venueID := UUID
-				email := "test@test.com"
-				resp, err := repository.RemoveAdmin(context.Background(), &api.RemoveAdminRequest{
-					VenueId: venueID,
-					Email:   email,
-				})
-				require.NoError(t, err)
-				require.Equal(t, email, resp.Email)
-
-				admin, err := repository.IsAdmin(context.Background(), &api.IsAdminRequest{
-					VenueId: venueID,
-					Email:   email,
-				})
-				require.NoError(t, err)
-
-				assert.Equal(t, false, admin.IsAdmin)
-			},
-		},
-		{
-			name: "get administrators none",
-			test: func(t *testing.T) {
-				resp, err := repository.GetAdmins(context.Background(), &api.GetAdminsRequest{VenueId: UUID})
-				require.NoError(t, err)
-
-				require.Equal(t, 0, len(resp.Admins))
-			},
-		},
-	}
+	conformance.Run(t, repository)
 }
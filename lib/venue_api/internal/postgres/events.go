@@ -0,0 +1,216 @@
+package postgres
+
+import (
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cobbinma/booking-platform/lib/protobuf/autogen/lang/go/venue/api"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultEventPollInterval = time.Second
+	defaultEventRetention    = 7 * 24 * time.Hour
+	eventPruneInterval       = time.Hour
+	eventBatchSize           = 100
+)
+
+// publish writes an event to the outbox inside the transaction that made the change, so an event is stored if and
+// only if the change is committed. The advisory lock is held until the transaction ends, which means event ids become
+// visible in the order they were allocated and a reader never skips an event committed after a later one.
+func (c client) publish(ctx context.Context, tx *sqlx.Tx, event *api.VenueEvent) error {
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", EventsTable); err != nil {
+		return status.Errorf(errorCode(err), "could not lock venue events : %s", err)
+	}
+
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not marshal venue event : %s", err)
+	}
+
+	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert(EventsTable).
+		Columns("venue_id", "event").
+		Values(event.VenueId, payload).ToSql()
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not build venue event sql : %s", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, sql, args...); err != nil {
+		return status.Errorf(errorCode(err), "could not insert venue event : %s", err)
+	}
+
+	return nil
+}
+
+func (c client) WatchVenue(req *api.WatchVenueRequest, stream api.VenueAPI_WatchVenueServer) error {
+	if req.VenueId == "" {
+		return status.Error(codes.InvalidArgument, "venue id must be given")
+	}
+
+	return c.watch(stream.Context(), req.VenueId, req.Cursor, stream.Send)
+}
+
+func (c client) WatchVenues(req *api.WatchVenuesRequest, stream api.VenueAPI_WatchVenuesServer) error {
+	return c.watch(stream.Context(), "", req.Cursor, stream.Send)
+}
+
+// watch streams outbox events after the given cursor until the context is done. An empty cursor starts from the
+// latest event, so only changes made after the call are sent.
+func (c client) watch(ctx context.Context, venueID, cursor string, send func(*api.VenueEvent) error) error {
+	after, err := c.parseCursor(ctx, cursor)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(c.eventPollInterval)
+	defer ticker.Stop()
+
+	for {
+		events, err := c.getEvents(ctx, venueID, after)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+
+		if len(events) > 0 {
+			last, err := strconv.ParseInt(events[len(events)-1].Cursor, 10, 64)
+			if err != nil {
+				return status.Errorf(codes.Internal, "could not parse cursor : %s", err)
+			}
+			after = last
+		}
+
+		if len(events) == eventBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.Error(errorCode(ctx.Err()), ctx.Err().Error())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c client) parseCursor(ctx context.Context, cursor string) (int64, error) {
+	if cursor != "" {
+		after, err := strconv.ParseInt(cursor, 10, 64)
+		if err != nil || after < 0 {
+			return 0, status.Errorf(codes.InvalidArgument, "invalid cursor '%s'", cursor)
+		}
+
+		return after, nil
+	}
+
+	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("COALESCE(MAX(id), 0)").From(EventsTable).ToSql()
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "could not build venue events sql : %s", err)
+	}
+
+	var latest int64
+	if err := c.db.QueryRowContext(ctx, sql, args...).Scan(&latest); err != nil {
+		return 0, status.Errorf(errorCode(err), "could not get latest venue event : %s", err)
+	}
+
+	return latest, nil
+}
+
+func (c client) getEvents(ctx context.Context, venueID string, after int64) ([]*api.VenueEvent, error) {
+	where := sq.And{sq.Gt{"id": after}}
+	if venueID != "" {
+		where = append(where, sq.Eq{"venue_id": venueID})
+	}
+
+	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("id", "event", "occurred_at").From(EventsTable).
+		Where(where).OrderBy("id").Limit(eventBatchSize).ToSql()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not build venue events sql : %s", err)
+	}
+
+	rows, err := c.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "could not query venue events : %s", err)
+	}
+	defer closeRows(c.log, rows)
+
+	events := []*api.VenueEvent{}
+	for rows.Next() {
+		var id int64
+		var payload []byte
+		var occurredAt time.Time
+		if err := rows.Scan(&id, &payload, &occurredAt); err != nil {
+			return nil, status.Errorf(codes.Internal, "could not scan venue event row : %s", err)
+		}
+
+		event := &api.VenueEvent{}
+		if err := proto.Unmarshal(payload, event); err != nil {
+			return nil, status.Errorf(codes.Internal, "could not unmarshal venue event : %s", err)
+		}
+		event.Cursor = strconv.FormatInt(id, 10)
+		event.OccurredAt = occurredAt.UTC().Format(time.RFC3339)
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(errorCode(err), "venue events rows error : %s", err)
+	}
+
+	return events, nil
+}
+
+// pruneEvents deletes the outbox events older than the retention every prune interval until the context is done. Each
+// replica prunes, which is harmless as the deletes are idempotent.
+func (c client) pruneEvents(ctx context.Context) {
+	ticker := time.NewTicker(eventPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := c.deleteEvents(ctx, time.Now().Add(-c.eventRetention))
+		if err != nil && ctx.Err() == nil {
+			c.log.Errorw("could not prune venue events", zap.Error(err))
+		} else if deleted > 0 {
+			c.log.Infow("pruned venue events", "deleted", deleted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deleteEvents deletes the outbox events that occurred before the given time, returning how many were deleted.
+func (c client) deleteEvents(ctx context.Context, before time.Time) (int64, error) {
+	sql, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete(EventsTable).Where(sq.Lt{"occurred_at": before}).ToSql()
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "could not build venue events sql : %s", err)
+	}
+
+	result, err := c.db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, status.Errorf(errorCode(err), "could not delete venue events : %s", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, status.Errorf(errorCode(err), "could not count deleted venue events : %s", err)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// poolCollector exports the connection pool statistics of the database handle.
+type poolCollector struct {
+	db           *sql.DB
+	maxOpen      *prometheus.Desc
+	open         *prometheus.Desc
+	inUse        *prometheus.Desc
+	idle         *prometheus.Desc
+	waitCount    *prometheus.Desc
+	waitDuration *prometheus.Desc
+	closed       *prometheus.Desc
+}
+
+func newPoolCollector(db *sql.DB) *poolCollector {
+	desc := func(name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName("venue", "db", name), help, labels, nil)
+	}
+
+	return &poolCollector{
+		db:           db,
+		maxOpen:      desc("max_open_connections", "Maximum number of open connections to the database."),
+		open:         desc("open_connections", "The number of established connections both in use and idle."),
+		inUse:        desc("in_use_connections", "The number of connections currently in use."),
+		idle:         desc("idle_connections", "The number of idle connections."),
+		waitCount:    desc("wait_count_total", "The total number of connections waited for."),
+		waitDuration: desc("wait_duration_seconds_total", "The total time blocked waiting for a new connection."),
+		closed:       desc("closed_connections_total", "The total number of connections closed by the pool.", "reason"),
+	}
+}
+
+func (p *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- p.maxOpen
+	ch <- p.open
+	ch <- p.inUse
+	ch <- p.idle
+	ch <- p.waitCount
+	ch <- p.waitDuration
+	ch <- p.closed
+}
+
+func (p *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := p.db.Stats()
+	ch <- prometheus.MustNewConstMetric(p.maxOpen, prometheus.GaugeValue, float64(stats.MaxOpenConnections))
+	ch <- prometheus.MustNewConstMetric(p.open, prometheus.GaugeValue, float64(stats.OpenConnections))
+	ch <- prometheus.MustNewConstMetric(p.inUse, prometheus.GaugeValue, float64(stats.InUse))
+	ch <- prometheus.MustNewConstMetric(p.idle, prometheus.GaugeValue, float64(stats.Idle))
+	ch <- prometheus.MustNewConstMetric(p.waitCount, prometheus.CounterValue, float64(stats.WaitCount))
+	ch <- prometheus.MustNewConstMetric(p.waitDuration, prometheus.CounterValue, stats.WaitDuration.Seconds())
+	ch <- prometheus.MustNewConstMetric(p.closed, prometheus.CounterValue, float64(stats.MaxIdleClosed), "max_idle")
+	ch <- prometheus.MustNewConstMetric(p.closed, prometheus.CounterValue, float64(stats.MaxIdleTimeClosed), "max_idle_time")
+	ch <- prometheus.MustNewConstMetric(p.closed, prometheus.CounterValue, float64(stats.MaxLifetimeClosed), "max_lifetime")
+}
+
+func (c client) registerMetrics() error {
+	if c.registerer == nil {
+		return nil
+	}
+
+	if err := c.registerer.Register(newPoolCollector(c.db.DB)); err != nil {
+		return fmt.Errorf("could not register database metrics : %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source/httpfs"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Migrator applies the schema migrations embedded in the binary to the venue database.
+type Migrator struct {
+	m   *migrate.Migrate
+	log *zap.SugaredLogger
+}
+
+// NewMigrator accepts the same options as NewPostgres. Migrations are read from the binary unless a source is given
+// with WithMigrationsSourceURL.
+func NewMigrator(log *zap.SugaredLogger, options ...func(*client)) (*Migrator, func(log *zap.SugaredLogger), error) {
+	c := &client{log: log}
+	for i := range options {
+		options[i](c)
+	}
+
+	if err := c.resolveDatabaseURL(); err != nil {
+		return nil, nil, err
+	}
+
+	m, err := c.newMigrator()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return m, func(log *zap.SugaredLogger) {
+		m.close()
+	}, nil
+}
+
+func (c *client) newMigrator() (*Migrator, error) {
+	var m *migrate.Migrate
+	if c.migrationsSource != "" {
+		source, err := migrate.New(c.migrationsSource, c.pgURL.String())
+		if err != nil {
+			return nil, fmt.Errorf("error instantiating migrate : %w", err)
+		}
+		m = source
+	} else {
+		driver, err := httpfs.New(http.FS(migrations), "migrations")
+		if err != nil {
+			return nil, fmt.Errorf("could not read embedded migrations : %w", err)
+		}
+
+		embedded, err := migrate.NewWithSourceInstance("httpfs", driver, c.pgURL.String())
+		if err != nil {
+			return nil, fmt.Errorf("error instantiating migrate : %w", err)
+		}
+		m = embedded
+	}
+
+	return &Migrator{m: m, log: c.log}, nil
+}
+
+func (c *client) migrate() error {
+	m, err := c.newMigrator()
+	if err != nil {
+		return err
+	}
+	defer m.close()
+
+	return m.Up()
+}
+
+// Up applies all migrations that have not been applied yet.
+func (m *Migrator) Up() error {
+	version, dirty, err := m.Status()
+	if err != nil {
+		return err
+	}
+	m.log.Infof("database version %d, dirty %t", version, dirty)
+
+	m.log.Infof("starting migration")
+	if err := m.m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("an error occurred while syncing the database.. %w", err)
+	}
+
+	m.log.Infof("migration successfully")
+	return nil
+}
+
+// Down rolls back the given number of applied migrations.
+func (m *Migrator) Down(steps int) error {
+	if steps < 1 {
+		return fmt.Errorf("number of migrations to roll back must be at least 1")
+	}
+
+	m.log.Infof("rolling back %d migrations", steps)
+	if err := m.m.Steps(-steps); err != nil {
+		return fmt.Errorf("could not roll back migrations : %w", err)
+	}
+
+	return nil
+}
+
+// Goto migrates up or down until the database is at the given version.
+func (m *Migrator) Goto(version uint) error {
+	m.log.Infof("migrating to version %d", version)
+	if err := m.m.Migrate(version); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("could not migrate to version %d : %w", version, err)
+	}
+
+	return nil
+}
+
+// Force records the given version as applied and clears the dirty flag without running any migration. It is used to
+// recover after a migration failed part way through and the database has been repaired by hand.
+func (m *Migrator) Force(version int) error {
+	m.log.Infof("forcing version %d", version)
+	if err := m.m.Force(version); err != nil {
+		return fmt.Errorf("could not force version %d : %w", version, err)
+	}
+
+	return nil
+}
+
+// Status returns the current schema version and whether the last migration failed. A database that has never been
+// migrated is at version 0.
+func (m *Migrator) Status() (uint, bool, error) {
+	version, dirty, err := m.m.Version()
+	if err != nil {
+		if errors.Is(err, migrate.ErrNilVersion) {
+			return 0, false, nil
+		}
+
+		return 0, false, fmt.Errorf("could not get database version : %w", err)
+	}
+
+	return version, dirty, nil
+}
+
+func (m *Migrator) close() {
+	sourceErr, databaseErr := m.m.Close()
+	if sourceErr != nil {
+		m.log.Errorf("could not close migrations source : %s", sourceErr)
+	}
+	if databaseErr != nil {
+		m.log.Errorf("could not close migrations database : %s", databaseErr)
+	}
+}
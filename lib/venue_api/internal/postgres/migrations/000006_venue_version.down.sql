@@ -0,0 +1 @@
+ALTER TABLE venues DROP COLUMN version;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE venues ADD version BIGINT NOT NULL DEFAULT 1;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS venue_events;
\ No newline at end of file
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS venue_events
+(
+    id          BIGSERIAL PRIMARY KEY,
+    venue_id    UUID NOT NULL,
+    event       BYTEA NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS venue_events_venue_id ON venue_events (venue_id, id);
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS api_keys
+(
+    id           UUID PRIMARY KEY NOT NULL,
+    venue_id     UUID NOT NULL REFERENCES venues (id) ON DELETE CASCADE,
+    name         VARCHAR NOT NULL,
+    role         VARCHAR NOT NULL,
+    prefix       VARCHAR NOT NULL,
+    hash         VARCHAR UNIQUE NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_used_at TIMESTAMPTZ,
+    revoked_at   TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS api_keys_venue_id ON api_keys (venue_id);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS venue_events_occurred_at;
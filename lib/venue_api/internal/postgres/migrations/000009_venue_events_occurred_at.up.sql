@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS venue_events_occurred_at ON venue_events (occurred_at);
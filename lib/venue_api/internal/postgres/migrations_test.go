@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"github.com/golang-migrate/migrate/v4/source/httpfs"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func Test_EmbeddedMigrations(t *testing.T) {
+	driver, err := httpfs.New(http.FS(migrations), "migrations")
+	require.NoError(t, err)
+	defer driver.Close()
+
+	version, err := driver.First()
+	require.NoError(t, err)
+	require.Equal(t, uint(1), version)
+
+	versions := []uint{version}
+	for {
+		version, err = driver.Next(version)
+		if err != nil {
+			break
+		}
+		versions = append(versions, version)
+	}
+	require.Equal(t, []uint{1, 2, 3, 4, 5, 6, 7, 8, 9}, versions)
+
+	for _, v := range versions {
+		up, _, err := driver.ReadUp(v)
+		require.NoError(t, err)
+		require.NoError(t, up.Close())
+
+		down, _, err := driver.ReadDown(v)
+		require.NoError(t, err)
+		require.NoError(t, down.Close())
+	}
+}
@@ -2,7 +2,9 @@ package postgres
 
 import (
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
 	"net/url"
+	"time"
 )
 
 func WithDatabaseURL(pgURL *url.URL) func(*client) {
@@ -11,6 +13,65 @@ func WithDatabaseURL(pgURL *url.URL) func(*client) {
 	}
 }
 
+// WithMaxOpenConns limits the number of open connections to the database. Zero means unlimited.
+func WithMaxOpenConns(n int) func(*client) {
+	return func(c *client) {
+		c.maxOpenConns = n
+	}
+}
+
+// WithMaxIdleConns sets the number of connections kept open in the idle pool.
+func WithMaxIdleConns(n int) func(*client) {
+	return func(c *client) {
+		c.maxIdleConns = n
+	}
+}
+
+// WithConnMaxLifetime closes connections once they have been open for the given duration. Zero means connections are
+// reused forever.
+func WithConnMaxLifetime(d time.Duration) func(*client) {
+	return func(c *client) {
+		c.connMaxLifetime = d
+	}
+}
+
+// WithStatementTimeout makes postgres abort any statement that runs for longer than the given duration, whether or not
+// the caller's context carries a deadline.
+func WithStatementTimeout(d time.Duration) func(*client) {
+	return func(c *client) {
+		c.statementTimeout = d
+	}
+}
+
+// WithEventPollInterval sets how often WatchVenue and WatchVenues check the outbox for new events.
+func WithEventPollInterval(d time.Duration) func(*client) {
+	return func(c *client) {
+		c.eventPollInterval = d
+	}
+}
+
+// WithEventRetention sets how long events are kept in the outbox before they are deleted. Zero keeps them forever.
+// Watchers resuming from a cursor older than the retention miss the events deleted since.
+func WithEventRetention(d time.Duration) func(*client) {
+	return func(c *client) {
+		c.eventRetention = d
+	}
+}
+
+// WithMetricsRegisterer exports the connection pool statistics to the given registerer.
+func WithMetricsRegisterer(r prometheus.Registerer) func(*client) {
+	return func(c *client) {
+		c.registerer = r
+	}
+}
+
+// WithAutoMigrate controls whether NewPostgres applies pending migrations on start up. It is enabled by default.
+func WithAutoMigrate(enabled bool) func(*client) {
+	return func(c *client) {
+		c.autoMigrate = enabled
+	}
+}
+
 func WithMigrationsSourceURL(url string) func(*client) {
 	return func(c *client) {
 		c.migrationsSource = url